@@ -0,0 +1,26 @@
+package adapters
+
+import (
+	"fmt"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+// NewAnalyzerRegistry returns a registry holding every built-in pluggable analyzer.
+func NewAnalyzerRegistry() (*domain.AnalyzerRegistry, error) {
+	techStack, err := NewTechStackAnalyzer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tech stack analyzer: %w", err)
+	}
+
+	thirdParties, err := NewThirdPartyAnalyzer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create third-party analyzer: %w", err)
+	}
+
+	return domain.NewAnalyzerRegistry(
+		techStack,
+		thirdParties,
+		NewSecurityHeadersAnalyzer(),
+	)
+}
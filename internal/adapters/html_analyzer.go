@@ -2,16 +2,36 @@ package adapters
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strings"
-	"sync"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/architeacher/svc-web-analyzer/internal/domain"
 	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+	"golang.org/x/net/html"
+)
+
+var (
+	html5DoctypePattern = regexp.MustCompile(`(?i)<!DOCTYPE\s+html\s*>`)
+
+	html401DoctypePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+HTML\s+4\.01//EN"`),
+		regexp.MustCompile(`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+HTML\s+4\.01\s+Transitional//EN"`),
+		regexp.MustCompile(`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+HTML\s+4\.01\s+Frameset//EN"`),
+	}
+
+	xhtml10DoctypePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+XHTML\s+1\.0\s+Strict//EN"`),
+		regexp.MustCompile(`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+XHTML\s+1\.0\s+Transitional//EN"`),
+		regexp.MustCompile(`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+XHTML\s+1\.0\s+Frameset//EN"`),
+	}
+
+	xhtml11DoctypePattern = regexp.MustCompile(`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+XHTML\s+1\.1//EN"`)
+	xmlDeclarationPattern = regexp.MustCompile(`(?i)<\?xml\s+version`)
+	whitespacePattern     = regexp.MustCompile(`\s+`)
 )
 
 type HTMLAnalyzer struct {
@@ -24,80 +44,102 @@ func NewHTMLAnalyzer(logger infrastructure.Logger) *HTMLAnalyzer {
 	}
 }
 
-func (a *HTMLAnalyzer) Analyze(ctx context.Context, url, html string, options domain.AnalysisOptions) (*domain.AnalysisData, error) {
-	results := &domain.AnalysisData{}
-	var wg sync.WaitGroup
-	var mu sync.Mutex
+// Analyze walks the already parsed page once, feeding every element to the visitors enabled by
+// the analysis options.
+func (a *HTMLAnalyzer) Analyze(ctx context.Context, page *domain.Document, options domain.AnalysisOptions) (*domain.AnalysisData, error) {
+	doc := goquery.NewDocumentFromNode(page.Root)
 
-	wg.Go(func() {
-		version := a.ExtractHTMLVersion(html)
-		mu.Lock()
-		results.HTMLVersion = version
-		mu.Unlock()
-	})
+	results := &domain.AnalysisData{
+		HTMLVersion: a.ExtractHTMLVersion(page.HTML),
+	}
 
-	wg.Go(func() {
-		title := a.ExtractTitle(html)
-		mu.Lock()
-		results.Title = title
-		mu.Unlock()
-	})
+	visitors := []elementVisitor{
+		&titleVisitor{},
+		newStructuredDataVisitor(a.logger),
+	}
+
+	links, err := newLinkVisitor(page.URL, a.logger)
+	if err != nil {
+		a.logger.Warn().Err(err).Str("url", page.URL).Msg("failed to extract links during analysis")
+		results.Links = domain.LinkAnalysis{
+			TotalCount:        0,
+			InternalCount:     0,
+			ExternalCount:     0,
+			RegionCounts:      map[domain.LinkRegion]int{},
+			ExternalLinks:     []domain.Link{},
+			Links:             []domain.Link{},
+			InaccessibleLinks: []domain.InaccessibleLink{},
+			LinkIssues:        []domain.LinkIssue{},
+		}
+	} else {
+		visitors = append(visitors, links)
+	}
+
+	resources, err := newResourceVisitor(page.URL, a.logger)
+	if err != nil {
+		a.logger.Warn().Err(err).Str("url", page.URL).Msg("failed to extract resources during analysis")
+	} else {
+		visitors = append(visitors, resources)
+	}
+
+	mixedContent, err := newMixedContentVisitor(page.URL, a.logger)
+	if err != nil {
+		a.logger.Warn().Err(err).Str("url", page.URL).Msg("failed to detect mixed content during analysis")
+	} else {
+		visitors = append(visitors, mixedContent)
+	}
+
+	performance, err := newPerformanceVisitor(
+		page.URL,
+		page.Headers,
+		bodyBytes(page.HTML, page.DecodedBytes),
+		int(page.TransferredBytes),
+		a.logger,
+	)
+	if err != nil {
+		a.logger.Warn().Err(err).Str("url", page.URL).Msg("failed to build performance report during analysis")
+	} else {
+		visitors = append(visitors, performance)
+	}
 
 	if options.IncludeHeadings {
-		wg.Go(func() {
-			headings := a.ExtractHeadingCounts(html)
-			mu.Lock()
-			results.HeadingCounts = headings
-			mu.Unlock()
-		})
+		visitors = append(visitors, &headingCountVisitor{}, &headingOutlineVisitor{})
 	}
 
-	wg.Go(func() {
-		links, err := a.ExtractLinks(html, url)
+	if options.DetectForms {
+		forms, err := newFormVisitor(page.URL)
 		if err != nil {
-			a.logger.Warn().Err(err).Str("url", url).Msg("failed to extract links during analysis")
-			mu.Lock()
-			results.Links = domain.LinkAnalysis{
-				TotalCount:        0,
-				InternalCount:     0,
-				ExternalCount:     0,
-				ExternalLinks:     []domain.Link{},
-				InaccessibleLinks: []domain.InaccessibleLink{},
-			}
-			mu.Unlock()
+			a.logger.Warn().Err(err).Str("url", page.URL).Msg("failed to extract forms during analysis")
 		} else {
-			linkAnalysis := domain.LinkAnalysis{
-				TotalCount:        len(links),
-				ExternalLinks:     []domain.Link{},
-				InaccessibleLinks: []domain.InaccessibleLink{},
-			}
-
-			for _, link := range links {
-				switch link.Type {
-				case domain.LinkTypeInternal:
-					linkAnalysis.InternalCount++
-				case domain.LinkTypeExternal:
-					linkAnalysis.ExternalCount++
-					linkAnalysis.ExternalLinks = append(linkAnalysis.ExternalLinks, link)
-				}
-			}
-
-			mu.Lock()
-			results.Links = linkAnalysis
-			mu.Unlock()
+			visitors = append(visitors, forms)
 		}
-	})
+	}
 
-	if options.DetectForms {
-		wg.Go(func() {
-			forms := a.ExtractForms(html, url)
-			mu.Lock()
-			results.Forms = forms
-			mu.Unlock()
-		})
+	if options.IncludeMeta {
+		meta, err := newMetaVisitor(page.URL, a.logger)
+		if err != nil {
+			a.logger.Warn().Err(err).Str("url", page.URL).Msg("failed to extract meta tags during analysis")
+		} else {
+			visitors = append(visitors, meta)
+		}
+	}
+
+	if options.Accessibility {
+		visitors = append(visitors, newAccessibilityVisitor())
+	}
+
+	walkDocument(doc, visitors...)
+
+	for _, visitor := range visitors {
+		visitor.Apply(results)
 	}
 
-	wg.Wait()
+	a.logger.Debug().
+		Str("url", page.URL).
+		Int("visitors", len(visitors)).
+		Int("total_links", results.Links.TotalCount).
+		Int("total_forms", results.Forms.TotalCount).
+		Msg("analyzed HTML document")
 
 	return results, nil
 }
@@ -106,46 +148,31 @@ func (a *HTMLAnalyzer) ExtractHTMLVersion(html string) domain.HTMLVersion {
 	html = strings.TrimSpace(html)
 
 	// Check for HTML5 doctype (case-insensitive)
-	html5Regex := regexp.MustCompile(`(?i)<!DOCTYPE\s+html\s*>`)
-	if html5Regex.MatchString(html) {
+	if html5DoctypePattern.MatchString(html) {
 		return domain.HTML5
 	}
 
 	// Check for HTML 4.01 doctypes
-	html401Patterns := []string{
-		`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+HTML\s+4\.01//EN"`,
-		`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+HTML\s+4\.01\s+Transitional//EN"`,
-		`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+HTML\s+4\.01\s+Frameset//EN"`,
-	}
-
-	for _, pattern := range html401Patterns {
-		if matched, _ := regexp.MatchString(pattern, html); matched {
+	for _, pattern := range html401DoctypePatterns {
+		if pattern.MatchString(html) {
 			return domain.HTML401
 		}
 	}
 
 	// Check for XHTML 1.0 doctypes
-	xhtml10Patterns := []string{
-		`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+XHTML\s+1\.0\s+Strict//EN"`,
-		`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+XHTML\s+1\.0\s+Transitional//EN"`,
-		`(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+XHTML\s+1\.0\s+Frameset//EN"`,
-	}
-
-	for _, pattern := range xhtml10Patterns {
-		if matched, _ := regexp.MatchString(pattern, html); matched {
+	for _, pattern := range xhtml10DoctypePatterns {
+		if pattern.MatchString(html) {
 			return domain.XHTML10
 		}
 	}
 
 	// Check for XHTML 1.1 doctype
-	xhtml11Pattern := `(?i)<!DOCTYPE\s+html\s+PUBLIC\s+"-//W3C//DTD\s+XHTML\s+1\.1//EN"`
-	if matched, _ := regexp.MatchString(xhtml11Pattern, html); matched {
+	if xhtml11DoctypePattern.MatchString(html) {
 		return domain.XHTML11
 	}
 
 	// If no doctype found or unrecognized, check for XML declaration (might be XHTML)
-	xmlDeclPattern := `(?i)<\?xml\s+version`
-	if matched, _ := regexp.MatchString(xmlDeclPattern, html); matched {
+	if xmlDeclarationPattern.MatchString(html) {
 		return domain.XHTML10
 	}
 
@@ -160,10 +187,10 @@ func (a *HTMLAnalyzer) ExtractTitle(html string) string {
 		return ""
 	}
 
-	title := strings.TrimSpace(doc.Find("title").First().Text())
-	title = regexp.MustCompile(`\s+`).ReplaceAllString(title, " ")
+	visitor := &titleVisitor{}
+	walkDocument(doc, visitor)
 
-	return title
+	return visitor.title
 }
 
 func (a *HTMLAnalyzer) ExtractHeadingCounts(html string) domain.HeadingCounts {
@@ -174,32 +201,9 @@ func (a *HTMLAnalyzer) ExtractHeadingCounts(html string) domain.HeadingCounts {
 		return domain.HeadingCounts{}
 	}
 
-	counts := domain.HeadingCounts{}
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-
-	headingTypes := []struct {
-		tag   string
-		field *int
-	}{
-		{"h1", &counts.H1},
-		{"h2", &counts.H2},
-		{"h3", &counts.H3},
-		{"h4", &counts.H4},
-		{"h5", &counts.H5},
-		{"h6", &counts.H6},
-	}
-
-	for _, h := range headingTypes {
-		wg.Go(func() {
-			count := doc.Find(h.tag).Length()
-			mu.Lock()
-			*h.field = count
-			mu.Unlock()
-		})
-	}
-
-	wg.Wait()
+	visitor := &headingCountVisitor{}
+	walkDocument(doc, visitor)
+	counts := visitor.counts
 
 	a.logger.Debug().
 		Int("h1", counts.H1).
@@ -221,64 +225,21 @@ func (a *HTMLAnalyzer) ExtractLinks(html, baseURL string) ([]domain.Link, error)
 		return nil, err
 	}
 
-	baseURLParsed, err := url.Parse(baseURL)
+	visitor, err := newLinkVisitor(baseURL, a.logger)
 	if err != nil {
 		a.logger.Error().Err(err).Msg("failed to parse base URL")
 
 		return nil, err
 	}
 
-	var links []domain.Link
-	seen := make(map[string]bool)
-
-	doc.Find("a[href]").Each(func(i int, s *goquery.Selection) {
-		href, exists := s.Attr("href")
-		if !exists || href == "" {
-			return
-		}
-
-		parsedURL, err := url.Parse(href)
-		if err != nil {
-			a.logger.Debug().
-				Err(err).
-				Str("href", href).
-				Msg("failed to parse link URL")
-			return
-		}
-
-		resolvedURL := baseURLParsed.ResolveReference(parsedURL)
-		finalURL := resolvedURL.String()
-
-		if seen[finalURL] {
-			return
-		}
-		seen[finalURL] = true
-
-		if finalURL == "" ||
-			strings.HasPrefix(href, "#") ||
-			strings.HasPrefix(href, "javascript:") ||
-			strings.HasPrefix(href, "mailto:") ||
-			strings.HasPrefix(href, "tel:") {
-			return
-		}
-
-		linkType := domain.LinkTypeExternal
-		if resolvedURL.Host == baseURLParsed.Host {
-			linkType = domain.LinkTypeInternal
-		}
-
-		links = append(links, domain.Link{
-			URL:  finalURL,
-			Type: linkType,
-		})
-	})
+	walkDocument(doc, visitor)
 
 	a.logger.Debug().
-		Int("total_links", len(links)).
+		Int("total_links", len(visitor.links)).
 		Str("base_url", baseURL).
 		Msg("extracted links")
 
-	return links, nil
+	return visitor.links, nil
 }
 
 func (a *HTMLAnalyzer) ExtractForms(html string, baseURL string) domain.FormAnalysis {
@@ -289,76 +250,376 @@ func (a *HTMLAnalyzer) ExtractForms(html string, baseURL string) domain.FormAnal
 		return domain.FormAnalysis{}
 	}
 
-	baseURLParsed, err := url.Parse(baseURL)
+	visitor, err := newFormVisitor(baseURL)
 	if err != nil {
 		a.logger.Error().Err(err).Msg("failed to parse base URL for form analysis")
 
 		return domain.FormAnalysis{}
 	}
 
-	var loginForms []domain.LoginForm
-	totalForms := 0
+	walkDocument(doc, visitor)
+	analysis := visitor.analysis()
+
+	a.logger.Debug().
+		Int("total_forms", analysis.TotalCount).
+		Int("login_forms", analysis.LoginFormsDetected).
+		Msg("extracted form analysis")
+
+	return analysis
+}
+
+type titleVisitor struct {
+	found bool
+	title string
+}
+
+func (v *titleVisitor) VisitElement(s *goquery.Selection) {
+	if v.found || goquery.NodeName(s) != "title" {
+		return
+	}
+
+	v.found = true
+	v.title = whitespacePattern.ReplaceAllString(strings.TrimSpace(s.Text()), " ")
+}
+
+func (v *titleVisitor) Apply(results *domain.AnalysisData) {
+	results.Title = v.title
+}
+
+type headingCountVisitor struct {
+	counts domain.HeadingCounts
+}
+
+func (v *headingCountVisitor) VisitElement(s *goquery.Selection) {
+	switch goquery.NodeName(s) {
+	case "h1":
+		v.counts.H1++
+	case "h2":
+		v.counts.H2++
+	case "h3":
+		v.counts.H3++
+	case "h4":
+		v.counts.H4++
+	case "h5":
+		v.counts.H5++
+	case "h6":
+		v.counts.H6++
+	}
+}
+
+func (v *headingCountVisitor) Apply(results *domain.AnalysisData) {
+	results.HeadingCounts = v.counts
+}
 
-	doc.Find("form").Each(func(i int, s *goquery.Selection) {
-		totalForms++
+type (
+	linkVisitor struct {
+		logger  infrastructure.Logger
+		baseURL *url.URL
+		links   []domain.Link
+		seen    map[string]bool
 
-		method := strings.ToUpper(strings.TrimSpace(s.AttrOr("method", http.MethodGet)))
-		if method != strings.ToUpper(http.MethodPost) && method != strings.ToUpper(http.MethodGet) {
-			method = strings.ToUpper(http.MethodGet)
+		// anchors and samePageAnchors are compared once the whole page has been visited.
+		anchors         anchorTargets
+		samePageAnchors []samePageAnchor
+	}
+
+	// samePageAnchor is a link to a fragment of the page it was found on.
+	samePageAnchor struct {
+		link     domain.Link
+		fragment string
+	}
+)
+
+func newLinkVisitor(baseURL string, logger infrastructure.Logger) (*linkVisitor, error) {
+	baseURLParsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &linkVisitor{
+		logger:  logger,
+		baseURL: baseURLParsed,
+		seen:    make(map[string]bool),
+		anchors: make(anchorTargets),
+	}, nil
+}
+
+func (v *linkVisitor) VisitElement(s *goquery.Selection) {
+	v.anchors.visit(s)
+
+	if goquery.NodeName(s) != "a" {
+		return
+	}
+
+	href, exists := s.Attr("href")
+	if !exists || href == "" {
+		return
+	}
+
+	resolvedURL, err := resolveURL(v.baseURL, href)
+	if err != nil {
+		v.logger.Debug().
+			Err(err).
+			Str("href", href).
+			Msg("failed to parse link URL")
+		return
+	}
+
+	finalURL := resolvedURL.String()
+
+	if v.seen[finalURL] {
+		return
+	}
+	v.seen[finalURL] = true
+
+	if fragment := checkableFragment(resolvedURL); fragment != "" && sameDocument(resolvedURL, v.baseURL) {
+		v.samePageAnchors = append(v.samePageAnchors, samePageAnchor{
+			link: domain.Link{
+				URL:  finalURL,
+				Type: domain.LinkTypeInternal,
+				Text: anchorText(s),
+			},
+			fragment: fragment,
+		})
+	}
+
+	if finalURL == "" ||
+		strings.HasPrefix(href, "#") ||
+		strings.HasPrefix(href, "javascript:") ||
+		strings.HasPrefix(href, "mailto:") ||
+		strings.HasPrefix(href, "tel:") {
+		return
+	}
+
+	linkType := domain.LinkTypeExternal
+	if resolvedURL.Host == v.baseURL.Host {
+		linkType = domain.LinkTypeInternal
+	}
+
+	v.links = append(v.links, domain.Link{
+		URL:    finalURL,
+		Type:   linkType,
+		Region: linkRegion(s),
+		Text:   anchorText(s),
+		Rel:    relTokens(s),
+		Target: strings.TrimSpace(s.AttrOr("target", "")),
+	})
+}
+
+func (v *linkVisitor) Apply(results *domain.AnalysisData) {
+	linkAnalysis := domain.LinkAnalysis{
+		TotalCount:        len(v.links),
+		RegionCounts:      make(map[domain.LinkRegion]int),
+		ExternalLinks:     []domain.Link{},
+		Links:             append([]domain.Link{}, v.links...),
+		InaccessibleLinks: []domain.InaccessibleLink{},
+		LinkIssues:        []domain.LinkIssue{},
+	}
+
+	for _, anchor := range v.samePageAnchors {
+		if !v.anchors[anchor.fragment] {
+			linkAnalysis.LinkIssues = append(linkAnalysis.LinkIssues, danglingAnchorIssue(anchor.link, anchor.fragment, "the page"))
 		}
+	}
+
+	for _, link := range v.links {
+		linkAnalysis.RegionCounts[link.Region]++
 
-		action := s.AttrOr("action", "")
-		if action != "" {
-			if parsedAction, err := url.Parse(action); err == nil {
-				resolvedAction := baseURLParsed.ResolveReference(parsedAction)
-				action = resolvedAction.String()
-			}
+		switch link.Type {
+		case domain.LinkTypeInternal:
+			linkAnalysis.InternalCount++
+		case domain.LinkTypeExternal:
+			linkAnalysis.ExternalCount++
+			linkAnalysis.ExternalLinks = append(linkAnalysis.ExternalLinks, link)
 		}
+	}
 
-		var fields []string
-		fieldNames := make(map[string]bool)
+	results.Links = linkAnalysis
+}
 
-		s.Find("input, select, textarea").Each(func(j int, field *goquery.Selection) {
-			name := field.AttrOr("name", "")
-			if name != "" && !fieldNames[name] {
-				fields = append(fields, name)
-				fieldNames[name] = true
-			}
-		})
+type formVisitor struct {
+	baseURL            *url.URL
+	totalForms         int
+	loginForms         []domain.LoginForm
+	details            []domain.FormDetail
+	security           []domain.FormSecurity
+	classifications    []domain.FormClassification
+	federatedProviders []string
+	metaCSRFToken      bool
+
+	// standalone groups the fields rendered outside of any <form> by container, in document order.
+	standalone      []*standaloneFields
+	standaloneIndex map[*html.Node]*standaloneFields
+
+	// submitAncestors is filled on first use by visitField.
+	submitAncestors map[*html.Node]bool
+
+	// labelTexts is filled on first use by indexLabels, idElements and idTexts by idText.
+	labelTexts map[string]string
+	idElements map[string]*goquery.Selection
+	idTexts    map[string]string
+}
+
+func newFormVisitor(baseURL string) (*formVisitor, error) {
+	baseURLParsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &formVisitor{
+		baseURL:         baseURLParsed,
+		standaloneIndex: make(map[*html.Node]*standaloneFields),
+	}, nil
+}
+
+func (v *formVisitor) VisitElement(s *goquery.Selection) {
+	switch goquery.NodeName(s) {
+	case "meta":
+		// Frameworks such as Rails and Laravel expose the token in a meta tag and add it to
+		// submissions from JavaScript instead of a hidden input.
+		if csrfTokenPattern.MatchString(s.AttrOr("name", "")) {
+			v.metaCSRFToken = true
+		}
+
+		return
+	case "a", "button":
+		if provider := federatedProvider(s); provider != "" && !slices.Contains(v.federatedProviders, provider) {
+			v.federatedProviders = append(v.federatedProviders, provider)
+		}
+
+		return
+	case "input", "select", "textarea":
+		v.visitField(s)
+
+		return
+	case "form":
+	default:
+		return
+	}
+
+	v.totalForms++
+
+	method := strings.ToUpper(strings.TrimSpace(s.AttrOr("method", http.MethodGet)))
+	if method != strings.ToUpper(http.MethodPost) && method != strings.ToUpper(http.MethodGet) {
+		method = strings.ToUpper(http.MethodGet)
+	}
+
+	action := s.AttrOr("action", "")
+	if action != "" {
+		if parsedAction, err := url.Parse(action); err == nil {
+			resolvedAction := v.baseURL.ResolveReference(parsedAction)
+			action = resolvedAction.String()
+		}
+	}
+
+	var fields []string
+	fieldNames := make(map[string]bool)
 
-		if a.isLikelyLoginForm(method, s) {
-			loginForm := domain.LoginForm{
-				Method: domain.FormMethod(method),
-				Action: action,
-				Fields: fields,
-			}
-			loginForms = append(loginForms, loginForm)
+	formFields := s.Find(formFieldSelector)
+	formFields.Each(func(j int, field *goquery.Selection) {
+		name := field.AttrOr("name", "")
+		if name != "" && !fieldNames[name] {
+			fields = append(fields, name)
+			fieldNames[name] = true
 		}
 	})
 
-	analysis := domain.FormAnalysis{
-		TotalCount:         totalForms,
-		LoginFormsDetected: len(loginForms),
-		LoginFormDetails:   loginForms,
+	classification := classifyForm(s, formFields, method)
+	classification.Index = v.totalForms - 1
+	v.classifications = append(v.classifications, classification)
+
+	if classification.Kind == domain.FormKindLogin && classification.Confidence >= loginConfidenceThreshold {
+		v.loginForms = append(v.loginForms, domain.LoginForm{
+			Method: domain.FormMethod(method),
+			Action: action,
+			Fields: fields,
+		})
 	}
 
-	a.logger.Debug().
-		Int("total_forms", totalForms).
-		Int("login_forms", len(loginForms)).
-		Msg("extracted form analysis")
+	v.details = append(v.details, v.describeForm(v.totalForms-1, method, s))
+	v.security = append(v.security, v.assessForm(v.totalForms-1, method, s))
+}
 
-	return analysis
+// visitField groups the visible fields that are not nested in a <form> by container, so that
+// single page applications which handle submission in JavaScript are classified as well.
+func (v *formVisitor) visitField(s *goquery.Selection) {
+	if strings.EqualFold(strings.TrimSpace(s.AttrOr("type", "")), "hidden") || s.Closest("form").Length() > 0 {
+		return
+	}
+
+	if v.submitAncestors == nil {
+		v.submitAncestors = submitControlAncestors(s)
+	}
+
+	container := standaloneContainer(s, v.submitAncestors)
+	if container.Length() == 0 || goquery.NodeName(container) == "body" {
+		return
+	}
+
+	if group, ok := v.standaloneIndex[container.Get(0)]; ok {
+		group.fields = group.fields.AddSelection(s)
+
+		return
+	}
+
+	group := &standaloneFields{container: container, fields: s}
+	v.standaloneIndex[container.Get(0)] = group
+	v.standalone = append(v.standalone, group)
 }
 
-func (a *HTMLAnalyzer) isLikelyLoginForm(method string, formSelection *goquery.Selection) bool {
-	if method != strings.ToUpper(http.MethodPost) {
-		return false
+func (v *formVisitor) analysis() domain.FormAnalysis {
+	details := v.details
+	if details == nil {
+		details = []domain.FormDetail{}
 	}
 
-	hasPasswordInput := false
-	formSelection.Find(fmt.Sprintf("input[type='%s']", domain.InputTypePassword)).Each(func(i int, s *goquery.Selection) {
-		hasPasswordInput = true
-	})
+	security := v.security
+	if security == nil {
+		security = []domain.FormSecurity{}
+	}
+
+	classifications := append([]domain.FormClassification{}, v.classifications...)
+
+	index := 0
+	for _, group := range v.standalone {
+		classification := classifyForm(group.container, group.fields, "")
+		if classification.Kind == domain.FormKindUnknown {
+			continue
+		}
+
+		classification.Index = index
+		classification.Standalone = true
+		classifications = append(classifications, classification)
+		index++
+	}
+
+	federatedProviders := v.federatedProviders
+	if federatedProviders == nil {
+		federatedProviders = []string{}
+	}
+
+	return domain.FormAnalysis{
+		TotalCount:         v.totalForms,
+		LoginFormsDetected: len(v.loginForms),
+		LoginFormDetails:   v.loginForms,
+		Forms:              details,
+		Security:           security,
+		Classifications:    classifications,
+		FederatedProviders: federatedProviders,
+	}
+}
+
+func (v *formVisitor) Apply(results *domain.AnalysisData) {
+	results.Forms = v.analysis()
+}
+
+// resolveURL resolves a reference found in the page, such as an href or src attribute,
+// against the page URL the way a browser would.
+func resolveURL(baseURL *url.URL, ref string) (*url.URL, error) {
+	parsedRef, err := url.Parse(strings.TrimSpace(ref))
+	if err != nil {
+		return nil, err
+	}
 
-	return hasPasswordInput
+	return baseURL.ResolveReference(parsedRef), nil
 }
@@ -0,0 +1,392 @@
+package adapters
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+const (
+	wcagNonTextContent   = "1.1.1"
+	wcagInfoAndRelations = "1.3.1"
+	wcagFocusOrder       = "2.4.3"
+	wcagLinkPurpose      = "2.4.4"
+	wcagLanguageOfPage   = "3.1.1"
+	wcagParsing          = "4.1.1"
+	wcagNameRoleValue    = "4.1.2"
+)
+
+// landmarks are the landmark regions every page is expected to have, in the order missing ones are
+// reported, with the severity of a missing one.
+var landmarks = []struct {
+	role     string
+	severity domain.Severity
+}{
+	{role: "main", severity: domain.SeverityWarning},
+	{role: "navigation", severity: domain.SeverityInfo},
+	{role: "banner", severity: domain.SeverityInfo},
+	{role: "contentinfo", severity: domain.SeverityInfo},
+}
+
+// landmarkElements are the elements that map to a landmark role. A <header> or <footer> only does
+// outside sectioning content.
+var landmarkElements = map[string]string{
+	"main":   "main",
+	"nav":    "navigation",
+	"header": "banner",
+	"footer": "contentinfo",
+}
+
+// landmarkIssues are the finding codes of the missing landmark regions.
+var landmarkIssues = map[string]string{
+	"main":        domain.A11yIssueMissingMainLandmark,
+	"navigation":  domain.A11yIssueMissingNavigationLandmark,
+	"banner":      domain.A11yIssueMissingBannerLandmark,
+	"contentinfo": domain.A11yIssueMissingContentinfoLandmark,
+}
+
+// unlabelledInputTypes are input types that either carry their own accessible name or are not rendered.
+var unlabelledInputTypes = map[string]bool{
+	"hidden": true,
+	"submit": true,
+	"reset":  true,
+	"button": true,
+	"image":  true,
+}
+
+func (a *HTMLAnalyzer) ExtractAccessibility(html string) domain.AccessibilityAnalysis {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		a.logger.Error().Err(err).Msg("failed to parse HTML for accessibility audit")
+
+		return domain.AccessibilityAnalysis{Findings: []domain.Finding{}}
+	}
+
+	visitor := newAccessibilityVisitor()
+	walkDocument(doc, visitor)
+	analysis := visitor.analysis()
+
+	a.logger.Debug().
+		Int("findings", len(analysis.Findings)).
+		Msg("extracted accessibility analysis")
+
+	return analysis
+}
+
+// unlabelledField is a form field without an inline label; it is only reported once the
+// whole document is known, because a label[for] pointing at it may come later.
+type unlabelledField struct {
+	id        string
+	name      string
+	selection *goquery.Selection
+}
+
+// accessibilityVisitor runs the static WCAG checks. Findings are grouped per check so the
+// report reads check by check regardless of where the elements appear in the document.
+type accessibilityVisitor struct {
+	langChecked     bool
+	landmarks       map[string]bool
+	previousHeading int
+	labelled        map[string]bool
+	seenIDs         map[string]bool
+	fields          []unlabelledField
+
+	missingLang   []domain.Finding
+	images        []domain.Finding
+	headings      []domain.Finding
+	emptyLinks    []domain.Finding
+	emptyButtons  []domain.Finding
+	duplicateIDs  []domain.Finding
+	tabindexOrder []domain.Finding
+}
+
+func newAccessibilityVisitor() *accessibilityVisitor {
+	return &accessibilityVisitor{
+		landmarks: make(map[string]bool),
+		labelled:  make(map[string]bool),
+		seenIDs:   make(map[string]bool),
+	}
+}
+
+func (v *accessibilityVisitor) VisitElement(s *goquery.Selection) {
+	name := goquery.NodeName(s)
+
+	switch name {
+	case "html":
+		v.checkDocumentLanguage(s)
+	case "img", "area":
+		v.checkImageAlternative(s, name)
+	case "input":
+		inputType := strings.ToLower(s.AttrOr("type", "text"))
+		if inputType == "image" {
+			v.checkImageAlternative(s, name)
+		}
+
+		if !unlabelledInputTypes[inputType] {
+			v.checkFieldLabel(s, name)
+		}
+	case "select", "textarea":
+		v.checkFieldLabel(s, name)
+	case "label":
+		if target, ok := s.Attr("for"); ok {
+			v.labelled[target] = true
+		}
+	case "a":
+		if _, ok := s.Attr("href"); ok && !hasAccessibleName(s) {
+			v.emptyLinks = append(v.emptyLinks, domain.Finding{
+				Code:     domain.A11yIssueEmptyLink,
+				Severity: domain.SeverityError,
+				Message:  "link has no accessible name",
+				WCAG:     wcagLinkPurpose,
+				Selector: selectorPath(s),
+			})
+		}
+	}
+
+	if level := headingLevel(name); level > 0 {
+		v.checkHeadingLevel(s, level)
+	}
+
+	v.checkLandmark(s, name)
+
+	role := s.AttrOr("role", "")
+
+	if (name == "button" || role == "button") && !hasAccessibleName(s) {
+		v.emptyButtons = append(v.emptyButtons, domain.Finding{
+			Code:     domain.A11yIssueEmptyButton,
+			Severity: domain.SeverityError,
+			Message:  "button has no accessible name",
+			WCAG:     wcagNameRoleValue,
+			Selector: selectorPath(s),
+		})
+	}
+
+	v.checkDuplicateID(s)
+	v.checkTabindex(s)
+}
+
+func (v *accessibilityVisitor) checkDocumentLanguage(s *goquery.Selection) {
+	if v.langChecked {
+		return
+	}
+
+	v.langChecked = true
+	if strings.TrimSpace(s.AttrOr("lang", "")) != "" {
+		return
+	}
+
+	v.missingLang = append(v.missingLang, domain.Finding{
+		Code:     domain.A11yIssueMissingLang,
+		Severity: domain.SeverityError,
+		Message:  "<html> element has no lang attribute",
+		WCAG:     wcagLanguageOfPage,
+		Selector: "html",
+	})
+}
+
+func (v *accessibilityVisitor) checkImageAlternative(s *goquery.Selection, name string) {
+	if _, ok := s.Attr("alt"); ok || hasAriaName(s) {
+		return
+	}
+
+	v.images = append(v.images, domain.Finding{
+		Code:     domain.A11yIssueImageMissingAlt,
+		Severity: domain.SeverityError,
+		Message:  fmt.Sprintf("<%s> has no alt attribute", name),
+		WCAG:     wcagNonTextContent,
+		Selector: selectorPath(s),
+	})
+}
+
+func (v *accessibilityVisitor) checkFieldLabel(s *goquery.Selection, name string) {
+	if hasAriaName(s) || strings.TrimSpace(s.AttrOr("title", "")) != "" || s.Closest("label").Length() > 0 {
+		return
+	}
+
+	v.fields = append(v.fields, unlabelledField{
+		id:        s.AttrOr("id", ""),
+		name:      name,
+		selection: s,
+	})
+}
+
+func (v *accessibilityVisitor) checkHeadingLevel(s *goquery.Selection, level int) {
+	if v.previousHeading > 0 && level > v.previousHeading+1 {
+		v.headings = append(v.headings, domain.Finding{
+			Code:     domain.A11yIssueSkippedHeading,
+			Severity: domain.SeverityWarning,
+			Message:  fmt.Sprintf("heading level jumps from h%d to h%d", v.previousHeading, level),
+			WCAG:     wcagInfoAndRelations,
+			Selector: selectorPath(s),
+		})
+	}
+
+	v.previousHeading = level
+}
+
+// checkLandmark records the landmark region an element is, by its ARIA role or its element name.
+func (v *accessibilityVisitor) checkLandmark(s *goquery.Selection, name string) {
+	if role := strings.ToLower(strings.TrimSpace(s.AttrOr("role", ""))); landmarkIssues[role] != "" {
+		v.landmarks[role] = true
+
+		return
+	}
+
+	role, ok := landmarkElements[name]
+	if !ok {
+		return
+	}
+
+	// A header or footer inside sectioning content belongs to that section, not to the page.
+	if (name == "header" || name == "footer") && s.ParentsFiltered("article, aside, main, nav, section").Length() > 0 {
+		return
+	}
+
+	v.landmarks[role] = true
+}
+
+func (v *accessibilityVisitor) checkDuplicateID(s *goquery.Selection) {
+	id := s.AttrOr("id", "")
+	if id == "" {
+		return
+	}
+
+	if !v.seenIDs[id] {
+		v.seenIDs[id] = true
+
+		return
+	}
+
+	v.duplicateIDs = append(v.duplicateIDs, domain.Finding{
+		Code:     domain.A11yIssueDuplicateID,
+		Severity: domain.SeverityWarning,
+		Message:  fmt.Sprintf("id %q is used more than once", id),
+		WCAG:     wcagParsing,
+		Selector: selectorPath(s),
+	})
+}
+
+func (v *accessibilityVisitor) checkTabindex(s *goquery.Selection) {
+	value, ok := s.Attr("tabindex")
+	if !ok {
+		return
+	}
+
+	tabindex, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || tabindex <= 0 {
+		return
+	}
+
+	v.tabindexOrder = append(v.tabindexOrder, domain.Finding{
+		Code:     domain.A11yIssuePositiveTabindex,
+		Severity: domain.SeverityWarning,
+		Message:  fmt.Sprintf("tabindex %d overrides the natural focus order", tabindex),
+		WCAG:     wcagFocusOrder,
+		Selector: selectorPath(s),
+	})
+}
+
+func (v *accessibilityVisitor) analysis() domain.AccessibilityAnalysis {
+	findings := []domain.Finding{}
+	findings = append(findings, v.missingLang...)
+	findings = append(findings, v.images...)
+
+	for _, field := range v.fields {
+		if field.id != "" && v.labelled[field.id] {
+			continue
+		}
+
+		findings = append(findings, domain.Finding{
+			Code:     domain.A11yIssueInputMissingLabel,
+			Severity: domain.SeverityError,
+			Message:  fmt.Sprintf("<%s> has no associated label", field.name),
+			WCAG:     wcagNameRoleValue,
+			Selector: selectorPath(field.selection),
+		})
+	}
+
+	findings = append(findings, v.headings...)
+	findings = append(findings, v.emptyLinks...)
+	findings = append(findings, v.emptyButtons...)
+	findings = append(findings, v.duplicateIDs...)
+	findings = append(findings, v.tabindexOrder...)
+
+	for _, landmark := range landmarks {
+		if v.landmarks[landmark.role] {
+			continue
+		}
+
+		findings = append(findings, domain.Finding{
+			Code:     landmarkIssues[landmark.role],
+			Severity: landmark.severity,
+			Message:  fmt.Sprintf("page has no %s landmark region", landmark.role),
+			WCAG:     wcagInfoAndRelations,
+			Selector: "body",
+		})
+	}
+
+	return domain.AccessibilityAnalysis{Findings: findings}
+}
+
+func (v *accessibilityVisitor) Apply(results *domain.AnalysisData) {
+	analysis := v.analysis()
+	results.Accessibility = &analysis
+}
+
+func hasAriaName(s *goquery.Selection) bool {
+	return strings.TrimSpace(s.AttrOr("aria-label", "")) != "" ||
+		strings.TrimSpace(s.AttrOr("aria-labelledby", "")) != ""
+}
+
+// hasAccessibleName approximates the accessible name computation for links and buttons.
+func hasAccessibleName(s *goquery.Selection) bool {
+	if hasAriaName(s) || strings.TrimSpace(s.AttrOr("title", "")) != "" {
+		return true
+	}
+
+	if strings.TrimSpace(s.Text()) != "" {
+		return true
+	}
+
+	named := false
+	s.Find("img[alt], [aria-label], [aria-labelledby]").EachWithBreak(func(i int, child *goquery.Selection) bool {
+		named = strings.TrimSpace(child.AttrOr("alt", "")) != "" || hasAriaName(child)
+
+		return !named
+	})
+
+	return named
+}
+
+// headingLevel returns 1-6 for h1-h6 element names and 0 for anything else.
+func headingLevel(name string) int {
+	if len(name) != 2 || name[0] != 'h' || name[1] < '1' || name[1] > '6' {
+		return 0
+	}
+
+	return int(name[1] - '0')
+}
+
+// selectorPath builds an unambiguous CSS selector from the document root to the element.
+func selectorPath(s *goquery.Selection) string {
+	var parts []string
+
+	for node := s.First(); node.Length() > 0; node = node.Parent() {
+		name := goquery.NodeName(node)
+		if name == "" || name == "#document" {
+			break
+		}
+
+		part := name
+		if siblings := node.Parent().ChildrenFiltered(name); siblings.Length() > 1 {
+			part = fmt.Sprintf("%s:nth-of-type(%d)", name, siblings.IndexOfNode(node.Get(0))+1)
+		}
+
+		parts = append([]string{part}, parts...)
+	}
+
+	return strings.Join(parts, " > ")
+}
@@ -0,0 +1,195 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHTMLAnalyzer_ExtractAccessibility tests the static accessibility checks
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractAccessibility() {
+	cases := []struct {
+		name     string
+		html     string
+		expected []domain.Finding
+	}{
+		{
+			name: "Accessible page",
+			html: `<html lang="en"><body>
+				<header><nav><a href="/">Home</a></nav></header>
+				<main>
+					<h1>Title</h1>
+					<h2>Section</h2>
+					<img src="/logo.png" alt="">
+					<label for="email">Email</label><input id="email" type="email">
+					<label>Name <input type="text"></label>
+					<input type="hidden" name="csrf">
+					<a href="/about">About</a>
+					<a href="/home"><img src="/home.png" alt="Home"></a>
+					<button aria-label="Close"></button>
+				</main>
+				<footer>Contact</footer>
+			</body></html>`,
+			expected: []domain.Finding{},
+		},
+		{
+			name: "Skipped heading level",
+			html: `<html lang="en"><body>
+				<header><nav><a href="/">Home</a></nav></header>
+				<main><h1>Title</h1><h2>Section</h2><h4>Detail</h4><h2>Next</h2></main>
+				<footer>Contact</footer>
+			</body></html>`,
+			expected: []domain.Finding{
+				{
+					Code:     domain.A11yIssueSkippedHeading,
+					Severity: domain.SeverityWarning,
+					Message:  "heading level jumps from h2 to h4",
+					WCAG:     "1.3.1",
+					Selector: "html > body > main > h4",
+				},
+			},
+		},
+		{
+			name: "Landmark roles",
+			html: `<html lang="en"><body>
+				<div role="banner"><div role="navigation"><a href="/">Home</a></div></div>
+				<div role="main"><h1>Title</h1></div>
+				<div role="contentinfo">Contact</div>
+			</body></html>`,
+			expected: []domain.Finding{},
+		},
+		{
+			name: "Section headers and footers",
+			html: `<html lang="en"><body>
+				<nav><a href="/">Home</a></nav>
+				<main><article><header><h1>Title</h1></header><footer>Author</footer></article></main>
+			</body></html>`,
+			expected: []domain.Finding{
+				{
+					Code:     domain.A11yIssueMissingBannerLandmark,
+					Severity: domain.SeverityInfo,
+					Message:  "page has no banner landmark region",
+					WCAG:     "1.3.1",
+					Selector: "body",
+				},
+				{
+					Code:     domain.A11yIssueMissingContentinfoLandmark,
+					Severity: domain.SeverityInfo,
+					Message:  "page has no contentinfo landmark region",
+					WCAG:     "1.3.1",
+					Selector: "body",
+				},
+			},
+		},
+		{
+			name: "Every check failing",
+			html: `<html><body>
+				<div>
+					<h1>Title</h1>
+					<h3>Skipped</h3>
+					<img src="/a.png">
+					<input type="text" name="q">
+					<a href="/empty"></a>
+					<button></button>
+					<span id="dup">one</span>
+					<span id="dup" tabindex="3">two</span>
+				</div>
+			</body></html>`,
+			expected: []domain.Finding{
+				{
+					Code:     domain.A11yIssueMissingLang,
+					Severity: domain.SeverityError,
+					Message:  "<html> element has no lang attribute",
+					WCAG:     "3.1.1",
+					Selector: "html",
+				},
+				{
+					Code:     domain.A11yIssueImageMissingAlt,
+					Severity: domain.SeverityError,
+					Message:  "<img> has no alt attribute",
+					WCAG:     "1.1.1",
+					Selector: "html > body > div > img",
+				},
+				{
+					Code:     domain.A11yIssueInputMissingLabel,
+					Severity: domain.SeverityError,
+					Message:  "<input> has no associated label",
+					WCAG:     "4.1.2",
+					Selector: "html > body > div > input",
+				},
+				{
+					Code:     domain.A11yIssueSkippedHeading,
+					Severity: domain.SeverityWarning,
+					Message:  "heading level jumps from h1 to h3",
+					WCAG:     "1.3.1",
+					Selector: "html > body > div > h3",
+				},
+				{
+					Code:     domain.A11yIssueEmptyLink,
+					Severity: domain.SeverityError,
+					Message:  "link has no accessible name",
+					WCAG:     "2.4.4",
+					Selector: "html > body > div > a",
+				},
+				{
+					Code:     domain.A11yIssueEmptyButton,
+					Severity: domain.SeverityError,
+					Message:  "button has no accessible name",
+					WCAG:     "4.1.2",
+					Selector: "html > body > div > button",
+				},
+				{
+					Code:     domain.A11yIssueDuplicateID,
+					Severity: domain.SeverityWarning,
+					Message:  `id "dup" is used more than once`,
+					WCAG:     "4.1.1",
+					Selector: "html > body > div > span:nth-of-type(2)",
+				},
+				{
+					Code:     domain.A11yIssuePositiveTabindex,
+					Severity: domain.SeverityWarning,
+					Message:  "tabindex 3 overrides the natural focus order",
+					WCAG:     "2.4.3",
+					Selector: "html > body > div > span:nth-of-type(2)",
+				},
+				{
+					Code:     domain.A11yIssueMissingMainLandmark,
+					Severity: domain.SeverityWarning,
+					Message:  "page has no main landmark region",
+					WCAG:     "1.3.1",
+					Selector: "body",
+				},
+				{
+					Code:     domain.A11yIssueMissingNavigationLandmark,
+					Severity: domain.SeverityInfo,
+					Message:  "page has no navigation landmark region",
+					WCAG:     "1.3.1",
+					Selector: "body",
+				},
+				{
+					Code:     domain.A11yIssueMissingBannerLandmark,
+					Severity: domain.SeverityInfo,
+					Message:  "page has no banner landmark region",
+					WCAG:     "1.3.1",
+					Selector: "body",
+				},
+				{
+					Code:     domain.A11yIssueMissingContentinfoLandmark,
+					Severity: domain.SeverityInfo,
+					Message:  "page has no contentinfo landmark region",
+					WCAG:     "1.3.1",
+					Selector: "body",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result := suite.analyzer.ExtractAccessibility(tc.html)
+			assert.Equal(t, tc.expected, result.Findings)
+		})
+	}
+}
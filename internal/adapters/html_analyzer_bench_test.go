@@ -0,0 +1,128 @@
+package adapters
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+	"github.com/rs/zerolog"
+)
+
+var benchmarkPageSizes = []struct {
+	name  string
+	bytes int
+}{
+	{"100KB", 100 << 10},
+	{"1MB", 1 << 20},
+	{"10MB", 10 << 20},
+}
+
+// largeFixturePage builds a realistic page of roughly the requested size with headings,
+// links, forms, images and structured data repeated across sections.
+func largeFixturePage(size int) string {
+	var builder strings.Builder
+	builder.Grow(size + 4096)
+
+	builder.WriteString(`<!DOCTYPE html><html lang="en"><head><meta charset="utf-8">
+		<title>Benchmark fixture</title>
+		<meta name="description" content="Large page used to benchmark the analyzer">
+		<meta name="viewport" content="width=device-width, initial-scale=1">
+		<link rel="canonical" href="https://example.com/fixture">
+		<script type="application/ld+json">{"@context": "https://schema.org", "@type": "WebSite", "name": "Fixture"}</script>
+		</head><body><main><h1>Benchmark fixture</h1>`)
+
+	for section := 0; builder.Len() < size; section++ {
+		fmt.Fprintf(&builder, `<section id="section-%d">
+			<h2>Section %d</h2>
+			<p>Lorem ipsum dolor sit amet, <a href="/page/%d">internal link</a> and
+			<a href="https://external-%d.example.org/path">external link</a>.</p>
+			<h3>Details</h3>
+			<img src="/img/%d.png" alt="Illustration %d">
+			<div itemscope itemtype="https://schema.org/Product"><span itemprop="name">Product %d</span></div>
+			<form method="post" action="/subscribe/%d">
+				<label for="email-%d">Email</label><input id="email-%d" type="email" name="email">
+				<input type="password" name="password" aria-label="Password">
+				<button type="submit">Send</button>
+			</form>
+		</section>`, section, section, section, section, section, section, section, section, section, section)
+	}
+
+	builder.WriteString(`</main></body></html>`)
+
+	return builder.String()
+}
+
+func benchmarkAnalysisOptions() domain.AnalysisOptions {
+	return domain.AnalysisOptions{
+		IncludeHeadings: true,
+		DetectForms:     true,
+		IncludeMeta:     true,
+		Accessibility:   true,
+	}
+}
+
+// BenchmarkHTMLAnalyzer_Analyze measures the single-parse, single-walk pipeline.
+func BenchmarkHTMLAnalyzer_Analyze(b *testing.B) {
+	analyzer := NewHTMLAnalyzer(infrastructure.Logger{Logger: zerolog.Nop()})
+	options := benchmarkAnalysisOptions()
+
+	for _, size := range benchmarkPageSizes {
+		content := &domain.WebPageContent{URL: "https://example.com", HTML: largeFixturePage(size.bytes)}
+
+		b.Run(size.name, func(b *testing.B) {
+			b.SetBytes(int64(len(content.HTML)))
+			b.ReportAllocs()
+
+			for b.Loop() {
+				doc, err := domain.NewDocument(content)
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				if _, err := analyzer.Analyze(b.Context(), doc, options); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkHTMLAnalyzer_SeparateExtractors is the baseline: every extractor parses and walks
+// the page on its own, which is what Analyze did before the visitor pipeline.
+func BenchmarkHTMLAnalyzer_SeparateExtractors(b *testing.B) {
+	analyzer := NewHTMLAnalyzer(infrastructure.Logger{Logger: zerolog.Nop()})
+
+	for _, size := range benchmarkPageSizes {
+		html := largeFixturePage(size.bytes)
+
+		b.Run(size.name, func(b *testing.B) {
+			b.SetBytes(int64(len(html)))
+			b.ReportAllocs()
+
+			for b.Loop() {
+				analyzer.ExtractHTMLVersion(html)
+				analyzer.ExtractTitle(html)
+				analyzer.ExtractHeadingCounts(html)
+				analyzer.ExtractHeadingOutline(html)
+				if _, err := analyzer.ExtractLinks(html, "https://example.com"); err != nil {
+					b.Fatal(err)
+				}
+				analyzer.ExtractForms(html, "https://example.com")
+				analyzer.ExtractMeta(html, "https://example.com")
+				analyzer.ExtractStructuredData(html)
+				analyzer.ExtractAccessibility(html)
+			}
+		})
+	}
+}
+
+func BenchmarkHTMLAnalyzer_ExtractHTMLVersion(b *testing.B) {
+	analyzer := NewHTMLAnalyzer(infrastructure.Logger{Logger: zerolog.Nop()})
+	html := `<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.1//EN"><html><head><title>Test</title></head></html>`
+
+	for b.Loop() {
+		analyzer.ExtractHTMLVersion(html)
+	}
+}
@@ -0,0 +1,409 @@
+package adapters
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"golang.org/x/net/html"
+)
+
+const (
+	// loginConfidenceThreshold is the confidence a form classified as login needs before it is
+	// reported in the login form details.
+	loginConfidenceThreshold = 50
+
+	// minClassificationScore is the score below which a form is left unknown.
+	minClassificationScore = 30
+
+	formFieldSelector     = "input, select, textarea"
+	submitControlSelector = "button, input[type='submit'], input[type='button'], input[type='image'], [role='button']"
+	formHeadingSelector   = "h1, h2, h3, h4, legend"
+)
+
+// formKinds is the order ties between equally scored kinds are broken in. Signup and password
+// reset forms share most of their fields with login forms, so they win a tie against them.
+var formKinds = []domain.FormKind{
+	domain.FormKindSignup,
+	domain.FormKindPasswordReset,
+	domain.FormKindLogin,
+	domain.FormKindPayment,
+	domain.FormKindSearch,
+	domain.FormKindNewsletter,
+}
+
+// formKindPatterns match the words a form of each kind uses on its buttons and headings, and the
+// action, id or class names it is usually given.
+var formKindPatterns = map[domain.FormKind]struct {
+	words       *regexp.Regexp
+	descriptors *regexp.Regexp
+}{
+	domain.FormKindLogin: {
+		words:       regexp.MustCompile(`(?i)\b(sign[ -]?in|log[ -]?in|anmelden|einloggen|connexion|se connecter|iniciar sesi[oó]n|entrar|accedi|inloggen|aanmelden|zaloguj|logga in)\b|войти|вход|ログイン|登录|登入|로그인`),
+		descriptors: regexp.MustCompile(`(?i)(log-?in|sign-?in|session|/auth\b|\bauth\b|authenticate)`),
+	},
+	domain.FormKindSignup: {
+		words:       regexp.MustCompile(`(?i)\b(sign[ -]?up|register|create (an )?account|join now|get started|registrieren|konto erstellen|s'inscrire|inscription|cr[ée]er un compte|reg[ií]strate|registrarse|crear cuenta|cadastr(ar|e-se)|registrati|registreren|zarejestruj)\b|зарегистрироваться|регистрация|登録|注册|註冊|가입`),
+		descriptors: regexp.MustCompile(`(?i)(sign-?up|register|registration|join|create-?account)`),
+	},
+	domain.FormKindPasswordReset: {
+		words:       regexp.MustCompile(`(?i)(forgot|reset|recover|lost your password|passwort vergessen|zur[üu]cksetzen|mot de passe oubli|r[ée]initialiser|olvid|restablecer|recuperar|reimposta|wachtwoord vergeten|resetuj|сброс|восстанов|パスワードを忘れ|重置|忘记密码|비밀번호 찾기|재설정)`),
+		descriptors: regexp.MustCompile(`(?i)(forgot|reset|recover|lost-?password)`),
+	},
+	domain.FormKindPayment: {
+		words:       regexp.MustCompile(`(?i)\b(pay( now)?|checkout|check out|place order|complete (purchase|order)|buy now|bezahlen|jetzt kaufen|payer|commander|pagar|finalizar compra|paga|acquista|betalen|afrekenen)\b|zapłać|оплатить|支払|支付|付款|결제`),
+		descriptors: regexp.MustCompile(`(?i)(checkout|payment|billing|/pay\b)`),
+	},
+	domain.FormKindSearch: {
+		words:       regexp.MustCompile(`(?i)\b(search|suche|suchen|rechercher|recherche|buscar|b[úu]squeda|pesquisar|cerca|zoeken|szukaj|s[öo]k)\b|поиск|найти|検索|搜索|검색`),
+		descriptors: regexp.MustCompile(`(?i)search`),
+	},
+	domain.FormKindNewsletter: {
+		words:       regexp.MustCompile(`(?i)(newsletter|subscribe|mailing list|abonnieren|s'abonner|abonnez|suscrib|iscriviti|inschrijven|zapisz się|подписаться|購読|订阅|구독)`),
+		descriptors: regexp.MustCompile(`(?i)(newsletter|subscri|mailing)`),
+	},
+}
+
+var (
+	// nextStepPattern matches the button of the first step of a multi-step login, which asks for
+	// the username alone.
+	nextStepPattern = regexp.MustCompile(`(?i)\b(next|continue|weiter|suivant|continuer|siguiente|continuar|avanti|volgende|dalej)\b|далее|продолжить|次へ|下一步|다음`)
+
+	confirmPasswordPattern = regexp.MustCompile(`(?i)(confirm|repeat|retype|again|verify|password2|_confirmation)`)
+	searchFieldPattern     = regexp.MustCompile(`(?i)^(q|s|k|query|search|keywords?|term|search_?query|searchterm)$`)
+	cardFieldPattern       = regexp.MustCompile(`(?i)(card.?number|cc.?num|cardnum|\bcvv\b|\bcvc\b|\bcsc\b|expir|exp.?date|cc.?exp|security.?code)`)
+	personalFieldPattern   = regexp.MustCompile(`(?i)(first.?name|last.?name|full.?name|given.?name|family.?name|birth|phone)`)
+	termsFieldPattern      = regexp.MustCompile(`(?i)(terms|agree|tos\b|accept|consent|privacy)`)
+
+	// federatedTextPattern matches sign in buttons such as "Continue with Google" and captures the
+	// provider keyword.
+	federatedTextPattern = regexp.MustCompile(`(?i)\b(?:sign (?:in|up)|log ?in|continue|connect|register|anmelden|weiter|connexion|continuer|iniciar sesi[oó]n|continuar|accedi|inloggen)\s+(?:with|using|via|mit|avec|con|met)\s+(\w+)`)
+
+	// federatedPathPattern matches the sign in routes of libraries such as OmniAuth and Passport.
+	federatedPathPattern = regexp.MustCompile(`(?i)/(?:auth|oauth|login|connect|social(?:/login)?)/(\w+)(?:/|\?|$)`)
+
+	oidcAuthorizePattern = regexp.MustCompile(`(?i)/oauth2?/(v[\d.]+/)?authorize\b|/protocol/openid-connect/auth\b`)
+)
+
+// federatedProviders are the identity providers recognised in sign in links, by keyword and by the
+// authorization endpoint they send users to.
+var federatedProviders = []struct {
+	name     string
+	keywords []string
+	endpoint *regexp.Regexp
+}{
+	{"Google", []string{"google"}, regexp.MustCompile(`(?i)accounts\.google\.com/`)},
+	{"Apple", []string{"apple"}, regexp.MustCompile(`(?i)appleid\.apple\.com/`)},
+	{"Facebook", []string{"facebook"}, regexp.MustCompile(`(?i)facebook\.com/(v[\d.]+/)?dialog/oauth`)},
+	{"GitHub", []string{"github"}, regexp.MustCompile(`(?i)github\.com/login/oauth`)},
+	{"Microsoft", []string{"microsoft", "azure", "azuread"}, regexp.MustCompile(`(?i)login\.(microsoftonline|live)\.com/`)},
+	{"X", []string{"twitter", "x"}, regexp.MustCompile(`(?i)((twitter|x)\.com/i/oauth2|api\.(twitter|x)\.com/oauth)`)},
+	{"LinkedIn", []string{"linkedin"}, regexp.MustCompile(`(?i)linkedin\.com/oauth`)},
+}
+
+// federatedProvider returns the identity provider a link or button signs users in with, or an empty
+// string when it is not a federated sign in control.
+func federatedProvider(s *goquery.Selection) string {
+	targets := []string{s.AttrOr("href", ""), s.AttrOr("formaction", ""), s.AttrOr("data-href", "")}
+
+	for _, target := range targets {
+		if target == "" {
+			continue
+		}
+
+		for _, provider := range federatedProviders {
+			if provider.endpoint.MatchString(target) {
+				return provider.name
+			}
+		}
+
+		if match := federatedPathPattern.FindStringSubmatch(target); match != nil {
+			if name := federatedProviderByKeyword(match[1]); name != "" {
+				return name
+			}
+		}
+	}
+
+	label := s.AttrOr("data-provider", "") + " " + s.AttrOr("aria-label", "") + " " + s.Text()
+	if match := federatedTextPattern.FindStringSubmatch(label); match != nil {
+		if name := federatedProviderByKeyword(match[1]); name != "" {
+			return name
+		}
+	}
+
+	for _, target := range targets {
+		if oidcAuthorizePattern.MatchString(target) {
+			return "OpenID Connect"
+		}
+	}
+
+	return ""
+}
+
+func federatedProviderByKeyword(keyword string) string {
+	keyword = strings.ToLower(keyword)
+
+	for _, provider := range federatedProviders {
+		if slices.Contains(provider.keywords, keyword) {
+			return provider.name
+		}
+	}
+
+	return ""
+}
+
+// formScores accumulates the evidence for each kind of form.
+type formScores struct {
+	scores  map[domain.FormKind]int
+	signals map[domain.FormKind][]string
+}
+
+func (f *formScores) add(kind domain.FormKind, weight int, signal string) {
+	f.scores[kind] += weight
+	f.signals[kind] = append(f.signals[kind], signal)
+}
+
+// classifyForm scores the fields, buttons and attributes of a form, or of a group of fields that
+// are not wrapped in a <form>, against every kind of form and labels it with the best match.
+func classifyForm(container, fields *goquery.Selection, method string) domain.FormClassification {
+	f := &formScores{
+		scores:  make(map[domain.FormKind]int),
+		signals: make(map[domain.FormKind][]string),
+	}
+
+	var (
+		visible, passwords, usernames, emails            int
+		currentPassword, newPassword, usernameToken      bool
+		cardField, personalField, termsField, searchName bool
+	)
+
+	fields.Each(func(i int, field *goquery.Selection) {
+		inputType := goquery.NodeName(field)
+		if inputType == "input" {
+			inputType = strings.ToLower(strings.TrimSpace(field.AttrOr("type", "text")))
+		}
+
+		switch inputType {
+		case "hidden", "submit", "button", "image", "reset":
+			return
+		}
+
+		visible++
+
+		name := field.AttrOr("name", "")
+		descriptor := strings.Join([]string{
+			name, field.AttrOr("id", ""), field.AttrOr("placeholder", ""), field.AttrOr("aria-label", ""),
+		}, " ")
+		autocomplete := strings.Fields(strings.ToLower(field.AttrOr("autocomplete", "")))
+
+		switch {
+		case slices.Contains(autocomplete, "current-password"):
+			currentPassword = true
+		case slices.Contains(autocomplete, "new-password"):
+			newPassword = true
+		}
+
+		switch inputType {
+		case domain.InputTypePassword:
+			passwords++
+			if confirmPasswordPattern.MatchString(descriptor) {
+				f.add(domain.FormKindSignup, 30, "confirm_password_field")
+			}
+		case "search":
+			f.add(domain.FormKindSearch, 50, "search_field")
+		case "text", "email", "tel":
+			usernameToken = usernameToken || slices.Contains(autocomplete, "username")
+			if slices.Contains(autocomplete, "username") || usernameFieldPattern.MatchString(descriptor) {
+				usernames++
+			}
+
+			if inputType == "email" || slices.Contains(autocomplete, "email") || strings.Contains(strings.ToLower(descriptor), "mail") {
+				emails++
+			}
+		case "checkbox":
+			if !termsField && termsFieldPattern.MatchString(descriptor) {
+				termsField = true
+				f.add(domain.FormKindSignup, 15, "terms_checkbox")
+			}
+		}
+
+		if !searchName && searchFieldPattern.MatchString(name) {
+			searchName = true
+			f.add(domain.FormKindSearch, 40, "search_field_name")
+		}
+
+		if !cardField && (slices.ContainsFunc(autocomplete, func(token string) bool {
+			return strings.HasPrefix(token, "cc-")
+		}) || cardFieldPattern.MatchString(descriptor)) {
+			cardField = true
+			f.add(domain.FormKindPayment, 50, "card_field")
+		}
+
+		if !personalField && personalFieldPattern.MatchString(descriptor) {
+			personalField = true
+			f.add(domain.FormKindSignup, 10, "personal_details")
+		}
+	})
+
+	if passwords == 1 && !newPassword {
+		f.add(domain.FormKindLogin, 50, "password_field")
+	}
+
+	if currentPassword {
+		f.add(domain.FormKindLogin, 30, "autocomplete:current-password")
+	}
+
+	if passwords >= 2 {
+		f.add(domain.FormKindSignup, 40, "multiple_password_fields")
+	}
+
+	if newPassword {
+		f.add(domain.FormKindSignup, 30, "autocomplete:new-password")
+		f.add(domain.FormKindPasswordReset, 20, "autocomplete:new-password")
+	}
+
+	within := func(selector string) *goquery.Selection {
+		if goquery.NodeName(container) == "form" {
+			return container.Find(selector)
+		}
+
+		return outsideForm(container.Find(selector))
+	}
+
+	buttons := controlText(within(submitControlSelector))
+	headings := controlText(within(formHeadingSelector))
+
+	if passwords == 0 && visible <= 2 {
+		if usernames > 0 {
+			// The first step of a multi-step login asks for the username alone.
+			f.add(domain.FormKindLogin, 25, "username_only")
+
+			if usernameToken {
+				f.add(domain.FormKindLogin, 20, "autocomplete:username")
+			}
+
+			if nextStepPattern.MatchString(buttons) {
+				f.add(domain.FormKindLogin, 15, "next_step_button")
+			}
+		}
+
+		if emails == 1 {
+			f.add(domain.FormKindNewsletter, 30, "single_email_field")
+			f.add(domain.FormKindPasswordReset, 25, "single_email_field")
+		}
+	}
+
+	if method == http.MethodGet && f.scores[domain.FormKindSearch] > 0 {
+		f.add(domain.FormKindSearch, 10, "get_method")
+	}
+
+	descriptor := strings.Join([]string{
+		container.AttrOr("action", ""), container.AttrOr("id", ""), container.AttrOr("class", ""),
+		container.AttrOr("name", ""), container.AttrOr("aria-label", ""), container.AttrOr("role", ""),
+	}, " ")
+
+	for _, kind := range formKinds {
+		patterns := formKindPatterns[kind]
+
+		if patterns.words.MatchString(buttons) {
+			f.add(kind, 30, "button_text")
+		}
+
+		if patterns.words.MatchString(headings) {
+			f.add(kind, 20, "heading_text")
+		}
+
+		if patterns.descriptors.MatchString(descriptor) {
+			f.add(kind, 20, "form_attributes")
+		}
+	}
+
+	var providers []string
+	within("a, button").Each(func(i int, control *goquery.Selection) {
+		if provider := federatedProvider(control); provider != "" && !slices.Contains(providers, provider) {
+			providers = append(providers, provider)
+		}
+	})
+
+	if len(providers) > 0 {
+		f.add(domain.FormKindLogin, 20, "federated:"+strings.Join(providers, ","))
+	}
+
+	classification := domain.FormClassification{Kind: domain.FormKindUnknown, Signals: []string{}}
+
+	best := 0
+	for _, kind := range formKinds {
+		if score := f.scores[kind]; score > best {
+			best = score
+			classification.Kind = kind
+		}
+	}
+
+	if best < minClassificationScore {
+		classification.Kind = domain.FormKindUnknown
+
+		return classification
+	}
+
+	classification.Confidence = min(best, 100)
+	classification.Signals = f.signals[classification.Kind]
+
+	return classification
+}
+
+// controlText joins the visible text and labels of the given controls into one string.
+func controlText(controls *goquery.Selection) string {
+	var texts []string
+
+	controls.Each(func(i int, control *goquery.Selection) {
+		texts = append(texts, control.AttrOr("value", ""), control.AttrOr("aria-label", ""), control.Text())
+	})
+
+	return whitespacePattern.ReplaceAllString(strings.Join(texts, " "), " ")
+}
+
+// standaloneContainer returns the element that groups a field rendered outside of any <form>: the
+// closest ancestor that carries a form or search role or holds a submit control, as told by
+// submitAncestors. Fields that only share the <body> are not grouped.
+func standaloneContainer(field *goquery.Selection, submitAncestors map[*html.Node]bool) *goquery.Selection {
+	for parent := field.Parent(); parent.Length() > 0; parent = parent.Parent() {
+		if role := strings.ToLower(parent.AttrOr("role", "")); role == "form" || role == "search" {
+			return parent
+		}
+
+		if goquery.NodeName(parent) == "body" || submitAncestors[parent.Get(0)] {
+			return parent
+		}
+	}
+
+	return field.Parent()
+}
+
+// submitControlAncestors returns the elements of the document of s that hold a submit control.
+func submitControlAncestors(s *goquery.Selection) map[*html.Node]bool {
+	ancestors := make(map[*html.Node]bool)
+
+	s.Parents().Last().Find(submitControlSelector).Each(func(i int, control *goquery.Selection) {
+		for node := control.Get(0).Parent; node != nil && !ancestors[node]; node = node.Parent {
+			ancestors[node] = true
+		}
+	})
+
+	return ancestors
+}
+
+// outsideForm keeps the elements of the selection that are not nested in a <form>.
+func outsideForm(s *goquery.Selection) *goquery.Selection {
+	return s.FilterFunction(func(i int, element *goquery.Selection) bool {
+		return element.Closest("form").Length() == 0
+	})
+}
+
+// standaloneFields is a group of fields rendered outside of any <form> and the element holding them.
+type standaloneFields struct {
+	container *goquery.Selection
+	fields    *goquery.Selection
+}
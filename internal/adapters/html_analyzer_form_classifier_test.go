@@ -0,0 +1,165 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHTMLAnalyzer_ExtractFormsClassification tests the scored form classifier
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractFormsClassification() {
+	type classified struct {
+		kind       domain.FormKind
+		standalone bool
+	}
+
+	cases := []struct {
+		name       string
+		html       string
+		expected   []classified
+		loginForms int
+		providers  []string
+	}{
+		{
+			name:       "Lone password field",
+			html:       `<form method="post"><input type="password" name="password"></form>`,
+			expected:   []classified{{kind: domain.FormKindLogin}},
+			loginForms: 1,
+		},
+		{
+			name:       "GET form with password field",
+			html:       `<form method="get"><input type="password" name="password"></form>`,
+			expected:   []classified{{kind: domain.FormKindLogin}},
+			loginForms: 1,
+		},
+		{
+			name:     "Username field without a submit control",
+			html:     `<form method="post"><input type="text" name="username"></form>`,
+			expected: []classified{{kind: domain.FormKindUnknown}},
+		},
+		{
+			name:     "Empty form",
+			html:     `<form method="post"></form>`,
+			expected: []classified{{kind: domain.FormKindUnknown}},
+		},
+		{
+			name: "First step of a multi-step login",
+			html: `<form method="post" action="/identifier">
+				<input type="email" name="identifier" autocomplete="username">
+				<button type="submit">Next</button>
+			</form>`,
+			expected:   []classified{{kind: domain.FormKindLogin}},
+			loginForms: 1,
+		},
+		{
+			name: "German login form",
+			html: `<form method="post" action="/konto">
+				<input type="text" name="benutzer">
+				<input type="password" name="kennwort">
+				<button>Anmelden</button>
+			</form>`,
+			expected:   []classified{{kind: domain.FormKindLogin}},
+			loginForms: 1,
+		},
+		{
+			name: "Signup form with password confirmation",
+			html: `<form method="post">
+				<input type="password" name="password">
+				<input type="password" name="confirm">
+			</form>`,
+			expected: []classified{{kind: domain.FormKindSignup}},
+		},
+		{
+			name: "Signup form with new password and terms",
+			html: `<form method="post" action="/users">
+				<input type="text" name="first_name">
+				<input type="email" name="email" autocomplete="email">
+				<input type="password" name="password" autocomplete="new-password">
+				<input type="checkbox" name="accept_terms">
+				<button>Créer un compte</button>
+			</form>`,
+			expected: []classified{{kind: domain.FormKindSignup}},
+		},
+		{
+			name: "Password reset request",
+			html: `<form method="post" action="/account/password">
+				<h2>Forgot your password?</h2>
+				<input type="email" name="email">
+				<button>Send reset link</button>
+			</form>`,
+			expected: []classified{{kind: domain.FormKindPasswordReset}},
+		},
+		{
+			name: "Search and newsletter forms",
+			html: `<form action="/find"><input type="search" name="q"><button>Suchen</button></form>
+			<form method="post" class="footer-signup">
+				<input type="email" name="email" placeholder="Your email">
+				<button>Subscribe</button>
+			</form>`,
+			expected: []classified{{kind: domain.FormKindSearch}, {kind: domain.FormKindNewsletter}},
+		},
+		{
+			name: "Payment form",
+			html: `<form method="post" action="/orders">
+				<input type="text" name="holder" autocomplete="cc-name">
+				<input type="text" name="number" autocomplete="cc-number">
+				<input type="text" name="cvc">
+				<button>Pay now</button>
+			</form>`,
+			expected: []classified{{kind: domain.FormKindPayment}},
+		},
+		{
+			name: "Login built without a form element and federated sign in",
+			html: `<html><body>
+				<div id="app">
+					<div class="field"><input type="email" aria-label="Email"></div>
+					<div class="field"><input type="password" aria-label="Password"></div>
+					<button type="button">Log in</button>
+				</div>
+				<div class="social">
+					<a href="https://accounts.google.com/o/oauth2/v2/auth?client_id=abc">Google</a>
+					<a href="/auth/github">GitHub</a>
+					<button>Continue with Apple</button>
+					<a href="https://sso.example.com/protocol/openid-connect/auth?client_id=web">Company SSO</a>
+					<a href="/about">About us</a>
+				</div>
+				<input type="checkbox" name="dark_mode">
+			</body></html>`,
+			expected:  []classified{{kind: domain.FormKindLogin, standalone: true}},
+			providers: []string{"Google", "GitHub", "Apple", "OpenID Connect"},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			analysis := suite.analyzer.ExtractForms(tc.html, "https://example.com/")
+
+			require.Len(t, analysis.Classifications, len(tc.expected))
+
+			for i, classification := range analysis.Classifications {
+				assert.Equal(t, tc.expected[i].kind, classification.Kind, "classification %d: %v", i, classification.Signals)
+				assert.Equal(t, tc.expected[i].standalone, classification.Standalone)
+
+				if classification.Kind == domain.FormKindUnknown {
+					assert.Zero(t, classification.Confidence)
+					assert.Empty(t, classification.Signals)
+				} else {
+					assert.GreaterOrEqual(t, classification.Confidence, minClassificationScore)
+					assert.LessOrEqual(t, classification.Confidence, 100)
+					assert.NotEmpty(t, classification.Signals)
+				}
+			}
+
+			assert.Equal(t, tc.loginForms, analysis.LoginFormsDetected)
+
+			providers := tc.providers
+			if providers == nil {
+				providers = []string{}
+			}
+			assert.Equal(t, providers, analysis.FederatedProviders)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package adapters
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+var (
+	// csrfTokenPattern matches the names common frameworks give their anti-forgery token.
+	csrfTokenPattern = regexp.MustCompile(`(?i)(csrf|xsrf|authenticity_token|requestverificationtoken|^_?token$|^__token|^nonce$)`)
+
+	usernameFieldPattern = regexp.MustCompile(`(?i)(user|login|email|account|identifier)`)
+
+	credentialAutocompleteTokens = []string{"username", "current-password", "new-password", "one-time-code"}
+)
+
+// assessForm reports how safely a form handles what users type into it. It runs for every form,
+// not only for the ones classified as login forms.
+func (v *formVisitor) assessForm(index int, method string, s *goquery.Selection) domain.FormSecurity {
+	actionURL := v.baseURL
+	if action := strings.TrimSpace(s.AttrOr("action", "")); action != "" {
+		if resolvedAction, err := resolveURL(v.baseURL, action); err == nil {
+			actionURL = resolvedAction
+		}
+	}
+
+	security := domain.FormSecurity{
+		Index:            index,
+		Method:           domain.FormMethod(method),
+		Action:           actionURL.String(),
+		InsecureAction:   actionURL.Scheme == "http",
+		CredentialFields: []domain.CredentialField{},
+		Findings:         []domain.Finding{},
+	}
+
+	inputs := s.Find("input")
+	security.HasPasswordField = inputs.FilterFunction(func(i int, field *goquery.Selection) bool {
+		return strings.EqualFold(strings.TrimSpace(field.AttrOr("type", "")), domain.InputTypePassword)
+	}).Length() > 0
+
+	var autocompleteFindings []domain.Finding
+
+	inputs.Each(func(i int, field *goquery.Selection) {
+		inputType := strings.ToLower(strings.TrimSpace(field.AttrOr("type", "text")))
+		name := field.AttrOr("name", field.AttrOr("id", ""))
+		autocomplete := strings.ToLower(strings.TrimSpace(field.AttrOr("autocomplete", "")))
+		credential := domain.CredentialField{Name: name, Type: inputType, Autocomplete: autocomplete}
+
+		switch {
+		case inputType == "hidden":
+			if csrfTokenPattern.MatchString(name) {
+				security.HasCSRFToken = true
+			}
+		case inputType == domain.InputTypePassword:
+			security.CredentialFields = append(security.CredentialFields, credential)
+
+			if autocomplete == "" || autocomplete == "on" {
+				autocompleteFindings = append(autocompleteFindings, domain.Finding{
+					Code:     domain.FormIssuePasswordAutocomplete,
+					Severity: domain.SeverityInfo,
+					Message: fmt.Sprintf("password field %q has no autocomplete token; use current-password or new-password",
+						name),
+				})
+			}
+		case slices.ContainsFunc(strings.Fields(autocomplete), func(token string) bool {
+			return slices.Contains(credentialAutocompleteTokens, token)
+		}):
+			security.CredentialFields = append(security.CredentialFields, credential)
+		case security.HasPasswordField && (inputType == "text" || inputType == "email" || inputType == "tel") &&
+			usernameFieldPattern.MatchString(name):
+			// A user or email field only carries a credential when the form also asks for a password.
+			security.CredentialFields = append(security.CredentialFields, credential)
+		}
+	})
+
+	if security.InsecureAction {
+		security.Findings = append(security.Findings, domain.Finding{
+			Code:     domain.FormIssueInsecureAction,
+			Severity: domain.SeverityError,
+			Message:  fmt.Sprintf("form submits to %s over plain HTTP", security.Action),
+		})
+	}
+
+	if security.HasPasswordField && v.baseURL.Scheme == "http" {
+		security.Findings = append(security.Findings, domain.Finding{
+			Code:     domain.FormIssuePasswordOnHTTP,
+			Severity: domain.SeverityError,
+			Message:  "password field is served on a page loaded over plain HTTP",
+		})
+	}
+
+	if method == http.MethodPost && !security.HasCSRFToken && !v.metaCSRFToken {
+		security.Findings = append(security.Findings, domain.Finding{
+			Code:     domain.FormIssueMissingCSRFToken,
+			Severity: domain.SeverityWarning,
+			Message:  "POST form has no hidden input that looks like a CSRF token",
+		})
+	}
+
+	security.Findings = append(security.Findings, autocompleteFindings...)
+
+	return security
+}
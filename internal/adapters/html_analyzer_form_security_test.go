@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHTMLAnalyzer_ExtractFormsSecurity tests the per-form security assessment
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractFormsSecurity() {
+	cases := []struct {
+		name     string
+		html     string
+		baseURL  string
+		expected []domain.FormSecurity
+	}{
+		{
+			name: "Login form with token and autocomplete tokens",
+			html: `<html><body>
+				<form method="post" action="/session">
+					<input type="hidden" name="authenticity_token" value="abc">
+					<input type="email" name="email" autocomplete="username">
+					<input type="password" name="password" autocomplete="current-password">
+				</form>
+			</body></html>`,
+			baseURL: "https://example.com/login",
+			expected: []domain.FormSecurity{
+				{
+					Index:            0,
+					Method:           "POST",
+					Action:           "https://example.com/session",
+					HasPasswordField: true,
+					HasCSRFToken:     true,
+					CredentialFields: []domain.CredentialField{
+						{Name: "email", Type: "email", Autocomplete: "username"},
+						{Name: "password", Type: "password", Autocomplete: "current-password"},
+					},
+					Findings: []domain.Finding{},
+				},
+			},
+		},
+		{
+			name: "Insecure forms on a plain HTTP page",
+			html: `<html><body>
+				<form action="/search"><input type="text" name="q"></form>
+				<form method="post" action="http://auth.example.com/login">
+					<input type="text" name="user_login">
+					<INPUT TYPE="PASSWORD" name="pwd">
+				</form>
+			</body></html>`,
+			baseURL: "http://example.com/",
+			expected: []domain.FormSecurity{
+				{
+					Index:            0,
+					Method:           "GET",
+					Action:           "http://example.com/search",
+					InsecureAction:   true,
+					CredentialFields: []domain.CredentialField{},
+					Findings: []domain.Finding{
+						{Code: domain.FormIssueInsecureAction, Severity: domain.SeverityError, Message: "form submits to http://example.com/search over plain HTTP"},
+					},
+				},
+				{
+					Index:            1,
+					Method:           "POST",
+					Action:           "http://auth.example.com/login",
+					InsecureAction:   true,
+					HasPasswordField: true,
+					CredentialFields: []domain.CredentialField{
+						{Name: "user_login", Type: "text"},
+						{Name: "pwd", Type: "password"},
+					},
+					Findings: []domain.Finding{
+						{Code: domain.FormIssueInsecureAction, Severity: domain.SeverityError, Message: "form submits to http://auth.example.com/login over plain HTTP"},
+						{Code: domain.FormIssuePasswordOnHTTP, Severity: domain.SeverityError, Message: "password field is served on a page loaded over plain HTTP"},
+						{Code: domain.FormIssueMissingCSRFToken, Severity: domain.SeverityWarning, Message: "POST form has no hidden input that looks like a CSRF token"},
+						{Code: domain.FormIssuePasswordAutocomplete, Severity: domain.SeverityInfo, Message: `password field "pwd" has no autocomplete token; use current-password or new-password`},
+					},
+				},
+			},
+		},
+		{
+			name: "CSRF token exposed through a meta tag",
+			html: `<html><head><meta name="csrf-token" content="abc"></head><body>
+				<form method="post"><input type="email" name="email"></form>
+			</body></html>`,
+			baseURL: "https://example.com/newsletter",
+			expected: []domain.FormSecurity{
+				{
+					Index:            0,
+					Method:           "POST",
+					Action:           "https://example.com/newsletter",
+					CredentialFields: []domain.CredentialField{},
+					Findings:         []domain.Finding{},
+				},
+			},
+		},
+		{
+			name:     "No forms",
+			html:     `<html><body><p>Nothing to submit</p></body></html>`,
+			baseURL:  "https://example.com/",
+			expected: []domain.FormSecurity{},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result := suite.analyzer.ExtractForms(tc.html, tc.baseURL)
+
+			assert.Equal(t, tc.expected, result.Security)
+		})
+	}
+}
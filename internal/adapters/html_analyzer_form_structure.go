@@ -0,0 +1,266 @@
+package adapters
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+const defaultFormEnctype = "application/x-www-form-urlencoded"
+
+var (
+	// formEnctypes are the encodings a browser submits a form with; any other value falls back to
+	// the default.
+	formEnctypes = map[string]bool{
+		defaultFormEnctype:    true,
+		"multipart/form-data": true,
+		"text/plain":          true,
+	}
+
+	// constrainedInputTypes are input types the browser validates on its own.
+	constrainedInputTypes = map[string]bool{
+		"email":          true,
+		"url":            true,
+		"number":         true,
+		"range":          true,
+		"date":           true,
+		"datetime-local": true,
+		"month":          true,
+		"week":           true,
+		"time":           true,
+		"color":          true,
+	}
+
+	// uncheckedInputTypes are input types that take no free text, so validation attributes add
+	// nothing to them.
+	uncheckedInputTypes = map[string]bool{
+		"hidden":   true,
+		"checkbox": true,
+		"radio":    true,
+		"file":     true,
+		"submit":   true,
+		"reset":    true,
+		"button":   true,
+		"image":    true,
+	}
+)
+
+// describeForm lists the fields of a form with their validation constraints and labels, and
+// reports fields without labels, unrestricted file uploads and forms the browser will not validate.
+func (v *formVisitor) describeForm(index int, method string, s *goquery.Selection) domain.FormDetail {
+	actionURL := v.baseURL
+	if action := strings.TrimSpace(s.AttrOr("action", "")); action != "" {
+		if resolvedAction, err := resolveURL(v.baseURL, action); err == nil {
+			actionURL = resolvedAction
+		}
+	}
+
+	enctype := strings.ToLower(strings.TrimSpace(s.AttrOr("enctype", "")))
+	if !formEnctypes[enctype] {
+		enctype = defaultFormEnctype
+	}
+
+	_, noValidate := s.Attr("novalidate")
+
+	detail := domain.FormDetail{
+		Index:      index,
+		Method:     domain.FormMethod(method),
+		Action:     actionURL.String(),
+		Enctype:    enctype,
+		NoValidate: noValidate,
+		Fields:     []domain.FormField{},
+		Findings:   []domain.Finding{},
+	}
+
+	var (
+		unlabeled, uploads []domain.Finding
+		freeText           int
+		constrained        bool
+	)
+
+	s.Find(formFieldSelector).Each(func(i int, field *goquery.Selection) {
+		element := goquery.NodeName(field)
+
+		inputType := element
+		if element == "input" {
+			inputType = strings.ToLower(strings.TrimSpace(field.AttrOr("type", "text")))
+		}
+
+		if inputType == "submit" || inputType == "reset" || inputType == "button" || inputType == "image" {
+			return
+		}
+
+		_, required := field.Attr("required")
+		formField := domain.FormField{
+			Name:         field.AttrOr("name", ""),
+			Type:         inputType,
+			Required:     required,
+			Pattern:      field.AttrOr("pattern", ""),
+			MinLength:    lengthAttr(field, "minlength"),
+			MaxLength:    lengthAttr(field, "maxlength"),
+			Autocomplete: strings.ToLower(strings.TrimSpace(field.AttrOr("autocomplete", ""))),
+			Label:        v.fieldLabel(field),
+		}
+		detail.Fields = append(detail.Fields, formField)
+
+		name := formField.Name
+		if name == "" {
+			name = field.AttrOr("id", element)
+		}
+
+		if formField.Label == "" && !unlabelledInputTypes[inputType] {
+			unlabeled = append(unlabeled, domain.Finding{
+				Code:     domain.FormIssueUnlabeledField,
+				Severity: domain.SeverityWarning,
+				Message:  fmt.Sprintf("field %q has no label", name),
+				Selector: selectorPath(field),
+			})
+		}
+
+		if inputType == "file" && strings.TrimSpace(field.AttrOr("accept", "")) == "" {
+			uploads = append(uploads, domain.Finding{
+				Code:     domain.FormIssueUnrestrictedUpload,
+				Severity: domain.SeverityWarning,
+				Message:  fmt.Sprintf("file input %q accepts any file type; restrict it with accept", name),
+				Selector: selectorPath(field),
+			})
+		}
+
+		if uncheckedInputTypes[inputType] || element == "select" {
+			return
+		}
+
+		freeText++
+
+		_, hasMin := field.Attr("min")
+		_, hasMax := field.Attr("max")
+		if required || formField.Pattern != "" || formField.MinLength > 0 || formField.MaxLength > 0 ||
+			hasMin || hasMax || constrainedInputTypes[inputType] {
+			constrained = true
+		}
+	})
+
+	switch {
+	case noValidate:
+		detail.Findings = append(detail.Findings, domain.Finding{
+			Code:     domain.FormIssueValidationDisabled,
+			Severity: domain.SeverityInfo,
+			Message:  "form sets novalidate, so the browser does not check its constraints",
+		})
+	case freeText > 0 && !constrained:
+		detail.Findings = append(detail.Findings, domain.Finding{
+			Code:     domain.FormIssueMissingValidation,
+			Severity: domain.SeverityInfo,
+			Message:  "no field declares HTML5 validation such as required, pattern or a length limit",
+		})
+	}
+
+	detail.Findings = append(detail.Findings, unlabeled...)
+	detail.Findings = append(detail.Findings, uploads...)
+
+	return detail
+}
+
+// fieldLabel returns the text a field is announced with: the elements named by aria-labelledby,
+// aria-label, a label pointing at it or wrapping it, and finally its title.
+func (v *formVisitor) fieldLabel(field *goquery.Selection) string {
+	v.indexLabels(field)
+
+	if ids := strings.Fields(field.AttrOr("aria-labelledby", "")); len(ids) > 0 {
+		var texts []string
+		for _, id := range ids {
+			if text := v.idText(field, id); text != "" {
+				texts = append(texts, text)
+			}
+		}
+
+		if len(texts) > 0 {
+			return strings.Join(texts, " ")
+		}
+	}
+
+	if label := normalizeText(field.AttrOr("aria-label", "")); label != "" {
+		return label
+	}
+
+	if id := field.AttrOr("id", ""); id != "" && v.labelTexts[id] != "" {
+		return v.labelTexts[id]
+	}
+
+	if wrapping := field.Closest("label"); wrapping.Length() > 0 {
+		if label := labelText(wrapping); label != "" {
+			return label
+		}
+	}
+
+	return normalizeText(field.AttrOr("title", ""))
+}
+
+// indexLabels collects the texts of label[for] elements from the whole document once, because a
+// label may come after the field it names.
+func (v *formVisitor) indexLabels(field *goquery.Selection) {
+	if v.labelTexts != nil {
+		return
+	}
+
+	v.labelTexts = make(map[string]string)
+
+	field.Parents().Last().Find("label[for]").Each(func(i int, label *goquery.Selection) {
+		target := label.AttrOr("for", "")
+		if text := labelText(label); text != "" {
+			v.labelTexts[target] = strings.TrimSpace(v.labelTexts[target] + " " + text)
+		}
+	})
+}
+
+// idText returns the text of the first element of the document with the given id. The elements
+// are indexed on the first reference from aria-labelledby and their texts are only computed once
+// referenced.
+func (v *formVisitor) idText(field *goquery.Selection, id string) string {
+	if v.idElements == nil {
+		v.idElements = make(map[string]*goquery.Selection)
+		v.idTexts = make(map[string]string)
+
+		field.Parents().Last().Find("[id]").Each(func(i int, element *goquery.Selection) {
+			if elementID := element.AttrOr("id", ""); v.idElements[elementID] == nil {
+				v.idElements[elementID] = element
+			}
+		})
+	}
+
+	text, ok := v.idTexts[id]
+	if !ok {
+		if element := v.idElements[id]; element != nil {
+			text = normalizeText(element.Text())
+		}
+
+		v.idTexts[id] = text
+	}
+
+	return text
+}
+
+// labelText is the text of a label without the text of the fields nested in it, such as the
+// options of a select.
+func labelText(label *goquery.Selection) string {
+	clone := label.Clone()
+	clone.Find("select, textarea").Remove()
+
+	return normalizeText(clone.Text())
+}
+
+func normalizeText(text string) string {
+	return whitespacePattern.ReplaceAllString(strings.TrimSpace(text), " ")
+}
+
+func lengthAttr(field *goquery.Selection, name string) int {
+	length, err := strconv.Atoi(strings.TrimSpace(field.AttrOr(name, "")))
+	if err != nil || length < 0 {
+		return 0
+	}
+
+	return length
+}
@@ -0,0 +1,130 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHTMLAnalyzer_ExtractFormsStructure tests the per-form field structure and findings
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractFormsStructure() {
+	cases := []struct {
+		name     string
+		html     string
+		expected []domain.FormDetail
+	}{
+		{
+			name: "Labelled signup form with constraints",
+			html: `<html><body>
+				<form method="post" action="/users" enctype="multipart/form-data">
+					<label for="email">Email <span>address</span></label>
+					<input id="email" type="email" name="email" required autocomplete="email">
+					<label>Username <input type="text" name="username" pattern="[a-z0-9]+" minlength="3" maxlength="20"></label>
+					<span id="country-label">Country</span>
+					<select name="country" aria-labelledby="country-label"><option>Germany</option></select>
+					<input type="file" name="avatar" accept="image/*" aria-label="Avatar">
+					<input type="hidden" name="ref" value="home">
+					<button type="submit">Sign up</button>
+				</form>
+			</body></html>`,
+			expected: []domain.FormDetail{
+				{
+					Index:   0,
+					Method:  "POST",
+					Action:  "https://example.com/users",
+					Enctype: "multipart/form-data",
+					Fields: []domain.FormField{
+						{Name: "email", Type: "email", Required: true, Autocomplete: "email", Label: "Email address"},
+						{Name: "username", Type: "text", Pattern: "[a-z0-9]+", MinLength: 3, MaxLength: 20, Label: "Username"},
+						{Name: "country", Type: "select", Label: "Country"},
+						{Name: "avatar", Type: "file", Label: "Avatar"},
+						{Name: "ref", Type: "hidden"},
+					},
+					Findings: []domain.Finding{},
+				},
+			},
+		},
+		{
+			name: "Unlabelled fields, open upload and no validation",
+			html: `<html><body>
+				<form action="contact" enctype="application/json">
+					<input type="text" name="subject" placeholder="Subject">
+					<textarea name="message" title="Message"></textarea>
+					<input type="file" name="attachment">
+				</form>
+				<form method="post" novalidate><input type="email" name="email" required></form>
+			</body></html>`,
+			expected: []domain.FormDetail{
+				{
+					Index:   0,
+					Method:  "GET",
+					Action:  "https://example.com/contact",
+					Enctype: "application/x-www-form-urlencoded",
+					Fields: []domain.FormField{
+						{Name: "subject", Type: "text"},
+						{Name: "message", Type: "textarea", Label: "Message"},
+						{Name: "attachment", Type: "file"},
+					},
+					Findings: []domain.Finding{
+						{
+							Code:     domain.FormIssueMissingValidation,
+							Severity: domain.SeverityInfo,
+							Message:  "no field declares HTML5 validation such as required, pattern or a length limit",
+						},
+						{
+							Code:     domain.FormIssueUnlabeledField,
+							Severity: domain.SeverityWarning,
+							Message:  `field "subject" has no label`,
+							Selector: "html > body > form:nth-of-type(1) > input:nth-of-type(1)",
+						},
+						{
+							Code:     domain.FormIssueUnlabeledField,
+							Severity: domain.SeverityWarning,
+							Message:  `field "attachment" has no label`,
+							Selector: "html > body > form:nth-of-type(1) > input:nth-of-type(2)",
+						},
+						{
+							Code:     domain.FormIssueUnrestrictedUpload,
+							Severity: domain.SeverityWarning,
+							Message:  `file input "attachment" accepts any file type; restrict it with accept`,
+							Selector: "html > body > form:nth-of-type(1) > input:nth-of-type(2)",
+						},
+					},
+				},
+				{
+					Index:      1,
+					Method:     "POST",
+					Action:     "https://example.com/",
+					Enctype:    "application/x-www-form-urlencoded",
+					NoValidate: true,
+					Fields: []domain.FormField{
+						{Name: "email", Type: "email", Required: true},
+					},
+					Findings: []domain.Finding{
+						{
+							Code:     domain.FormIssueValidationDisabled,
+							Severity: domain.SeverityInfo,
+							Message:  "form sets novalidate, so the browser does not check its constraints",
+						},
+						{
+							Code:     domain.FormIssueUnlabeledField,
+							Severity: domain.SeverityWarning,
+							Message:  `field "email" has no label`,
+							Selector: "html > body > form:nth-of-type(2) > input",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			analysis := suite.analyzer.ExtractForms(tc.html, "https://example.com/")
+
+			assert.Equal(t, tc.expected, analysis.Forms)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package adapters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+type outlineNode struct {
+	heading  domain.HeadingNode
+	children []*outlineNode
+}
+
+func (a *HTMLAnalyzer) ExtractHeadingOutline(html string) domain.HeadingOutline {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		a.logger.Error().Err(err).Msg("failed to parse HTML for heading outline extraction")
+
+		return domain.HeadingOutline{Headings: []domain.HeadingNode{}, Issues: []domain.Finding{}}
+	}
+
+	visitor := &headingOutlineVisitor{}
+	walkDocument(doc, visitor)
+	outline := visitor.outline()
+
+	a.logger.Debug().
+		Int("top_level_headings", len(outline.Headings)).
+		Int("issues", len(outline.Issues)).
+		Msg("extracted heading outline")
+
+	return outline
+}
+
+// headingOutlineVisitor nests every heading under the closest preceding heading of a lower level.
+type headingOutlineVisitor struct {
+	roots    []*outlineNode
+	stack    []*outlineNode
+	issues   []domain.Finding
+	position int
+	previous int
+	h1Count  int
+}
+
+func (v *headingOutlineVisitor) VisitElement(s *goquery.Selection) {
+	level := headingLevel(goquery.NodeName(s))
+	if level == 0 {
+		return
+	}
+
+	text := strings.Join(strings.Fields(s.Text()), " ")
+
+	if level == 1 {
+		v.h1Count++
+	}
+
+	if v.previous > 0 && level > v.previous+1 {
+		v.issues = append(v.issues, domain.Finding{
+			Code:     domain.HeadingIssueLevelJump,
+			Severity: domain.SeverityWarning,
+			Message:  fmt.Sprintf("heading level jumps from h%d to h%d", v.previous, level),
+			Selector: selectorPath(s),
+		})
+	}
+	v.previous = level
+
+	if text == "" && strings.TrimSpace(s.AttrOr("aria-label", "")) == "" {
+		v.issues = append(v.issues, domain.Finding{
+			Code:     domain.HeadingIssueEmpty,
+			Severity: domain.SeverityWarning,
+			Message:  fmt.Sprintf("h%d has no text", level),
+			Selector: selectorPath(s),
+		})
+	}
+
+	if isAriaHidden(s) {
+		v.issues = append(v.issues, domain.Finding{
+			Code:     domain.HeadingIssueAriaHidden,
+			Severity: domain.SeverityWarning,
+			Message:  fmt.Sprintf("h%d is hidden from assistive technologies with aria-hidden", level),
+			Selector: selectorPath(s),
+		})
+	}
+
+	node := &outlineNode{heading: domain.HeadingNode{
+		Level:    level,
+		Text:     text,
+		Position: v.position,
+	}}
+	v.position++
+
+	for len(v.stack) > 0 && v.stack[len(v.stack)-1].heading.Level >= level {
+		v.stack = v.stack[:len(v.stack)-1]
+	}
+
+	if len(v.stack) == 0 {
+		v.roots = append(v.roots, node)
+	} else {
+		parent := v.stack[len(v.stack)-1]
+		parent.children = append(parent.children, node)
+	}
+
+	v.stack = append(v.stack, node)
+}
+
+func (v *headingOutlineVisitor) outline() domain.HeadingOutline {
+	issues := append([]domain.Finding{}, v.issues...)
+
+	if v.h1Count > 1 {
+		issues = append(issues, domain.Finding{
+			Code:     domain.HeadingIssueMultipleH1,
+			Severity: domain.SeverityWarning,
+			Message:  fmt.Sprintf("page has %d h1 headings", v.h1Count),
+		})
+	}
+
+	return domain.HeadingOutline{
+		Headings: toHeadingNodes(v.roots),
+		Issues:   issues,
+	}
+}
+
+func (v *headingOutlineVisitor) Apply(results *domain.AnalysisData) {
+	outline := v.outline()
+	results.HeadingOutline = &outline
+}
+
+func toHeadingNodes(nodes []*outlineNode) []domain.HeadingNode {
+	headings := make([]domain.HeadingNode, 0, len(nodes))
+
+	for _, node := range nodes {
+		heading := node.heading
+		if len(node.children) > 0 {
+			heading.Children = toHeadingNodes(node.children)
+		}
+
+		headings = append(headings, heading)
+	}
+
+	return headings
+}
+
+func isAriaHidden(s *goquery.Selection) bool {
+	for node := s; node.Length() > 0; node = node.Parent() {
+		if strings.EqualFold(strings.TrimSpace(node.AttrOr("aria-hidden", "")), "true") {
+			return true
+		}
+	}
+
+	return false
+}
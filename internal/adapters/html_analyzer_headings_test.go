@@ -0,0 +1,114 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHTMLAnalyzer_ExtractHeadingOutline tests the heading outline tree and its validation
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractHeadingOutline() {
+	cases := []struct {
+		name     string
+		html     string
+		expected domain.HeadingOutline
+	}{
+		{
+			name: "Well formed outline",
+			html: `<html><body>
+				<h1>Guide</h1>
+				<h2>Install</h2>
+				<h3>Linux</h3>
+				<h3>macOS</h3>
+				<h2>Usage</h2>
+			</body></html>`,
+			expected: domain.HeadingOutline{
+				Headings: []domain.HeadingNode{
+					{
+						Level: 1, Text: "Guide", Position: 0,
+						Children: []domain.HeadingNode{
+							{
+								Level: 2, Text: "Install", Position: 1,
+								Children: []domain.HeadingNode{
+									{Level: 3, Text: "Linux", Position: 2},
+									{Level: 3, Text: "macOS", Position: 3},
+								},
+							},
+							{Level: 2, Text: "Usage", Position: 4},
+						},
+					},
+				},
+				Issues: []domain.Finding{},
+			},
+		},
+		{
+			name: "Broken outline",
+			html: `<html><body>
+				<h1>First</h1>
+				<h4>Deep</h4>
+				<h1>Second</h1>
+				<h2>  </h2>
+				<div aria-hidden="true"><h2>Hidden</h2></div>
+			</body></html>`,
+			expected: domain.HeadingOutline{
+				Headings: []domain.HeadingNode{
+					{
+						Level: 1, Text: "First", Position: 0,
+						Children: []domain.HeadingNode{
+							{Level: 4, Text: "Deep", Position: 1},
+						},
+					},
+					{
+						Level: 1, Text: "Second", Position: 2,
+						Children: []domain.HeadingNode{
+							{Level: 2, Text: "", Position: 3},
+							{Level: 2, Text: "Hidden", Position: 4},
+						},
+					},
+				},
+				Issues: []domain.Finding{
+					{
+						Code:     domain.HeadingIssueLevelJump,
+						Severity: domain.SeverityWarning,
+						Message:  "heading level jumps from h1 to h4",
+						Selector: "html > body > h4",
+					},
+					{
+						Code:     domain.HeadingIssueEmpty,
+						Severity: domain.SeverityWarning,
+						Message:  "h2 has no text",
+						Selector: "html > body > h2",
+					},
+					{
+						Code:     domain.HeadingIssueAriaHidden,
+						Severity: domain.SeverityWarning,
+						Message:  "h2 is hidden from assistive technologies with aria-hidden",
+						Selector: "html > body > div > h2",
+					},
+					{
+						Code:     domain.HeadingIssueMultipleH1,
+						Severity: domain.SeverityWarning,
+						Message:  "page has 2 h1 headings",
+					},
+				},
+			},
+		},
+		{
+			name: "No headings",
+			html: `<html><body><p>Plain</p></body></html>`,
+			expected: domain.HeadingOutline{
+				Headings: []domain.HeadingNode{},
+				Issues:   []domain.Finding{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result := suite.analyzer.ExtractHeadingOutline(tc.html)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package adapters
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+// anchorTargets collects the fragments a document can be scrolled to: the id of any element and
+// the name of an a element.
+type anchorTargets map[string]bool
+
+func (t anchorTargets) visit(s *goquery.Selection) {
+	if id := s.AttrOr("id", ""); id != "" {
+		t[id] = true
+	}
+
+	if goquery.NodeName(s) == "a" {
+		if name := s.AttrOr("name", ""); name != "" {
+			t[name] = true
+		}
+	}
+}
+
+// documentAnchorTargets returns the fragments of a parsed document.
+func documentAnchorTargets(doc *goquery.Document) anchorTargets {
+	targets := make(anchorTargets)
+
+	doc.Find("[id], a[name]").Each(func(_ int, s *goquery.Selection) {
+		targets.visit(s)
+	})
+
+	return targets
+}
+
+// checkableFragment returns the fragment of a link that should point at an element, or an empty
+// string for links without one and for fragments browsers and scripts handle themselves: the top
+// of the page, client side routes ("#!/", "#/") and text fragments.
+func checkableFragment(linkURL *url.URL) string {
+	fragment := linkURL.Fragment
+
+	switch {
+	case fragment == "",
+		strings.EqualFold(fragment, "top"),
+		strings.HasPrefix(fragment, "!"),
+		strings.HasPrefix(fragment, "/"),
+		strings.HasPrefix(fragment, ":~:"):
+		return ""
+	}
+
+	return fragment
+}
+
+// sameDocument reports whether a link points at the page it was found on.
+func sameDocument(linkURL, pageURL *url.URL) bool {
+	return withoutFragment(linkURL) == withoutFragment(pageURL)
+}
+
+func withoutFragment(u *url.URL) string {
+	stripped := *u
+	stripped.Fragment = ""
+	stripped.RawFragment = ""
+
+	return stripped.String()
+}
+
+// danglingAnchorIssue reports a link whose fragment does not match any element of the document
+// it points at.
+func danglingAnchorIssue(link domain.Link, fragment, document string) domain.LinkIssue {
+	return domain.LinkIssue{
+		URL:      link.URL,
+		Code:     domain.LinkIssueDanglingAnchor,
+		Severity: domain.SeverityWarning,
+		Message:  fmt.Sprintf("no element with id or name %q in %s", fragment, document),
+		Scope:    link.Type,
+		Text:     link.Text,
+	}
+}
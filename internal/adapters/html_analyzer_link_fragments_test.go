@@ -0,0 +1,103 @@
+package adapters
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHTMLAnalyzer_SamePageAnchors tests that fragments pointing at the page itself are checked
+// against its ids and named anchors
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_SamePageAnchors() {
+	html := `<html><body>
+		<nav class="toc">
+			<a href="#intro">Introduction</a>
+			<a href="#setup">Setup</a>
+			<a href="#legacy">Legacy notes</a>
+			<a href="/docs/guide#faq">FAQ</a>
+			<a href="#missing">Missing section</a>
+			<a href="#missing">Missing again</a>
+			<a href="#top">Back to top</a>
+			<a href="#">Nowhere</a>
+			<a href="#!/settings">Settings</a>
+			<a href="/docs/other#gone">Other page</a>
+		</nav>
+		<section id="intro"><h2>Introduction</h2></section>
+		<h2 id="setup">Setup</h2>
+		<a name="legacy"></a>
+		<div name="faq"></div>
+	</body></html>`
+
+	suite.t.Run("Reports fragments missing from the page", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := suite.analyzer.Analyze(t.Context(), parsePage(t, "https://example.com/docs/guide", html), domain.AnalysisOptions{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []domain.LinkIssue{
+			{
+				URL:      "https://example.com/docs/guide#faq",
+				Code:     domain.LinkIssueDanglingAnchor,
+				Severity: domain.SeverityWarning,
+				Message:  `no element with id or name "faq" in the page`,
+				Scope:    domain.LinkTypeInternal,
+				Text:     "FAQ",
+			},
+			{
+				URL:      "https://example.com/docs/guide#missing",
+				Code:     domain.LinkIssueDanglingAnchor,
+				Severity: domain.SeverityWarning,
+				Message:  `no element with id or name "missing" in the page`,
+				Scope:    domain.LinkTypeInternal,
+				Text:     "Missing section",
+			},
+		}, results.Links.LinkIssues)
+	})
+
+	suite.t.Run("Keeps fragment only links out of the link list", func(t *testing.T) {
+		t.Parallel()
+
+		links, err := suite.analyzer.ExtractLinks(html, "https://example.com/docs/guide")
+		require.NoError(t, err)
+
+		urls := make([]string, 0, len(links))
+		for _, link := range links {
+			urls = append(urls, link.URL)
+		}
+
+		assert.Equal(t, []string{"https://example.com/docs/guide#faq", "https://example.com/docs/other#gone"}, urls)
+	})
+}
+
+func TestCheckableFragment(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{name: "Element fragment", url: "https://example.com/docs#install", expected: "install"},
+		{name: "Encoded fragment", url: "https://example.com/docs#caf%C3%A9", expected: "café"},
+		{name: "No fragment", url: "https://example.com/docs", expected: ""},
+		{name: "Empty fragment", url: "https://example.com/docs#", expected: ""},
+		{name: "Top of the page", url: "https://example.com/docs#top", expected: ""},
+		{name: "Hashbang route", url: "https://example.com/app#!/settings", expected: ""},
+		{name: "Hash route", url: "https://example.com/app#/settings", expected: ""},
+		{name: "Text fragment", url: "https://example.com/docs#:~:text=install", expected: ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			linkURL, err := url.Parse(tc.url)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, checkableFragment(linkURL))
+		})
+	}
+}
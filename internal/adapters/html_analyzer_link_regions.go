@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+var (
+	// regionElements are the semantic elements that mark a region of the page.
+	regionElements = map[string]domain.LinkRegion{
+		"nav":     domain.LinkRegionNavigation,
+		"header":  domain.LinkRegionHeader,
+		"footer":  domain.LinkRegionFooter,
+		"aside":   domain.LinkRegionSidebar,
+		"main":    domain.LinkRegionContent,
+		"article": domain.LinkRegionContent,
+	}
+
+	// regionRoles are the ARIA landmark roles that mark a region of the page.
+	regionRoles = map[string]domain.LinkRegion{
+		"navigation":    domain.LinkRegionNavigation,
+		"banner":        domain.LinkRegionHeader,
+		"contentinfo":   domain.LinkRegionFooter,
+		"complementary": domain.LinkRegionSidebar,
+		"main":          domain.LinkRegionContent,
+	}
+
+	// regionTokens are the class and id words themes commonly give their regions, checked in
+	// order so that a "footer-nav" counts as navigation.
+	regionTokens = []struct {
+		region domain.LinkRegion
+		tokens []string
+	}{
+		{domain.LinkRegionNavigation, []string{"nav", "navbar", "navigation", "menu", "menubar", "breadcrumb", "breadcrumbs", "pagination"}},
+		{domain.LinkRegionFooter, []string{"footer", "colophon"}},
+		{domain.LinkRegionHeader, []string{"header", "masthead", "topbar"}},
+		{domain.LinkRegionSidebar, []string{"sidebar", "aside", "widget", "widgets"}},
+		{domain.LinkRegionContent, []string{"content", "main", "article", "post", "entry", "story"}},
+	}
+
+	regionTokenSeparator = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// linkRegion returns the region of the page an element sits in, decided by its closest ancestor
+// that is a landmark element, carries a landmark role or has a telling class or id. Links outside
+// any region count as content.
+func linkRegion(s *goquery.Selection) domain.LinkRegion {
+	for parent := s.Parent(); parent.Length() > 0; parent = parent.Parent() {
+		name := goquery.NodeName(parent)
+		if name == "body" || name == "html" {
+			break
+		}
+
+		if region, ok := regionRoles[strings.ToLower(strings.TrimSpace(parent.AttrOr("role", "")))]; ok {
+			return region
+		}
+
+		if region, ok := regionElements[name]; ok {
+			// A header or footer inside sectioning content belongs to that section, not to the page.
+			if (name != "header" && name != "footer") || parent.ParentsFiltered("article, aside, main, nav, section").Length() == 0 {
+				return region
+			}
+		}
+
+		tokens := regionTokenSeparator.Split(strings.ToLower(parent.AttrOr("class", "")+" "+parent.AttrOr("id", "")), -1)
+		for _, candidate := range regionTokens {
+			if slices.ContainsFunc(tokens, func(token string) bool {
+				return slices.Contains(candidate.tokens, token)
+			}) {
+				return candidate.region
+			}
+		}
+	}
+
+	return domain.LinkRegionContent
+}
+
+// anchorText returns the text a link is announced with, falling back to its aria-label, the alt
+// text of an image inside it and its title.
+func anchorText(s *goquery.Selection) string {
+	if text := normalizeText(s.Text()); text != "" {
+		return text
+	}
+
+	if label := normalizeText(s.AttrOr("aria-label", "")); label != "" {
+		return label
+	}
+
+	if alt := normalizeText(s.Find("img[alt]").First().AttrOr("alt", "")); alt != "" {
+		return alt
+	}
+
+	return normalizeText(s.AttrOr("title", ""))
+}
+
+// relTokens returns the distinct lower cased tokens of a rel attribute.
+func relTokens(s *goquery.Selection) []string {
+	tokens := []string{}
+
+	for _, token := range strings.Fields(strings.ToLower(s.AttrOr("rel", ""))) {
+		if !slices.Contains(tokens, token) {
+			tokens = append(tokens, token)
+		}
+	}
+
+	return tokens
+}
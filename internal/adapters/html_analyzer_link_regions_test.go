@@ -0,0 +1,88 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHTMLAnalyzer_ExtractLinksRegions tests the page region, text, rel and target of links
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractLinksRegions() {
+	html := `<html><body>
+		<header>
+			<a href="/">Home</a>
+			<nav><a href="/products">Products</a></nav>
+		</header>
+		<div class="breadcrumbs"><a href="/shop">Shop</a></div>
+		<main>
+			<article>
+				<header><a href="/authors/jane">Jane Doe</a></header>
+				<p><a href="https://partner.example.org/deal" rel="Sponsored noopener sponsored" target="_blank">
+					Partner   deal
+				</a></p>
+				<a href="/gallery"><img src="g.png" alt="Gallery"></a>
+				<footer><a href="/tags/go" title="Go articles"></a></footer>
+			</article>
+		</main>
+		<div id="sidebar"><a href="/archive" aria-label="Archive">&rarr;</a></div>
+		<div role="complementary"><a href="/popular">Popular</a></div>
+		<div class="site-footer">
+			<a href="https://social.example.net/shop" rel="nofollow ugc">Social</a>
+			<ul class="footer-nav"><li><a href="/imprint">Imprint</a></li></ul>
+		</div>
+		<p><a href="/loose">Loose</a></p>
+	</body></html>`
+
+	expected := []domain.Link{
+		{URL: "https://shop.example.com/", Type: domain.LinkTypeInternal, Region: domain.LinkRegionHeader, Text: "Home", Rel: []string{}},
+		{URL: "https://shop.example.com/products", Type: domain.LinkTypeInternal, Region: domain.LinkRegionNavigation, Text: "Products", Rel: []string{}},
+		{URL: "https://shop.example.com/shop", Type: domain.LinkTypeInternal, Region: domain.LinkRegionNavigation, Text: "Shop", Rel: []string{}},
+		{URL: "https://shop.example.com/authors/jane", Type: domain.LinkTypeInternal, Region: domain.LinkRegionContent, Text: "Jane Doe", Rel: []string{}},
+		{
+			URL:    "https://partner.example.org/deal",
+			Type:   domain.LinkTypeExternal,
+			Region: domain.LinkRegionContent,
+			Text:   "Partner deal",
+			Rel:    []string{"sponsored", "noopener"},
+			Target: "_blank",
+		},
+		{URL: "https://shop.example.com/gallery", Type: domain.LinkTypeInternal, Region: domain.LinkRegionContent, Text: "Gallery", Rel: []string{}},
+		{URL: "https://shop.example.com/tags/go", Type: domain.LinkTypeInternal, Region: domain.LinkRegionContent, Text: "Go articles", Rel: []string{}},
+		{URL: "https://shop.example.com/archive", Type: domain.LinkTypeInternal, Region: domain.LinkRegionSidebar, Text: "→", Rel: []string{}},
+		{URL: "https://shop.example.com/popular", Type: domain.LinkTypeInternal, Region: domain.LinkRegionSidebar, Text: "Popular", Rel: []string{}},
+		{
+			URL:    "https://social.example.net/shop",
+			Type:   domain.LinkTypeExternal,
+			Region: domain.LinkRegionFooter,
+			Text:   "Social",
+			Rel:    []string{"nofollow", "ugc"},
+		},
+		{URL: "https://shop.example.com/imprint", Type: domain.LinkTypeInternal, Region: domain.LinkRegionNavigation, Text: "Imprint", Rel: []string{}},
+		{URL: "https://shop.example.com/loose", Type: domain.LinkTypeInternal, Region: domain.LinkRegionContent, Text: "Loose", Rel: []string{}},
+	}
+
+	suite.t.Run("Links carry region, text, rel and target", func(t *testing.T) {
+		t.Parallel()
+		links, err := suite.analyzer.ExtractLinks(html, "https://shop.example.com/")
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, links)
+	})
+
+	suite.t.Run("Analysis counts links per region", func(t *testing.T) {
+		t.Parallel()
+		results, err := suite.analyzer.Analyze(t.Context(), parsePage(t, "https://shop.example.com/", html), domain.AnalysisOptions{})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[domain.LinkRegion]int{
+			domain.LinkRegionHeader:     1,
+			domain.LinkRegionNavigation: 3,
+			domain.LinkRegionContent:    5,
+			domain.LinkRegionSidebar:    2,
+			domain.LinkRegionFooter:     1,
+		}, results.Links.RegionCounts)
+		assert.Equal(t, expected, results.Links.Links)
+	})
+}
@@ -0,0 +1,291 @@
+package adapters
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+)
+
+const (
+	openGraphPrefix   = "og:"
+	twitterCardPrefix = "twitter:"
+)
+
+func (a *HTMLAnalyzer) ExtractMeta(html, baseURL string) domain.MetaAnalysis {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		a.logger.Error().Err(err).Msg("failed to parse HTML for meta extraction")
+
+		return domain.MetaAnalysis{Issues: []domain.Finding{}}
+	}
+
+	visitor, err := newMetaVisitor(baseURL, a.logger)
+	if err != nil {
+		a.logger.Error().Err(err).Msg("failed to parse base URL for meta extraction")
+
+		return domain.MetaAnalysis{Issues: []domain.Finding{}}
+	}
+
+	walkDocument(doc, visitor)
+	meta := visitor.analysis()
+
+	a.logger.Debug().
+		Int("keywords", len(meta.Keywords)).
+		Int("hreflang", len(meta.Hreflang)).
+		Int("open_graph", len(meta.OpenGraph)).
+		Int("twitter_card", len(meta.TwitterCard)).
+		Int("issues", len(meta.Issues)).
+		Msg("extracted meta analysis")
+
+	return meta
+}
+
+type metaVisitor struct {
+	logger           infrastructure.Logger
+	baseURL          *url.URL
+	meta             domain.MetaAnalysis
+	title            string
+	titleFound       bool
+	descriptionCount int
+	canonicals       int
+}
+
+func newMetaVisitor(baseURL string, logger infrastructure.Logger) (*metaVisitor, error) {
+	baseURLParsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metaVisitor{
+		logger:  logger,
+		baseURL: baseURLParsed,
+		meta: domain.MetaAnalysis{
+			OpenGraph:   map[string]string{},
+			TwitterCard: map[string]string{},
+		},
+	}, nil
+}
+
+func (v *metaVisitor) VisitElement(s *goquery.Selection) {
+	switch goquery.NodeName(s) {
+	case "title":
+		if !v.titleFound {
+			v.titleFound = true
+			v.title = strings.Join(strings.Fields(s.Text()), " ")
+		}
+	case "meta":
+		v.visitMeta(s)
+	case "link":
+		v.visitLink(s)
+	}
+}
+
+func (v *metaVisitor) visitMeta(s *goquery.Selection) {
+	if charset, ok := s.Attr("charset"); ok && v.meta.Charset == "" {
+		v.meta.Charset = strings.ToLower(strings.TrimSpace(charset))
+
+		return
+	}
+
+	content := strings.TrimSpace(s.AttrOr("content", ""))
+
+	if strings.EqualFold(strings.TrimSpace(s.AttrOr("http-equiv", "")), "content-type") {
+		if v.meta.Charset == "" {
+			v.meta.Charset = charsetFromContentType(content)
+		}
+
+		return
+	}
+
+	name := strings.ToLower(strings.TrimSpace(s.AttrOr("name", "")))
+	property := strings.ToLower(strings.TrimSpace(s.AttrOr("property", "")))
+
+	switch {
+	case name == "description":
+		v.descriptionCount++
+		if v.meta.Description == "" {
+			v.meta.Description = content
+		}
+	case name == "keywords":
+		v.meta.Keywords = append(v.meta.Keywords, splitList(content, ",")...)
+	case name == "robots":
+		v.meta.Robots = append(v.meta.Robots, splitList(strings.ToLower(content), ",")...)
+	case name == "viewport":
+		v.meta.Viewport = content
+	case strings.HasPrefix(property, openGraphPrefix):
+		setOnce(v.meta.OpenGraph, strings.TrimPrefix(property, openGraphPrefix), content)
+	case strings.HasPrefix(name, twitterCardPrefix):
+		setOnce(v.meta.TwitterCard, strings.TrimPrefix(name, twitterCardPrefix), content)
+	case strings.HasPrefix(property, twitterCardPrefix):
+		setOnce(v.meta.TwitterCard, strings.TrimPrefix(property, twitterCardPrefix), content)
+	}
+}
+
+func (v *metaVisitor) visitLink(s *goquery.Selection) {
+	rel, hasRel := s.Attr("rel")
+	href := strings.TrimSpace(s.AttrOr("href", ""))
+	if !hasRel || href == "" {
+		return
+	}
+
+	parsedHref, err := url.Parse(href)
+	if err != nil {
+		v.logger.Debug().
+			Err(err).
+			Str("href", href).
+			Msg("failed to parse meta link URL")
+		return
+	}
+
+	resolvedURL := v.baseURL.ResolveReference(parsedHref).String()
+
+	for _, relation := range strings.Fields(strings.ToLower(rel)) {
+		switch relation {
+		case "canonical":
+			v.canonicals++
+			if v.meta.CanonicalURL == "" {
+				v.meta.CanonicalURL = resolvedURL
+			}
+		case "alternate":
+			lang := strings.TrimSpace(s.AttrOr("hreflang", ""))
+			if lang == "" {
+				continue
+			}
+
+			v.meta.Hreflang = append(v.meta.Hreflang, domain.HreflangLink{
+				Lang: lang,
+				URL:  resolvedURL,
+			})
+		}
+	}
+}
+
+func (v *metaVisitor) analysis() domain.MetaAnalysis {
+	meta := v.meta
+	meta.Issues = metaIssues(meta, v.title, v.descriptionCount, v.canonicals)
+
+	return meta
+}
+
+func (v *metaVisitor) Apply(results *domain.AnalysisData) {
+	meta := v.analysis()
+	results.Meta = &meta
+}
+
+func metaIssues(meta domain.MetaAnalysis, title string, descriptionCount, canonicals int) []domain.Finding {
+	issues := []domain.Finding{}
+
+	switch titleLength := utf8.RuneCountInString(title); {
+	case titleLength == 0:
+		issues = append(issues, domain.Finding{
+			Code:     domain.MetaIssueMissingTitle,
+			Severity: domain.SeverityError,
+			Message:  "page has no <title>",
+		})
+	case titleLength > domain.RecommendedTitleLength:
+		issues = append(issues, domain.Finding{
+			Code:     domain.MetaIssueTitleTooLong,
+			Severity: domain.SeverityWarning,
+			Message: fmt.Sprintf("title is %d characters long, recommended maximum is %d",
+				titleLength, domain.RecommendedTitleLength),
+		})
+	}
+
+	switch descriptionLength := utf8.RuneCountInString(meta.Description); {
+	case descriptionCount == 0 || descriptionLength == 0:
+		issues = append(issues, domain.Finding{
+			Code:     domain.MetaIssueMissingDescription,
+			Severity: domain.SeverityWarning,
+			Message:  "page has no meta description",
+		})
+	case descriptionLength > domain.RecommendedDescriptionLength:
+		issues = append(issues, domain.Finding{
+			Code:     domain.MetaIssueDescriptionTooLong,
+			Severity: domain.SeverityInfo,
+			Message: fmt.Sprintf("meta description is %d characters long, recommended maximum is %d",
+				descriptionLength, domain.RecommendedDescriptionLength),
+		})
+	}
+
+	if descriptionCount > 1 {
+		issues = append(issues, domain.Finding{
+			Code:     domain.MetaIssueMultipleDescriptions,
+			Severity: domain.SeverityWarning,
+			Message:  fmt.Sprintf("page declares %d meta descriptions", descriptionCount),
+		})
+	}
+
+	if canonicals > 1 {
+		issues = append(issues, domain.Finding{
+			Code:     domain.MetaIssueMultipleCanonicals,
+			Severity: domain.SeverityError,
+			Message:  fmt.Sprintf("page declares %d canonical URLs", canonicals),
+		})
+	}
+
+	if meta.Viewport == "" {
+		issues = append(issues, domain.Finding{
+			Code:     domain.MetaIssueMissingViewport,
+			Severity: domain.SeverityWarning,
+			Message:  "page has no viewport meta tag",
+		})
+	}
+
+	if meta.Charset == "" {
+		issues = append(issues, domain.Finding{
+			Code:     domain.MetaIssueMissingCharset,
+			Severity: domain.SeverityInfo,
+			Message:  "page does not declare a character encoding",
+		})
+	}
+
+	for _, directive := range meta.Robots {
+		if directive == "noindex" || directive == "none" {
+			issues = append(issues, domain.Finding{
+				Code:     domain.MetaIssueNoIndex,
+				Severity: domain.SeverityInfo,
+				Message:  "robots meta tag prevents the page from being indexed",
+			})
+
+			break
+		}
+	}
+
+	return issues
+}
+
+func charsetFromContentType(contentType string) string {
+	for _, param := range strings.Split(contentType, ";") {
+		key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if found && strings.EqualFold(strings.TrimSpace(key), "charset") {
+			return strings.ToLower(strings.Trim(strings.TrimSpace(value), `"'`))
+		}
+	}
+
+	return ""
+}
+
+func splitList(value, separator string) []string {
+	var items []string
+
+	for _, item := range strings.Split(value, separator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func setOnce(values map[string]string, key, value string) {
+	if _, exists := values[key]; exists || key == "" {
+		return
+	}
+
+	values[key] = value
+}
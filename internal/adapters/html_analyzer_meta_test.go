@@ -0,0 +1,130 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHTMLAnalyzer_ExtractMeta tests SEO meta tag extraction
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractMeta() {
+	cases := []struct {
+		name     string
+		html     string
+		baseURL  string
+		expected domain.MetaAnalysis
+		issues   []string
+	}{
+		{
+			name: "Complete head",
+			html: `<html><head>
+				<meta charset="UTF-8">
+				<title>Example page</title>
+				<meta name="description" content="An example page">
+				<meta name="keywords" content="go, html , , seo">
+				<meta name="robots" content="Index, Follow">
+				<meta name="viewport" content="width=device-width, initial-scale=1">
+				<link rel="canonical" href="/page">
+				<link rel="alternate" hreflang="de" href="https://example.com/de/page">
+				<link rel="alternate" type="application/rss+xml" href="/feed">
+				<meta property="og:title" content="OG title">
+				<meta property="og:type" content="website">
+				<meta name="twitter:card" content="summary">
+			</head></html>`,
+			baseURL: "https://example.com/section/",
+			expected: domain.MetaAnalysis{
+				Description:  "An example page",
+				Keywords:     []string{"go", "html", "seo"},
+				Robots:       []string{"index", "follow"},
+				Viewport:     "width=device-width, initial-scale=1",
+				Charset:      "utf-8",
+				CanonicalURL: "https://example.com/page",
+				Hreflang: []domain.HreflangLink{
+					{Lang: "de", URL: "https://example.com/de/page"},
+				},
+				OpenGraph:   map[string]string{"title": "OG title", "type": "website"},
+				TwitterCard: map[string]string{"card": "summary"},
+			},
+			issues: []string{},
+		},
+		{
+			name: "Charset from http-equiv",
+			html: `<html><head>
+				<meta http-equiv="Content-Type" content="text/html; charset=ISO-8859-1">
+				<title>Legacy</title>
+				<meta name="description" content="Legacy page">
+				<meta name="viewport" content="width=device-width">
+			</head></html>`,
+			baseURL: "https://example.com",
+			expected: domain.MetaAnalysis{
+				Description: "Legacy page",
+				Viewport:    "width=device-width",
+				Charset:     "iso-8859-1",
+				OpenGraph:   map[string]string{},
+				TwitterCard: map[string]string{},
+			},
+			issues: []string{},
+		},
+		{
+			name:    "Empty head",
+			html:    `<html><head></head><body></body></html>`,
+			baseURL: "https://example.com",
+			expected: domain.MetaAnalysis{
+				OpenGraph:   map[string]string{},
+				TwitterCard: map[string]string{},
+			},
+			issues: []string{
+				domain.MetaIssueMissingTitle,
+				domain.MetaIssueMissingDescription,
+				domain.MetaIssueMissingViewport,
+				domain.MetaIssueMissingCharset,
+			},
+		},
+		{
+			name: "Duplicated tags and long title",
+			html: `<html><head>
+				<meta charset="utf-8">
+				<title>This title is definitely much longer than the sixty characters search engines show</title>
+				<meta name="description" content="First">
+				<meta name="description" content="Second">
+				<meta name="viewport" content="width=device-width">
+				<meta name="robots" content="noindex">
+				<link rel="canonical" href="https://example.com/a">
+				<link rel="canonical" href="https://example.com/b">
+			</head></html>`,
+			baseURL: "https://example.com",
+			expected: domain.MetaAnalysis{
+				Description:  "First",
+				Robots:       []string{"noindex"},
+				Viewport:     "width=device-width",
+				Charset:      "utf-8",
+				CanonicalURL: "https://example.com/a",
+				OpenGraph:    map[string]string{},
+				TwitterCard:  map[string]string{},
+			},
+			issues: []string{
+				domain.MetaIssueTitleTooLong,
+				domain.MetaIssueMultipleDescriptions,
+				domain.MetaIssueMultipleCanonicals,
+				domain.MetaIssueNoIndex,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result := suite.analyzer.ExtractMeta(tc.html, tc.baseURL)
+
+			codes := make([]string, 0, len(result.Issues))
+			for _, issue := range result.Issues {
+				codes = append(codes, issue.Code)
+			}
+			assert.ElementsMatch(t, tc.issues, codes)
+
+			result.Issues = nil
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package adapters
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+)
+
+// passivePreloadTypes are the preload destinations browsers treat as optionally-blockable.
+var passivePreloadTypes = map[string]bool{
+	"image": true,
+	"video": true,
+	"audio": true,
+}
+
+// ExtractMixedContent returns the plain HTTP URLs an HTTPS page loads or submits to, or nil when
+// the page itself is not served over HTTPS.
+func (a *HTMLAnalyzer) ExtractMixedContent(html, baseURL string) *domain.MixedContentReport {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		a.logger.Error().Err(err).Msg("failed to parse HTML for mixed content detection")
+
+		return nil
+	}
+
+	visitor, err := newMixedContentVisitor(baseURL, a.logger)
+	if err != nil {
+		a.logger.Error().Err(err).Msg("failed to parse base URL for mixed content detection")
+
+		return nil
+	}
+
+	walkDocument(doc, visitor)
+
+	return visitor.report()
+}
+
+type mixedContentVisitor struct {
+	logger  infrastructure.Logger
+	baseURL *url.URL
+	items   []domain.MixedContentItem
+	seen    map[domain.MixedContentItem]bool
+}
+
+func newMixedContentVisitor(baseURL string, logger infrastructure.Logger) (*mixedContentVisitor, error) {
+	baseURLParsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mixedContentVisitor{
+		logger:  logger,
+		baseURL: baseURLParsed,
+		seen:    make(map[domain.MixedContentItem]bool),
+	}, nil
+}
+
+func (v *mixedContentVisitor) VisitElement(s *goquery.Selection) {
+	if v.baseURL.Scheme != "https" {
+		return
+	}
+
+	switch name := goquery.NodeName(s); name {
+	case "script", "iframe", "frame", "embed":
+		v.add(s, name, "src", domain.MixedContentActive)
+	case "object":
+		v.add(s, name, "data", domain.MixedContentActive)
+	case "link":
+		v.visitLink(s)
+	case "img":
+		v.add(s, name, "src", domain.MixedContentPassive)
+		v.addSrcset(s, name)
+	case "video":
+		v.add(s, name, "src", domain.MixedContentPassive)
+		v.add(s, name, "poster", domain.MixedContentPassive)
+	case "audio":
+		v.add(s, name, "src", domain.MixedContentPassive)
+	case "source":
+		v.add(s, name, "src", domain.MixedContentPassive)
+		v.addSrcset(s, name)
+	case "form":
+		v.add(s, name, "action", domain.MixedContentForm)
+	case "button", "input":
+		v.add(s, name, "formaction", domain.MixedContentForm)
+	}
+}
+
+func (v *mixedContentVisitor) visitLink(s *goquery.Selection) {
+	for _, relation := range strings.Fields(strings.ToLower(s.AttrOr("rel", ""))) {
+		switch relation {
+		case "stylesheet", "modulepreload":
+			v.add(s, "link", "href", domain.MixedContentActive)
+		case "preload":
+			kind := domain.MixedContentActive
+			if passivePreloadTypes[strings.ToLower(s.AttrOr("as", ""))] {
+				kind = domain.MixedContentPassive
+			}
+
+			v.add(s, "link", "href", kind)
+		case "icon", "apple-touch-icon":
+			v.add(s, "link", "href", domain.MixedContentPassive)
+		}
+	}
+}
+
+func (v *mixedContentVisitor) addSrcset(s *goquery.Selection, element string) {
+	for _, candidate := range strings.Split(s.AttrOr("srcset", ""), ",") {
+		if fields := strings.Fields(candidate); len(fields) > 0 {
+			v.addURL(fields[0], element, domain.MixedContentPassive)
+		}
+	}
+}
+
+func (v *mixedContentVisitor) add(s *goquery.Selection, element, attribute string, kind domain.MixedContentKind) {
+	v.addURL(s.AttrOr(attribute, ""), element, kind)
+}
+
+func (v *mixedContentVisitor) addURL(ref, element string, kind domain.MixedContentKind) {
+	if strings.TrimSpace(ref) == "" {
+		return
+	}
+
+	resolvedURL, err := resolveURL(v.baseURL, ref)
+	if err != nil {
+		v.logger.Debug().
+			Err(err).
+			Str("ref", ref).
+			Msg("failed to parse URL for mixed content detection")
+
+		return
+	}
+
+	if resolvedURL.Scheme != "http" {
+		return
+	}
+
+	item := domain.MixedContentItem{URL: resolvedURL.String(), Element: element, Kind: kind}
+	if v.seen[item] {
+		return
+	}
+	v.seen[item] = true
+
+	v.items = append(v.items, item)
+}
+
+func (v *mixedContentVisitor) report() *domain.MixedContentReport {
+	if v.baseURL.Scheme != "https" {
+		return nil
+	}
+
+	report := &domain.MixedContentReport{Items: []domain.MixedContentItem{}}
+	for _, item := range v.items {
+		switch item.Kind {
+		case domain.MixedContentActive:
+			report.ActiveCount++
+		case domain.MixedContentPassive:
+			report.PassiveCount++
+		case domain.MixedContentForm:
+			report.FormCount++
+		}
+	}
+
+	if len(v.items) > 0 {
+		report.Items = v.items
+	}
+
+	return report
+}
+
+func (v *mixedContentVisitor) Apply(results *domain.AnalysisData) {
+	results.MixedContent = v.report()
+}
@@ -0,0 +1,79 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHTMLAnalyzer_ExtractMixedContent tests mixed content detection on HTTPS pages
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractMixedContent() {
+	cases := []struct {
+		name     string
+		html     string
+		baseURL  string
+		expected *domain.MixedContentReport
+	}{
+		{
+			name: "Active, passive and form mixed content",
+			html: `<html><head>
+				<link rel="stylesheet" href="http://cdn.example.net/site.css">
+				<link rel="preload" href="http://cdn.example.net/hero.webp" as="image">
+				<link rel="icon" href="//example.com/favicon.ico">
+				<script src="http://cdn.example.net/app.js"></script>
+				<script src="/js/local.js"></script>
+			</head><body>
+				<img src="http://img.example.net/a.png" srcset="http://img.example.net/a-2x.png 2x, /a-3x.png 3x">
+				<iframe src="http://widgets.example.org/embed"></iframe>
+				<video poster="http://media.example.org/poster.jpg"><source src="https://media.example.org/clip.mp4"></video>
+				<object data="http://legacy.example.org/movie.swf"></object>
+				<form action="http://example.com/subscribe"><button formaction="http://example.com/alt">Go</button></form>
+				<form action="/search"></form>
+				<a href="http://example.org/plain-link">Links are not mixed content</a>
+				<img src="http://img.example.net/a.png">
+			</body></html>`,
+			baseURL: "https://example.com/",
+			expected: &domain.MixedContentReport{
+				ActiveCount:  4,
+				PassiveCount: 4,
+				FormCount:    2,
+				Items: []domain.MixedContentItem{
+					{URL: "http://cdn.example.net/site.css", Element: "link", Kind: domain.MixedContentActive},
+					{URL: "http://cdn.example.net/hero.webp", Element: "link", Kind: domain.MixedContentPassive},
+					{URL: "http://cdn.example.net/app.js", Element: "script", Kind: domain.MixedContentActive},
+					{URL: "http://img.example.net/a.png", Element: "img", Kind: domain.MixedContentPassive},
+					{URL: "http://img.example.net/a-2x.png", Element: "img", Kind: domain.MixedContentPassive},
+					{URL: "http://widgets.example.org/embed", Element: "iframe", Kind: domain.MixedContentActive},
+					{URL: "http://media.example.org/poster.jpg", Element: "video", Kind: domain.MixedContentPassive},
+					{URL: "http://legacy.example.org/movie.swf", Element: "object", Kind: domain.MixedContentActive},
+					{URL: "http://example.com/subscribe", Element: "form", Kind: domain.MixedContentForm},
+					{URL: "http://example.com/alt", Element: "button", Kind: domain.MixedContentForm},
+				},
+			},
+		},
+		{
+			name:    "Secure page without mixed content",
+			html:    `<html><head><script src="https://cdn.example.net/app.js"></script></head><body><img src="logo.png"></body></html>`,
+			baseURL: "https://example.com/",
+			expected: &domain.MixedContentReport{
+				Items: []domain.MixedContentItem{},
+			},
+		},
+		{
+			name:     "Plain HTTP page is not assessed",
+			html:     `<html><head><script src="http://cdn.example.net/app.js"></script></head></html>`,
+			baseURL:  "http://example.com/",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result := suite.analyzer.ExtractMixedContent(tc.html, tc.baseURL)
+
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package adapters
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+)
+
+const (
+	// renderBlockingScriptsHighImpact is the number of blocking scripts from which the hint is rated high.
+	renderBlockingScriptsHighImpact = 3
+	// renderBlockingStylesheetsMediumImpact is the number of blocking stylesheets from which the hint is rated medium.
+	renderBlockingStylesheetsMediumImpact = 3
+	// imagesWithoutDimensionsHighImpact is the number of unsized images from which the hint is rated high.
+	imagesWithoutDimensionsHighImpact = 5
+
+	inlineBytesMediumImpact = 50 * 1024
+	inlineBytesHighImpact   = 150 * 1024
+
+	// minCompressibleBytes is the document size below which compression is not worth a hint.
+	minCompressibleBytes   = 1024
+	uncompressedHighImpact = 100 * 1024
+	largeHTMLMediumImpact  = 500 * 1024
+	largeHTMLHighImpact    = 1024 * 1024
+	maxHintResources       = 20
+)
+
+func (a *HTMLAnalyzer) ExtractPerformance(content *domain.WebPageContent) domain.PerformanceReport {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content.HTML))
+	if err != nil {
+		a.logger.Error().Err(err).Msg("failed to parse HTML for performance report")
+
+		return emptyPerformanceReport()
+	}
+
+	visitor, err := newPerformanceVisitor(
+		content.URL,
+		content.Headers,
+		bodyBytes(content.HTML, content.DecodedBytes),
+		int(content.TransferredBytes),
+		a.logger,
+	)
+	if err != nil {
+		a.logger.Error().Err(err).Msg("failed to parse base URL for performance report")
+
+		return emptyPerformanceReport()
+	}
+
+	walkDocument(doc, visitor)
+
+	return visitor.report()
+}
+
+type performanceVisitor struct {
+	logger                  infrastructure.Logger
+	baseURL                 *url.URL
+	headers                 http.Header
+	htmlBytes               int
+	transferredBytes        int
+	inlineScriptBytes       int
+	inlineStyleBytes        int
+	blockingScripts         []*url.URL
+	blockingStylesheets     []*url.URL
+	imagesWithoutDimensions []string
+	preconnectedHosts       map[string]bool
+	preloadedURLs           map[string]bool
+}
+
+// newPerformanceVisitor creates a visitor reporting a page of htmlBytes once its Content-Encoding
+// was decoded, sent in transferredBytes.
+func newPerformanceVisitor(
+	pageURL string,
+	headers http.Header,
+	htmlBytes, transferredBytes int,
+	logger infrastructure.Logger,
+) (*performanceVisitor, error) {
+	baseURLParsed, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &performanceVisitor{
+		logger:            logger,
+		baseURL:           baseURLParsed,
+		headers:           headers,
+		htmlBytes:         htmlBytes,
+		transferredBytes:  transferredBytes,
+		preconnectedHosts: make(map[string]bool),
+		preloadedURLs:     make(map[string]bool),
+	}, nil
+}
+
+func (v *performanceVisitor) VisitElement(s *goquery.Selection) {
+	switch goquery.NodeName(s) {
+	case "script":
+		src, hasSrc := s.Attr("src")
+		if !hasSrc {
+			v.inlineScriptBytes += len(s.Text())
+
+			return
+		}
+
+		_, async := s.Attr("async")
+		_, deferred := s.Attr("defer")
+		isModule := strings.EqualFold(strings.TrimSpace(s.AttrOr("type", "")), "module")
+
+		if !async && !deferred && !isModule && inHead(s) {
+			v.blockingScripts = v.appendResolved(v.blockingScripts, src)
+		}
+	case "style":
+		v.inlineStyleBytes += len(s.Text())
+	case "link":
+		v.visitLink(s)
+	case "img":
+		_, hasWidth := s.Attr("width")
+		_, hasHeight := s.Attr("height")
+
+		if !hasWidth || !hasHeight {
+			v.imagesWithoutDimensions = append(v.imagesWithoutDimensions, s.AttrOr("src", ""))
+		}
+	}
+}
+
+func (v *performanceVisitor) visitLink(s *goquery.Selection) {
+	href := s.AttrOr("href", "")
+
+	for _, relation := range strings.Fields(strings.ToLower(s.AttrOr("rel", ""))) {
+		switch relation {
+		case "stylesheet":
+			_, disabled := s.Attr("disabled")
+			if !disabled && blocksRendering(s.AttrOr("media", "")) && inHead(s) {
+				v.blockingStylesheets = v.appendResolved(v.blockingStylesheets, href)
+			}
+		case "preconnect", "dns-prefetch":
+			if resolvedURL, err := resolveURL(v.baseURL, href); err == nil {
+				v.preconnectedHosts[strings.ToLower(resolvedURL.Hostname())] = true
+			}
+		case "preload", "modulepreload":
+			if resolvedURL, err := resolveURL(v.baseURL, href); err == nil {
+				v.preloadedURLs[resolvedURL.String()] = true
+			}
+		}
+	}
+}
+
+func (v *performanceVisitor) appendResolved(resources []*url.URL, ref string) []*url.URL {
+	resolvedURL, err := resolveURL(v.baseURL, ref)
+	if err != nil {
+		v.logger.Debug().
+			Err(err).
+			Str("ref", ref).
+			Msg("failed to parse resource URL")
+
+		return resources
+	}
+
+	return append(resources, resolvedURL)
+}
+
+func (v *performanceVisitor) report() domain.PerformanceReport {
+	report := emptyPerformanceReport()
+	report.HTMLBytes = v.htmlBytes
+	report.TransferredBytes = v.transferredBytes
+	report.InlineScriptBytes = v.inlineScriptBytes
+	report.InlineStyleBytes = v.inlineStyleBytes
+	report.ImagesWithoutDimensions = len(v.imagesWithoutDimensions)
+	report.ContentEncoding = v.headers.Get("Content-Encoding")
+	report.Compressed = report.ContentEncoding != "" && !strings.EqualFold(report.ContentEncoding, "identity")
+	report.Cache = domain.CacheHeaders{
+		CacheControl: v.headers.Get("Cache-Control"),
+		Expires:      v.headers.Get("Expires"),
+		ETag:         v.headers.Get("ETag"),
+		LastModified: v.headers.Get("Last-Modified"),
+	}
+
+	for _, script := range v.blockingScripts {
+		report.RenderBlockingScripts = append(report.RenderBlockingScripts, script.String())
+	}
+
+	for _, stylesheet := range v.blockingStylesheets {
+		report.RenderBlockingStylesheets = append(report.RenderBlockingStylesheets, stylesheet.String())
+	}
+
+	if count := len(report.RenderBlockingScripts); count > 0 {
+		impact := domain.ImpactMedium
+		if count >= renderBlockingScriptsHighImpact {
+			impact = domain.ImpactHigh
+		}
+
+		report.Hints = append(report.Hints, performanceHint(domain.PerfHintRenderBlockingScript, impact,
+			fmt.Sprintf("%d script(s) in <head> block rendering; load them with defer or async", count),
+			report.RenderBlockingScripts))
+	}
+
+	if count := len(report.RenderBlockingStylesheets); count > 0 {
+		impact := domain.ImpactLow
+		if count >= renderBlockingStylesheetsMediumImpact {
+			impact = domain.ImpactMedium
+		}
+
+		report.Hints = append(report.Hints, performanceHint(domain.PerfHintRenderBlockingStylesheet, impact,
+			fmt.Sprintf("%d stylesheet(s) in <head> block rendering; inline critical CSS or combine them", count),
+			report.RenderBlockingStylesheets))
+	}
+
+	if count := report.ImagesWithoutDimensions; count > 0 {
+		impact := domain.ImpactMedium
+		if count >= imagesWithoutDimensionsHighImpact {
+			impact = domain.ImpactHigh
+		}
+
+		report.Hints = append(report.Hints, performanceHint(domain.PerfHintImageMissingDimensions, impact,
+			fmt.Sprintf("%d image(s) have no width and height, which causes layout shifts", count),
+			nonEmpty(v.imagesWithoutDimensions)))
+	}
+
+	if origins := v.unconnectedOrigins(); len(origins) > 0 {
+		report.Hints = append(report.Hints, performanceHint(domain.PerfHintMissingPreconnect, domain.ImpactMedium,
+			fmt.Sprintf("%d third-party origin(s) serve render-blocking resources without a preconnect or preload", len(origins)),
+			origins))
+	}
+
+	if impact, ok := inlineBytesImpact(report.InlineScriptBytes); ok {
+		report.Hints = append(report.Hints, performanceHint(domain.PerfHintLargeInlineScripts, impact,
+			fmt.Sprintf("inline scripts add %d bytes that cannot be cached separately", report.InlineScriptBytes), nil))
+	}
+
+	if impact, ok := inlineBytesImpact(report.InlineStyleBytes); ok {
+		report.Hints = append(report.Hints, performanceHint(domain.PerfHintLargeInlineStyles, impact,
+			fmt.Sprintf("inline styles add %d bytes that cannot be cached separately", report.InlineStyleBytes), nil))
+	}
+
+	if !report.Compressed && report.HTMLBytes >= minCompressibleBytes {
+		impact := domain.ImpactMedium
+		if report.HTMLBytes >= uncompressedHighImpact {
+			impact = domain.ImpactHigh
+		}
+
+		report.Hints = append(report.Hints, performanceHint(domain.PerfHintUncompressedResponse, impact,
+			fmt.Sprintf("the %d byte document is served without Content-Encoding", report.HTMLBytes), nil))
+	}
+
+	if report.Cache == (domain.CacheHeaders{}) {
+		report.Hints = append(report.Hints, performanceHint(domain.PerfHintMissingCacheHeaders, domain.ImpactLow,
+			"the response has no Cache-Control, Expires, ETag or Last-Modified header", nil))
+	}
+
+	if report.HTMLBytes >= largeHTMLMediumImpact {
+		impact := domain.ImpactMedium
+		if report.HTMLBytes >= largeHTMLHighImpact {
+			impact = domain.ImpactHigh
+		}
+
+		report.Hints = append(report.Hints, performanceHint(domain.PerfHintLargeHTML, impact,
+			fmt.Sprintf("the document weighs %d bytes", report.HTMLBytes), nil))
+	}
+
+	return report
+}
+
+// unconnectedOrigins returns the third-party origins that serve render-blocking resources and
+// are neither preconnected nor have those resources preloaded, in document order.
+func (v *performanceVisitor) unconnectedOrigins() []string {
+	origins := []string{}
+	seen := make(map[string]bool)
+	pageHost := strings.ToLower(v.baseURL.Hostname())
+
+	for _, resource := range append(append([]*url.URL{}, v.blockingStylesheets...), v.blockingScripts...) {
+		host := strings.ToLower(resource.Hostname())
+		if host == "" || host == pageHost || v.preconnectedHosts[host] || v.preloadedURLs[resource.String()] {
+			continue
+		}
+
+		origin := resource.Scheme + "://" + resource.Host
+		if !seen[origin] {
+			seen[origin] = true
+			origins = append(origins, origin)
+		}
+	}
+
+	return origins
+}
+
+func (v *performanceVisitor) Apply(results *domain.AnalysisData) {
+	report := v.report()
+	results.Performance = &report
+}
+
+func emptyPerformanceReport() domain.PerformanceReport {
+	return domain.PerformanceReport{
+		RenderBlockingScripts:     []string{},
+		RenderBlockingStylesheets: []string{},
+		Hints:                     []domain.PerformanceHint{},
+	}
+}
+
+// bodyBytes is the size of a page body once its Content-Encoding was decoded, or the size of its
+// HTML when the size of the response is not known.
+func bodyBytes(html string, decodedBytes int64) int {
+	if decodedBytes > 0 {
+		return int(decodedBytes)
+	}
+
+	return len(html)
+}
+
+func performanceHint(code string, impact domain.Impact, message string, resources []string) domain.PerformanceHint {
+	if len(resources) > maxHintResources {
+		resources = resources[:maxHintResources]
+	}
+
+	return domain.PerformanceHint{
+		Code:      code,
+		Impact:    impact,
+		Message:   message,
+		Resources: resources,
+	}
+}
+
+func inlineBytesImpact(size int) (domain.Impact, bool) {
+	switch {
+	case size >= inlineBytesHighImpact:
+		return domain.ImpactHigh, true
+	case size >= inlineBytesMediumImpact:
+		return domain.ImpactMedium, true
+	default:
+		return "", false
+	}
+}
+
+// blocksRendering reports whether a stylesheet with the given media query applies to the
+// initial render; print-only and similar stylesheets are fetched without blocking.
+func blocksRendering(media string) bool {
+	media = strings.ToLower(strings.TrimSpace(media))
+
+	return media == "" || media == "all" || media == "screen"
+}
+
+func inHead(s *goquery.Selection) bool {
+	return s.Closest("head").Length() > 0
+}
+
+func nonEmpty(values []string) []string {
+	result := []string{}
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,170 @@
+package adapters
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHTMLAnalyzer_ExtractPerformance tests the static performance report
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractPerformance() {
+	cases := []struct {
+		name        string
+		html        string
+		header      http.Header
+		transferred int64
+		decoded     int64
+		assert      func(t *testing.T, report domain.PerformanceReport)
+	}{
+		{
+			name: "Render-blocking resources and missing preconnect",
+			html: `<html><head>
+				<link rel="preconnect" href="https://fonts.example.net">
+				<link rel="stylesheet" href="/css/site.css">
+				<link rel="stylesheet" href="/css/print.css" media="print">
+				<link rel="stylesheet" href="https://fonts.example.net/css?family=Inter">
+				<link rel="stylesheet" href="https://cdn.example.org/lib.css">
+				<link rel="preload" href="https://static.example.io/widget.js" as="script">
+				<script src="https://static.example.io/widget.js"></script>
+				<script src="/js/app.js" defer></script>
+				<script type="module" src="/js/module.js"></script>
+				<script>window.dataLayer = [];</script>
+				<style>body { margin: 0; }</style>
+			</head><body>
+				<img src="hero.jpg">
+				<img src="logo.png" width="120" height="40">
+				<script src="/js/footer.js"></script>
+			</body></html>`,
+			header: http.Header{
+				"Content-Encoding": {"gzip"},
+				"Cache-Control":    {"max-age=600"},
+				"Etag":             {`"abc"`},
+			},
+			assert: func(t *testing.T, report domain.PerformanceReport) {
+				assert.True(t, report.Compressed)
+				assert.Equal(t, "gzip", report.ContentEncoding)
+				assert.Equal(t, domain.CacheHeaders{CacheControl: "max-age=600", ETag: `"abc"`}, report.Cache)
+				assert.Equal(t, len("window.dataLayer = [];"), report.InlineScriptBytes)
+				assert.Equal(t, len("body { margin: 0; }"), report.InlineStyleBytes)
+				assert.Equal(t, []string{"https://static.example.io/widget.js"}, report.RenderBlockingScripts)
+				assert.Equal(t, []string{
+					"https://shop.example.com/css/site.css",
+					"https://fonts.example.net/css?family=Inter",
+					"https://cdn.example.org/lib.css",
+				}, report.RenderBlockingStylesheets)
+				assert.Equal(t, 1, report.ImagesWithoutDimensions)
+				assert.Equal(t, []domain.PerformanceHint{
+					{
+						Code:      domain.PerfHintRenderBlockingScript,
+						Impact:    domain.ImpactMedium,
+						Message:   "1 script(s) in <head> block rendering; load them with defer or async",
+						Resources: []string{"https://static.example.io/widget.js"},
+					},
+					{
+						Code:      domain.PerfHintRenderBlockingStylesheet,
+						Impact:    domain.ImpactMedium,
+						Message:   "3 stylesheet(s) in <head> block rendering; inline critical CSS or combine them",
+						Resources: report.RenderBlockingStylesheets,
+					},
+					{
+						Code:      domain.PerfHintImageMissingDimensions,
+						Impact:    domain.ImpactMedium,
+						Message:   "1 image(s) have no width and height, which causes layout shifts",
+						Resources: []string{"hero.jpg"},
+					},
+					{
+						Code:      domain.PerfHintMissingPreconnect,
+						Impact:    domain.ImpactMedium,
+						Message:   "1 third-party origin(s) serve render-blocking resources without a preconnect or preload",
+						Resources: []string{"https://cdn.example.org"},
+					},
+				}, report.Hints)
+			},
+		},
+		{
+			name:   "Uncompressed large document without cache headers",
+			html:   `<html><head><script>` + strings.Repeat("x", 160*1024) + `</script></head><body></body></html>`,
+			header: http.Header{"Content-Encoding": {"identity"}},
+			assert: func(t *testing.T, report domain.PerformanceReport) {
+				assert.False(t, report.Compressed)
+				assert.Equal(t, 160*1024, report.InlineScriptBytes)
+
+				impacts := map[string]domain.Impact{}
+				for _, hint := range report.Hints {
+					impacts[hint.Code] = hint.Impact
+				}
+
+				assert.Equal(t, map[string]domain.Impact{
+					domain.PerfHintLargeInlineScripts:   domain.ImpactHigh,
+					domain.PerfHintUncompressedResponse: domain.ImpactHigh,
+					domain.PerfHintMissingCacheHeaders:  domain.ImpactLow,
+				}, impacts)
+			},
+		},
+		{
+			name: "Small cached page has no hints",
+			html: `<html><head><title>Fast</title></head><body><p>Hello</p></body></html>`,
+			header: http.Header{
+				"Last-Modified": {"Mon, 12 Oct 2026 08:00:00 GMT"},
+			},
+			assert: func(t *testing.T, report domain.PerformanceReport) {
+				assert.Equal(t, len(`<html><head><title>Fast</title></head><body><p>Hello</p></body></html>`), report.HTMLBytes)
+				assert.Empty(t, report.RenderBlockingScripts)
+				assert.Empty(t, report.RenderBlockingStylesheets)
+				assert.Equal(t, []domain.PerformanceHint{}, report.Hints)
+			},
+		},
+		{
+			name: "Response sizes are reported rather than the transcoded HTML size",
+			html: `<html><head><title>Grüße</title></head><body><p>Käse</p></body></html>`,
+			header: http.Header{
+				"Content-Encoding": {"gzip"},
+				"Cache-Control":    {"max-age=600"},
+			},
+			transferred: 61,
+			decoded:     68,
+			assert: func(t *testing.T, report domain.PerformanceReport) {
+				assert.Equal(t, 68, report.HTMLBytes)
+				assert.Equal(t, 61, report.TransferredBytes)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			report := suite.analyzer.ExtractPerformance(&domain.WebPageContent{
+				URL:              "https://shop.example.com/",
+				HTML:             tc.html,
+				Headers:          tc.header,
+				TransferredBytes: tc.transferred,
+				DecodedBytes:     tc.decoded,
+			})
+
+			tc.assert(t, report)
+		})
+	}
+}
+
+// TestHTMLAnalyzer_AnalyzeReportsResponseSizes tests that the analysis reports the sizes of the
+// response rather than the size of the page once transcoded to UTF-8
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_AnalyzeReportsResponseSizes() {
+	doc, err := domain.NewDocument(&domain.WebPageContent{
+		URL:              "https://shop.example.com/",
+		HTML:             `<html><head><title>Grüße</title></head><body><p>Käse</p></body></html>`,
+		TransferredBytes: 61,
+		DecodedBytes:     68,
+	})
+	require.NoError(suite.t, err)
+
+	results, err := suite.analyzer.Analyze(suite.t.Context(), doc, domain.AnalysisOptions{})
+	require.NoError(suite.t, err)
+	require.NotNil(suite.t, results.Performance)
+
+	assert.Equal(suite.t, 68, results.Performance.HTMLBytes)
+	assert.Equal(suite.t, 61, results.Performance.TransferredBytes)
+}
@@ -0,0 +1,224 @@
+package adapters
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+)
+
+var (
+	// inlineFontPattern finds font files referenced from url() in inline <style> blocks.
+	inlineFontPattern = regexp.MustCompile(`(?i)url\(\s*['"]?([^'")]+\.(?:woff2?|ttf|otf|eot)(?:[?#][^'")]*)?)['"]?\s*\)`)
+
+	preloadResourceTypes = map[string]domain.ResourceType{
+		"script": domain.ResourceTypeScript,
+		"style":  domain.ResourceTypeStylesheet,
+		"image":  domain.ResourceTypeImage,
+		"font":   domain.ResourceTypeFont,
+		"video":  domain.ResourceTypeVideo,
+		"audio":  domain.ResourceTypeAudio,
+	}
+)
+
+func (a *HTMLAnalyzer) ExtractResources(html, baseURL string) domain.ResourceInventory {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		a.logger.Error().Err(err).Msg("failed to parse HTML for resource extraction")
+
+		return emptyResourceInventory()
+	}
+
+	visitor, err := newResourceVisitor(baseURL, a.logger)
+	if err != nil {
+		a.logger.Error().Err(err).Msg("failed to parse base URL for resource extraction")
+
+		return emptyResourceInventory()
+	}
+
+	walkDocument(doc, visitor)
+	inventory := visitor.inventory()
+
+	a.logger.Debug().
+		Int("total_resources", inventory.TotalCount).
+		Int("external_resources", inventory.ExternalCount).
+		Msg("extracted resource inventory")
+
+	return inventory
+}
+
+type resourceKey struct {
+	url          string
+	resourceType domain.ResourceType
+}
+
+type resourceVisitor struct {
+	logger    infrastructure.Logger
+	baseURL   *url.URL
+	resources []domain.Resource
+	seen      map[resourceKey]bool
+}
+
+func newResourceVisitor(baseURL string, logger infrastructure.Logger) (*resourceVisitor, error) {
+	baseURLParsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourceVisitor{
+		logger:  logger,
+		baseURL: baseURLParsed,
+		seen:    make(map[resourceKey]bool),
+	}, nil
+}
+
+func (v *resourceVisitor) VisitElement(s *goquery.Selection) {
+	switch name := goquery.NodeName(s); name {
+	case "script":
+		_, async := s.Attr("async")
+		_, deferred := s.Attr("defer")
+		v.add(s, s.AttrOr("src", ""), domain.ResourceTypeScript, func(resource *domain.Resource) {
+			resource.Async = async
+			resource.Defer = deferred
+		})
+	case "link":
+		v.visitLink(s)
+	case "img":
+		v.addImage(s)
+	case "source":
+		switch goquery.NodeName(s.Parent()) {
+		case "picture":
+			v.addImage(s)
+		case "video":
+			v.add(s, s.AttrOr("src", ""), domain.ResourceTypeVideo, nil)
+		case "audio":
+			v.add(s, s.AttrOr("src", ""), domain.ResourceTypeAudio, nil)
+		}
+	case "video":
+		v.add(s, s.AttrOr("src", ""), domain.ResourceTypeVideo, nil)
+		v.add(s, s.AttrOr("poster", ""), domain.ResourceTypeImage, nil)
+	case "audio":
+		v.add(s, s.AttrOr("src", ""), domain.ResourceTypeAudio, nil)
+	case "style":
+		for _, match := range inlineFontPattern.FindAllStringSubmatch(s.Text(), -1) {
+			v.add(s, match[1], domain.ResourceTypeFont, nil)
+		}
+	}
+}
+
+func (v *resourceVisitor) visitLink(s *goquery.Selection) {
+	href := s.AttrOr("href", "")
+
+	for _, relation := range strings.Fields(strings.ToLower(s.AttrOr("rel", ""))) {
+		switch relation {
+		case "stylesheet":
+			v.add(s, href, domain.ResourceTypeStylesheet, nil)
+		case "modulepreload":
+			v.add(s, href, domain.ResourceTypeScript, nil)
+		case "preload":
+			if resourceType, ok := preloadResourceTypes[strings.ToLower(s.AttrOr("as", ""))]; ok {
+				v.add(s, href, resourceType, nil)
+			}
+		case "icon", "apple-touch-icon":
+			v.add(s, href, domain.ResourceTypeImage, nil)
+		}
+	}
+}
+
+// addImage records the src and every srcset candidate of an <img> or <picture> <source>.
+func (v *resourceVisitor) addImage(s *goquery.Selection) {
+	lazy := strings.EqualFold(strings.TrimSpace(s.AttrOr("loading", "")), "lazy")
+	setLazy := func(resource *domain.Resource) {
+		resource.LazyLoaded = lazy
+	}
+
+	v.add(s, s.AttrOr("src", ""), domain.ResourceTypeImage, setLazy)
+
+	for _, candidate := range strings.Split(s.AttrOr("srcset", ""), ",") {
+		if fields := strings.Fields(candidate); len(fields) > 0 {
+			v.add(s, fields[0], domain.ResourceTypeImage, setLazy)
+		}
+	}
+}
+
+func (v *resourceVisitor) add(s *goquery.Selection, ref string, resourceType domain.ResourceType, decorate func(*domain.Resource)) {
+	if strings.TrimSpace(ref) == "" {
+		return
+	}
+
+	resolvedURL, err := resolveURL(v.baseURL, ref)
+	if err != nil {
+		v.logger.Debug().
+			Err(err).
+			Str("ref", ref).
+			Msg("failed to parse resource URL")
+
+		return
+	}
+
+	if resolvedURL.Scheme != "http" && resolvedURL.Scheme != "https" {
+		return
+	}
+
+	key := resourceKey{url: resolvedURL.String(), resourceType: resourceType}
+	if v.seen[key] {
+		return
+	}
+	v.seen[key] = true
+
+	origin := domain.LinkTypeExternal
+	if resolvedURL.Host == v.baseURL.Host {
+		origin = domain.LinkTypeInternal
+	}
+
+	resource := domain.Resource{
+		URL:          key.url,
+		Type:         resourceType,
+		Origin:       origin,
+		HasIntegrity: strings.TrimSpace(s.AttrOr("integrity", "")) != "",
+	}
+
+	if decorate != nil {
+		decorate(&resource)
+	}
+
+	v.resources = append(v.resources, resource)
+}
+
+func (v *resourceVisitor) inventory() domain.ResourceInventory {
+	inventory := emptyResourceInventory()
+	inventory.TotalCount = len(v.resources)
+
+	for _, resource := range v.resources {
+		inventory.CountsByType[resource.Type]++
+
+		switch resource.Origin {
+		case domain.LinkTypeInternal:
+			inventory.InternalCount++
+		case domain.LinkTypeExternal:
+			inventory.ExternalCount++
+		}
+	}
+
+	if len(v.resources) > 0 {
+		inventory.Resources = v.resources
+	}
+
+	return inventory
+}
+
+func (v *resourceVisitor) Apply(results *domain.AnalysisData) {
+	inventory := v.inventory()
+	results.Resources = &inventory
+}
+
+func emptyResourceInventory() domain.ResourceInventory {
+	return domain.ResourceInventory{
+		CountsByType:          map[domain.ResourceType]int{},
+		Resources:             []domain.Resource{},
+		InaccessibleResources: []domain.InaccessibleLink{},
+	}
+}
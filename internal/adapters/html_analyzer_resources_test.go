@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHTMLAnalyzer_ExtractResources tests the subresource inventory
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractResources() {
+	cases := []struct {
+		name     string
+		html     string
+		baseURL  string
+		expected domain.ResourceInventory
+	}{
+		{
+			name: "Scripts and stylesheets",
+			html: `<html><head>
+				<link rel="stylesheet" href="/css/site.css">
+				<link rel="stylesheet" href="https://cdn.example.net/lib.css" integrity="sha384-abc" crossorigin="anonymous">
+				<link rel="preload" href="/fonts/inter.woff2" as="font" crossorigin>
+				<link rel="preload" href="/unknown" as="fetch">
+				<link rel="modulepreload" href="/js/module.js">
+				<script src="/js/app.js" defer></script>
+				<script async src="https://cdn.example.net/analytics.js"></script>
+				<script>console.log("inline")</script>
+				<script src="/js/app.js" defer></script>
+			</head></html>`,
+			baseURL: "https://example.com/",
+			expected: domain.ResourceInventory{
+				TotalCount:    6,
+				InternalCount: 4,
+				ExternalCount: 2,
+				CountsByType: map[domain.ResourceType]int{
+					domain.ResourceTypeStylesheet: 2,
+					domain.ResourceTypeFont:       1,
+					domain.ResourceTypeScript:     3,
+				},
+				Resources: []domain.Resource{
+					{URL: "https://example.com/css/site.css", Type: domain.ResourceTypeStylesheet, Origin: domain.LinkTypeInternal},
+					{URL: "https://cdn.example.net/lib.css", Type: domain.ResourceTypeStylesheet, Origin: domain.LinkTypeExternal, HasIntegrity: true},
+					{URL: "https://example.com/fonts/inter.woff2", Type: domain.ResourceTypeFont, Origin: domain.LinkTypeInternal},
+					{URL: "https://example.com/js/module.js", Type: domain.ResourceTypeScript, Origin: domain.LinkTypeInternal},
+					{URL: "https://example.com/js/app.js", Type: domain.ResourceTypeScript, Origin: domain.LinkTypeInternal, Defer: true},
+					{URL: "https://cdn.example.net/analytics.js", Type: domain.ResourceTypeScript, Origin: domain.LinkTypeExternal, Async: true},
+				},
+				InaccessibleResources: []domain.InaccessibleLink{},
+			},
+		},
+		{
+			name: "Images, media and inline fonts",
+			html: `<html><head>
+				<link rel="icon" href="/favicon.ico">
+				<style>@font-face { font-family: X; src: url('/fonts/x.woff2') format('woff2'), url("data:font/woff2;base64,AAAA"); }</style>
+			</head><body>
+				<img src="hero.jpg" srcset="hero-480.jpg 480w, hero-800.jpg 800w" loading="lazy">
+				<img src="data:image/gif;base64,R0lGODlhAQABAAAAACw=">
+				<picture><source srcset="photo.webp" type="image/webp"><img src="photo.jpg"></picture>
+				<video src="/media/clip.mp4" poster="/media/poster.jpg"><source src="/media/clip.webm"></video>
+				<audio><source src="https://media.example.org/track.mp3"></audio>
+			</body></html>`,
+			baseURL: "https://example.com/gallery/",
+			expected: domain.ResourceInventory{
+				TotalCount:    11,
+				InternalCount: 10,
+				ExternalCount: 1,
+				CountsByType: map[domain.ResourceType]int{
+					domain.ResourceTypeImage: 7,
+					domain.ResourceTypeFont:  1,
+					domain.ResourceTypeVideo: 2,
+					domain.ResourceTypeAudio: 1,
+				},
+				Resources: []domain.Resource{
+					{URL: "https://example.com/favicon.ico", Type: domain.ResourceTypeImage, Origin: domain.LinkTypeInternal},
+					{URL: "https://example.com/fonts/x.woff2", Type: domain.ResourceTypeFont, Origin: domain.LinkTypeInternal},
+					{URL: "https://example.com/gallery/hero.jpg", Type: domain.ResourceTypeImage, Origin: domain.LinkTypeInternal, LazyLoaded: true},
+					{URL: "https://example.com/gallery/hero-480.jpg", Type: domain.ResourceTypeImage, Origin: domain.LinkTypeInternal, LazyLoaded: true},
+					{URL: "https://example.com/gallery/hero-800.jpg", Type: domain.ResourceTypeImage, Origin: domain.LinkTypeInternal, LazyLoaded: true},
+					{URL: "https://example.com/gallery/photo.webp", Type: domain.ResourceTypeImage, Origin: domain.LinkTypeInternal},
+					{URL: "https://example.com/gallery/photo.jpg", Type: domain.ResourceTypeImage, Origin: domain.LinkTypeInternal},
+					{URL: "https://example.com/media/clip.mp4", Type: domain.ResourceTypeVideo, Origin: domain.LinkTypeInternal},
+					{URL: "https://example.com/media/poster.jpg", Type: domain.ResourceTypeImage, Origin: domain.LinkTypeInternal},
+					{URL: "https://example.com/media/clip.webm", Type: domain.ResourceTypeVideo, Origin: domain.LinkTypeInternal},
+					{URL: "https://media.example.org/track.mp3", Type: domain.ResourceTypeAudio, Origin: domain.LinkTypeExternal},
+				},
+				InaccessibleResources: []domain.InaccessibleLink{},
+			},
+		},
+		{
+			name:    "No resources",
+			html:    `<html><body><p>Text only</p></body></html>`,
+			baseURL: "https://example.com",
+			expected: domain.ResourceInventory{
+				CountsByType:          map[domain.ResourceType]int{},
+				Resources:             []domain.Resource{},
+				InaccessibleResources: []domain.InaccessibleLink{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result := suite.analyzer.ExtractResources(tc.html, tc.baseURL)
+
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,342 @@
+package adapters
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+)
+
+const (
+	jsonLDContext = "@context"
+	jsonLDType    = "@type"
+	jsonLDGraph   = "@graph"
+)
+
+var (
+	schemaOrgPrefixes = []string{
+		"https://schema.org/",
+		"http://schema.org/",
+		"schema:",
+	}
+
+	// requiredSchemaProperties lists the properties search engines need before
+	// they consider an entity of the given schema.org type for rich results.
+	requiredSchemaProperties = map[string][]string{
+		"Article":        {"headline"},
+		"NewsArticle":    {"headline"},
+		"BlogPosting":    {"headline"},
+		"BreadcrumbList": {"itemListElement"},
+		"Event":          {"name", "startDate", "location"},
+		"FAQPage":        {"mainEntity"},
+		"JobPosting":     {"title", "datePosted", "description", "hiringOrganization"},
+		"LocalBusiness":  {"name", "address"},
+		"Organization":   {"name"},
+		"Person":         {"name"},
+		"Product":        {"name"},
+		"Recipe":         {"name", "image"},
+		"Review":         {"itemReviewed", "author"},
+		"VideoObject":    {"name", "thumbnailUrl", "uploadDate"},
+		"WebSite":        {"name"},
+	}
+)
+
+func (a *HTMLAnalyzer) ExtractStructuredData(html string) []domain.StructuredDataItem {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		a.logger.Error().Err(err).Msg("failed to parse HTML for structured data extraction")
+
+		return nil
+	}
+
+	visitor := newStructuredDataVisitor(a.logger)
+	walkDocument(doc, visitor)
+
+	a.logger.Debug().
+		Int("structured_data_items", len(visitor.items)).
+		Msg("extracted structured data")
+
+	return visitor.items
+}
+
+type structuredDataVisitor struct {
+	logger infrastructure.Logger
+	items  []domain.StructuredDataItem
+}
+
+func newStructuredDataVisitor(logger infrastructure.Logger) *structuredDataVisitor {
+	return &structuredDataVisitor{logger: logger}
+}
+
+func (v *structuredDataVisitor) VisitElement(s *goquery.Selection) {
+	if goquery.NodeName(s) == "script" && isJSONLDType(s.AttrOr("type", "")) {
+		v.items = append(v.items, v.extractJSONLD(s.Text())...)
+
+		return
+	}
+
+	if _, isScope := s.Attr("itemscope"); isScope {
+		if _, isProperty := s.Attr("itemprop"); !isProperty {
+			v.items = append(v.items, extractScopedItem(s, domain.StructuredDataMicrodata))
+		}
+	}
+
+	if _, isScope := s.Attr("typeof"); isScope {
+		if _, isProperty := s.Attr("property"); !isProperty {
+			v.items = append(v.items, extractScopedItem(s, domain.StructuredDataRDFa))
+		}
+	}
+}
+
+// isJSONLDType reports whether the type attribute of a script names JSON-LD, ignoring case,
+// surrounding white space and parameters such as charset.
+func isJSONLDType(scriptType string) bool {
+	mediaType, _, err := mime.ParseMediaType(scriptType)
+
+	return err == nil && mediaType == "application/ld+json"
+}
+
+func (v *structuredDataVisitor) Apply(results *domain.AnalysisData) {
+	results.StructuredData = v.items
+}
+
+func (v *structuredDataVisitor) extractJSONLD(raw string) []domain.StructuredDataItem {
+	var payload any
+	if err := json.Unmarshal([]byte(strings.TrimSpace(raw)), &payload); err != nil {
+		v.logger.Debug().Err(err).Msg("failed to parse JSON-LD block")
+
+		return []domain.StructuredDataItem{{
+			Format:     domain.StructuredDataJSONLD,
+			Types:      []string{},
+			Properties: map[string]any{},
+			Errors:     []string{fmt.Sprintf("invalid JSON: %s", err)},
+		}}
+	}
+
+	var nodes []map[string]any
+	var sharedContext any
+
+	switch value := payload.(type) {
+	case []any:
+		nodes = jsonLDNodes(value)
+	case map[string]any:
+		sharedContext = value[jsonLDContext]
+		if graph, ok := value[jsonLDGraph].([]any); ok {
+			nodes = jsonLDNodes(graph)
+		} else {
+			nodes = []map[string]any{value}
+		}
+	default:
+		return []domain.StructuredDataItem{{
+			Format:     domain.StructuredDataJSONLD,
+			Types:      []string{},
+			Properties: map[string]any{},
+			Errors:     []string{"JSON-LD block must be an object or an array of objects"},
+		}}
+	}
+
+	items := make([]domain.StructuredDataItem, 0, len(nodes))
+
+	for _, node := range nodes {
+		item := domain.StructuredDataItem{
+			Format:     domain.StructuredDataJSONLD,
+			Types:      jsonLDTypes(node[jsonLDType]),
+			Properties: map[string]any{},
+		}
+
+		if _, ok := node[jsonLDContext]; !ok && sharedContext == nil {
+			item.Errors = append(item.Errors, "missing @context")
+		}
+
+		for key, value := range node {
+			if key == jsonLDContext || key == jsonLDType {
+				continue
+			}
+
+			item.Properties[key] = value
+		}
+
+		item.Errors = append(item.Errors, validateStructuredDataItem(item)...)
+		items = append(items, item)
+	}
+
+	return items
+}
+
+func jsonLDNodes(values []any) []map[string]any {
+	nodes := make([]map[string]any, 0, len(values))
+
+	for _, value := range values {
+		if node, ok := value.(map[string]any); ok {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+func jsonLDTypes(value any) []string {
+	types := []string{}
+
+	switch typed := value.(type) {
+	case string:
+		types = append(types, schemaTypeName(typed))
+	case []any:
+		for _, entry := range typed {
+			if name, ok := entry.(string); ok {
+				types = append(types, schemaTypeName(name))
+			}
+		}
+	}
+
+	return types
+}
+
+// extractScopedItem turns a Microdata itemscope or an RDFa typeof element into an item.
+// Both syntaxes share the same shape: a scope element declaring the type and descendant
+// elements naming properties, where a nested scope becomes a nested object.
+func extractScopedItem(scope *goquery.Selection, format domain.StructuredDataFormat) domain.StructuredDataItem {
+	typeAttr, propAttr := "itemtype", "itemprop"
+	if format == domain.StructuredDataRDFa {
+		typeAttr, propAttr = "typeof", "property"
+	}
+
+	item := domain.StructuredDataItem{
+		Format:     format,
+		Types:      scopeTypes(scope.AttrOr(typeAttr, "")),
+		Properties: scopeProperties(scope, format, typeAttr, propAttr),
+	}
+
+	if len(item.Types) == 0 {
+		item.Errors = append(item.Errors, fmt.Sprintf("missing %s", typeAttr))
+	}
+
+	item.Errors = append(item.Errors, validateStructuredDataItem(item)...)
+
+	return item
+}
+
+func scopeTypes(value string) []string {
+	types := []string{}
+
+	for _, name := range strings.Fields(value) {
+		types = append(types, schemaTypeName(name))
+	}
+
+	return types
+}
+
+func scopeProperties(scope *goquery.Selection, format domain.StructuredDataFormat, typeAttr, propAttr string) map[string]any {
+	properties := map[string]any{}
+
+	var walk func(*goquery.Selection)
+	walk = func(parent *goquery.Selection) {
+		parent.Children().Each(func(i int, child *goquery.Selection) {
+			_, isScope := child.Attr(typeAttr)
+			if format == domain.StructuredDataMicrodata {
+				_, isScope = child.Attr("itemscope")
+			}
+
+			if names, ok := child.Attr(propAttr); ok {
+				var value any = structuredPropertyValue(child)
+				if isScope {
+					nested := scopeProperties(child, format, typeAttr, propAttr)
+					nested[jsonLDType] = scopeTypes(child.AttrOr(typeAttr, ""))
+					value = nested
+				}
+
+				for _, name := range strings.Fields(names) {
+					addStructuredProperty(properties, schemaTypeName(name), value)
+				}
+			}
+
+			if !isScope {
+				walk(child)
+			}
+		})
+	}
+
+	walk(scope)
+
+	return properties
+}
+
+// structuredPropertyValue follows the Microdata value rules, which RDFa mostly agrees with.
+func structuredPropertyValue(s *goquery.Selection) string {
+	if content, ok := s.Attr("content"); ok {
+		return strings.TrimSpace(content)
+	}
+
+	if resource, ok := s.Attr("resource"); ok {
+		return strings.TrimSpace(resource)
+	}
+
+	attr := ""
+	switch goquery.NodeName(s) {
+	case "audio", "embed", "iframe", "img", "source", "track", "video":
+		attr = "src"
+	case "a", "area", "link":
+		attr = "href"
+	case "object":
+		attr = "data"
+	case "data", "meter":
+		attr = "value"
+	case "time":
+		attr = "datetime"
+	}
+
+	if value, ok := s.Attr(attr); ok && attr != "" {
+		return strings.TrimSpace(value)
+	}
+
+	return strings.Join(strings.Fields(s.Text()), " ")
+}
+
+func addStructuredProperty(properties map[string]any, name string, value any) {
+	existing, ok := properties[name]
+	if !ok {
+		properties[name] = value
+
+		return
+	}
+
+	if values, isList := existing.([]any); isList {
+		properties[name] = append(values, value)
+
+		return
+	}
+
+	properties[name] = []any{existing, value}
+}
+
+func schemaTypeName(name string) string {
+	for _, prefix := range schemaOrgPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return strings.TrimPrefix(name, prefix)
+		}
+	}
+
+	return name
+}
+
+func validateStructuredDataItem(item domain.StructuredDataItem) []string {
+	var errors []string
+
+	if item.Format == domain.StructuredDataJSONLD && len(item.Types) == 0 {
+		errors = append(errors, "missing @type")
+	}
+
+	for _, typeName := range item.Types {
+		for _, property := range requiredSchemaProperties[typeName] {
+			if _, ok := item.Properties[property]; !ok {
+				errors = append(errors, fmt.Sprintf("%s is missing required property %q", typeName, property))
+			}
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,161 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHTMLAnalyzer_ExtractStructuredData tests JSON-LD, Microdata and RDFa extraction
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractStructuredData() {
+	cases := []struct {
+		name     string
+		html     string
+		expected []domain.StructuredDataItem
+	}{
+		{
+			name: "JSON-LD object",
+			html: `<html><head><script type="application/ld+json">
+				{"@context": "https://schema.org", "@type": "Product", "name": "Widget", "sku": "W-1"}
+			</script></head></html>`,
+			expected: []domain.StructuredDataItem{
+				{
+					Format:     domain.StructuredDataJSONLD,
+					Types:      []string{"Product"},
+					Properties: map[string]any{"name": "Widget", "sku": "W-1"},
+				},
+			},
+		},
+		{
+			name: "JSON-LD type with parameters, mixed case and white space",
+			html: `<html><head><script type=" Application/LD+JSON; charset=utf-8 ">
+				{"@context": "https://schema.org", "@type": "Organization", "name": "Example"}
+			</script></head></html>`,
+			expected: []domain.StructuredDataItem{
+				{
+					Format:     domain.StructuredDataJSONLD,
+					Types:      []string{"Organization"},
+					Properties: map[string]any{"name": "Example"},
+				},
+			},
+		},
+		{
+			name: "JSON-LD graph with missing required property",
+			html: `<html><head><script type="application/ld+json">
+				{"@context": "https://schema.org", "@graph": [
+					{"@type": "WebSite", "name": "Example"},
+					{"@type": ["Article", "Thing"], "author": "Jane"}
+				]}
+			</script></head></html>`,
+			expected: []domain.StructuredDataItem{
+				{
+					Format:     domain.StructuredDataJSONLD,
+					Types:      []string{"WebSite"},
+					Properties: map[string]any{"name": "Example"},
+				},
+				{
+					Format:     domain.StructuredDataJSONLD,
+					Types:      []string{"Article", "Thing"},
+					Properties: map[string]any{"author": "Jane"},
+					Errors:     []string{`Article is missing required property "headline"`},
+				},
+			},
+		},
+		{
+			name: "JSON-LD without context and type",
+			html: `<html><head><script type="application/ld+json">[{"name": "Nameless"}]</script></head></html>`,
+			expected: []domain.StructuredDataItem{
+				{
+					Format:     domain.StructuredDataJSONLD,
+					Types:      []string{},
+					Properties: map[string]any{"name": "Nameless"},
+					Errors:     []string{"missing @context", "missing @type"},
+				},
+			},
+		},
+		{
+			name: "Microdata with nested item",
+			html: `<html><body>
+				<div itemscope itemtype="https://schema.org/Product">
+					<h1 itemprop="name">Widget</h1>
+					<img itemprop="image" src="/widget.png">
+					<div itemprop="offers" itemscope itemtype="https://schema.org/Offer">
+						<meta itemprop="priceCurrency" content="EUR">
+						<span itemprop="price">9.99</span>
+					</div>
+				</div>
+			</body></html>`,
+			expected: []domain.StructuredDataItem{
+				{
+					Format: domain.StructuredDataMicrodata,
+					Types:  []string{"Product"},
+					Properties: map[string]any{
+						"name":  "Widget",
+						"image": "/widget.png",
+						"offers": map[string]any{
+							"@type":         []string{"Offer"},
+							"priceCurrency": "EUR",
+							"price":         "9.99",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Microdata without itemtype",
+			html: `<html><body><div itemscope><span itemprop="name">A</span><span itemprop="name">B</span></div></body></html>`,
+			expected: []domain.StructuredDataItem{
+				{
+					Format:     domain.StructuredDataMicrodata,
+					Types:      []string{},
+					Properties: map[string]any{"name": []any{"A", "B"}},
+					Errors:     []string{"missing itemtype"},
+				},
+			},
+		},
+		{
+			name: "RDFa",
+			html: `<html><body vocab="https://schema.org/">
+				<div typeof="schema:Event">
+					<span property="name">Concert</span>
+					<time property="startDate" datetime="2025-06-01T20:00">June 1st</time>
+				</div>
+			</body></html>`,
+			expected: []domain.StructuredDataItem{
+				{
+					Format:     domain.StructuredDataRDFa,
+					Types:      []string{"Event"},
+					Properties: map[string]any{"name": "Concert", "startDate": "2025-06-01T20:00"},
+					Errors:     []string{`Event is missing required property "location"`},
+				},
+			},
+		},
+		{
+			name:     "No structured data",
+			html:     `<html><head><meta property="og:title" content="Not RDFa"></head></html>`,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result := suite.analyzer.ExtractStructuredData(tc.html)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+// TestHTMLAnalyzer_ExtractStructuredData_InvalidJSON tests JSON-LD parse errors
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractStructuredData_InvalidJSON() {
+	html := `<html><head><script type="application/ld+json">{"@type": "Product",}</script></head></html>`
+
+	result := suite.analyzer.ExtractStructuredData(html)
+
+	require.Len(suite.t, result, 1)
+	assert.Equal(suite.t, domain.StructuredDataJSONLD, result[0].Format)
+	require.Len(suite.t, result[0].Errors, 1)
+	assert.Contains(suite.t, result[0].Errors[0], "invalid JSON")
+}
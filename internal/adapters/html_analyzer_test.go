@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/PuerkitoBio/goquery"
 	"github.com/architeacher/svc-web-analyzer/internal/domain"
 	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
 	"github.com/rs/zerolog"
@@ -28,6 +27,16 @@ func newHTMLAnalyzerTestSuite(t *testing.T) *HTMLAnalyzerTestSuite {
 	}
 }
 
+// parsePage parses a page the way the analysis pipeline does before analyzing it
+func parsePage(t *testing.T, pageURL, html string) *domain.Document {
+	t.Helper()
+
+	doc, err := domain.NewDocument(&domain.WebPageContent{URL: pageURL, HTML: html})
+	require.NoError(t, err)
+
+	return doc
+}
+
 // SetupTest sets up resources before each test
 func (suite *HTMLAnalyzerTestSuite) SetupTest() {
 	suite.analyzer = NewHTMLAnalyzer(suite.logger)
@@ -494,62 +503,80 @@ func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_ExtractForms() {
 	}
 }
 
-// TestHTMLAnalyzer_isLikelyLoginForm tests login form detection
-func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_isLikelyLoginForm() {
+// TestHTMLAnalyzer_Analyze tests that a single pass fills every enabled part of the results
+func (suite *HTMLAnalyzerTestSuite) TestHTMLAnalyzer_Analyze() {
+	html := `<!DOCTYPE html>
+		<html lang="en"><head>
+			<title>Shop</title>
+			<meta name="description" content="A shop">
+			<script type="application/ld+json">{"@context": "https://schema.org", "@type": "Organization", "name": "Shop"}</script>
+		</head><body><header><nav aria-label="Main"></nav></header><main>
+			<h1>Shop</h1>
+			<h2>Products</h2>
+			<a href="/cart">Cart</a>
+			<a href="https://external.com">Partner</a>
+			<form method="post" action="/login">
+				<input type="text" name="user" aria-label="User">
+				<input type="password" name="pass" aria-label="Password">
+			</form>
+		</main><footer></footer></body></html>`
+
 	cases := []struct {
-		name     string
-		method   string
-		html     string
-		expected bool
+		name    string
+		options domain.AnalysisOptions
+		assert  func(t *testing.T, results *domain.AnalysisData)
 	}{
 		{
-			name:     "POST form with password field",
-			method:   "POST",
-			html:     `<form><input type="password" name="password"></form>`,
-			expected: true,
-		},
-		{
-			name:     "GET form with password field",
-			method:   "GET",
-			html:     `<form><input type="password" name="password"></form>`,
-			expected: false,
-		},
-		{
-			name:     "POST form without password field",
-			method:   "POST",
-			html:     `<form><input type="text" name="username"></form>`,
-			expected: false,
-		},
-		{
-			name:     "POST form with multiple password fields",
-			method:   "POST",
-			html:     `<form><input type="password" name="password"><input type="password" name="confirm"></form>`,
-			expected: true,
-		},
-		{
-			name:     "Empty POST form",
-			method:   "POST",
-			html:     `<form></form>`,
-			expected: false,
+			name: "All options enabled",
+			options: domain.AnalysisOptions{
+				IncludeHeadings: true,
+				DetectForms:     true,
+				IncludeMeta:     true,
+				Accessibility:   true,
+			},
+			assert: func(t *testing.T, results *domain.AnalysisData) {
+				assert.Equal(t, domain.HTML5, results.HTMLVersion)
+				assert.Equal(t, "Shop", results.Title)
+				assert.Equal(t, domain.HeadingCounts{H1: 1, H2: 1}, results.HeadingCounts)
+				require.NotNil(t, results.HeadingOutline)
+				assert.Len(t, results.HeadingOutline.Headings, 1)
+				assert.Equal(t, 2, results.Links.TotalCount)
+				assert.Equal(t, 1, results.Links.InternalCount)
+				assert.Equal(t, 1, results.Links.ExternalCount)
+				assert.Equal(t, 1, results.Forms.LoginFormsDetected)
+				assert.Len(t, results.Forms.Security, results.Forms.TotalCount)
+				require.NotNil(t, results.MixedContent)
+				assert.Empty(t, results.MixedContent.Items)
+				require.NotNil(t, results.Meta)
+				assert.Equal(t, "A shop", results.Meta.Description)
+				assert.Len(t, results.StructuredData, 1)
+				require.NotNil(t, results.Accessibility)
+				assert.Empty(t, results.Accessibility.Findings)
+			},
 		},
 		{
-			name:     "PUT form with password field (not POST)",
-			method:   "PUT",
-			html:     `<form><input type="password" name="password"></form>`,
-			expected: false,
+			name:    "Optional parts disabled",
+			options: domain.AnalysisOptions{},
+			assert: func(t *testing.T, results *domain.AnalysisData) {
+				assert.Equal(t, "Shop", results.Title)
+				assert.Equal(t, domain.HeadingCounts{}, results.HeadingCounts)
+				assert.Nil(t, results.HeadingOutline)
+				assert.Equal(t, domain.FormAnalysis{}, results.Forms)
+				assert.Nil(t, results.Meta)
+				assert.Nil(t, results.Accessibility)
+				assert.Equal(t, 2, results.Links.TotalCount)
+				require.NotNil(t, results.Performance)
+				assert.Equal(t, len(html), results.Performance.HTMLBytes)
+			},
 		},
 	}
 
 	for _, tc := range cases {
 		suite.t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			// We need to create a goquery selection for the test
-			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tc.html))
+			results, err := suite.analyzer.Analyze(t.Context(), parsePage(t, "https://shop.example.com", html), tc.options)
 			require.NoError(t, err)
-
-			selection := doc.Find("form").First()
-			result := suite.analyzer.isLikelyLoginForm(tc.method, selection)
-			assert.Equal(t, tc.expected, result)
+			tc.assert(t, results)
 		})
 	}
 }
@@ -0,0 +1,24 @@
+package adapters
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+// elementVisitor collects one part of the analysis while the shared document is walked.
+// VisitElement is called for every element in document order; Apply stores the outcome
+// once the walk is complete.
+type elementVisitor interface {
+	VisitElement(s *goquery.Selection)
+	Apply(results *domain.AnalysisData)
+}
+
+// walkDocument traverses the parsed document exactly once and hands every element to
+// each of the visitors, so adding an extractor does not add another pass over the DOM.
+func walkDocument(doc *goquery.Document, visitors ...elementVisitor) {
+	doc.Find("*").Each(func(i int, s *goquery.Selection) {
+		for _, visitor := range visitors {
+			visitor.VisitElement(s)
+		}
+	})
+}
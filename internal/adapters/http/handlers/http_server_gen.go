@@ -28,11 +28,178 @@ const (
 	PasetoQueryAuthScopes = "PasetoQueryAuth.Scopes"
 )
 
+// Defines values for AccessibilityAnalysisFindingsSeverity.
+const (
+	AccessibilityAnalysisFindingsSeverityError   AccessibilityAnalysisFindingsSeverity = "error"
+	AccessibilityAnalysisFindingsSeverityInfo    AccessibilityAnalysisFindingsSeverity = "info"
+	AccessibilityAnalysisFindingsSeverityWarning AccessibilityAnalysisFindingsSeverity = "warning"
+)
+
+// Defines values for AnalysisDataAccessibilityFindingsSeverity.
+const (
+	AnalysisDataAccessibilityFindingsSeverityError   AnalysisDataAccessibilityFindingsSeverity = "error"
+	AnalysisDataAccessibilityFindingsSeverityInfo    AnalysisDataAccessibilityFindingsSeverity = "info"
+	AnalysisDataAccessibilityFindingsSeverityWarning AnalysisDataAccessibilityFindingsSeverity = "warning"
+)
+
+// Defines values for AnalysisDataEncodingSource.
+const (
+	AnalysisDataEncodingSourceBom         AnalysisDataEncodingSource = "bom"
+	AnalysisDataEncodingSourceContentType AnalysisDataEncodingSource = "content_type"
+	AnalysisDataEncodingSourceDefault     AnalysisDataEncodingSource = "default"
+	AnalysisDataEncodingSourceMeta        AnalysisDataEncodingSource = "meta"
+	AnalysisDataEncodingSourceSniffed     AnalysisDataEncodingSource = "sniffed"
+)
+
+// Defines values for AnalysisDataFormsClassificationsKind.
+const (
+	AnalysisDataFormsClassificationsKindLogin         AnalysisDataFormsClassificationsKind = "login"
+	AnalysisDataFormsClassificationsKindNewsletter    AnalysisDataFormsClassificationsKind = "newsletter"
+	AnalysisDataFormsClassificationsKindPasswordReset AnalysisDataFormsClassificationsKind = "password_reset"
+	AnalysisDataFormsClassificationsKindPayment       AnalysisDataFormsClassificationsKind = "payment"
+	AnalysisDataFormsClassificationsKindSearch        AnalysisDataFormsClassificationsKind = "search"
+	AnalysisDataFormsClassificationsKindSignup        AnalysisDataFormsClassificationsKind = "signup"
+	AnalysisDataFormsClassificationsKindUnknown       AnalysisDataFormsClassificationsKind = "unknown"
+)
+
+// Defines values for AnalysisDataFormsFormsEnctype.
+const (
+	AnalysisDataFormsFormsEnctypeApplicationxWwwFormUrlencoded AnalysisDataFormsFormsEnctype = "application/x-www-form-urlencoded"
+	AnalysisDataFormsFormsEnctypeMultipartformData             AnalysisDataFormsFormsEnctype = "multipart/form-data"
+	AnalysisDataFormsFormsEnctypeTextplain                     AnalysisDataFormsFormsEnctype = "text/plain"
+)
+
+// Defines values for AnalysisDataFormsFormsFindingsSeverity.
+const (
+	AnalysisDataFormsFormsFindingsSeverityError   AnalysisDataFormsFormsFindingsSeverity = "error"
+	AnalysisDataFormsFormsFindingsSeverityInfo    AnalysisDataFormsFormsFindingsSeverity = "info"
+	AnalysisDataFormsFormsFindingsSeverityWarning AnalysisDataFormsFormsFindingsSeverity = "warning"
+)
+
+// Defines values for AnalysisDataFormsFormsMethod.
+const (
+	AnalysisDataFormsFormsMethodGET  AnalysisDataFormsFormsMethod = "GET"
+	AnalysisDataFormsFormsMethodPOST AnalysisDataFormsFormsMethod = "POST"
+)
+
 // Defines values for AnalysisDataFormsLoginFormDetailsMethod.
 const (
+	AnalysisDataFormsLoginFormDetailsMethodGET  AnalysisDataFormsLoginFormDetailsMethod = "GET"
 	AnalysisDataFormsLoginFormDetailsMethodPOST AnalysisDataFormsLoginFormDetailsMethod = "POST"
 )
 
+// Defines values for AnalysisDataFormsSecurityFindingsSeverity.
+const (
+	AnalysisDataFormsSecurityFindingsSeverityError   AnalysisDataFormsSecurityFindingsSeverity = "error"
+	AnalysisDataFormsSecurityFindingsSeverityInfo    AnalysisDataFormsSecurityFindingsSeverity = "info"
+	AnalysisDataFormsSecurityFindingsSeverityWarning AnalysisDataFormsSecurityFindingsSeverity = "warning"
+)
+
+// Defines values for AnalysisDataFormsSecurityMethod.
+const (
+	AnalysisDataFormsSecurityMethodGET  AnalysisDataFormsSecurityMethod = "GET"
+	AnalysisDataFormsSecurityMethodPOST AnalysisDataFormsSecurityMethod = "POST"
+)
+
+// Defines values for AnalysisDataHeadingOutlineIssuesSeverity.
+const (
+	AnalysisDataHeadingOutlineIssuesSeverityError   AnalysisDataHeadingOutlineIssuesSeverity = "error"
+	AnalysisDataHeadingOutlineIssuesSeverityInfo    AnalysisDataHeadingOutlineIssuesSeverity = "info"
+	AnalysisDataHeadingOutlineIssuesSeverityWarning AnalysisDataHeadingOutlineIssuesSeverity = "warning"
+)
+
+// Defines values for AnalysisDataLinksInaccessibleLinksScope.
+const (
+	AnalysisDataLinksInaccessibleLinksScopeExternal AnalysisDataLinksInaccessibleLinksScope = "external"
+	AnalysisDataLinksInaccessibleLinksScopeInternal AnalysisDataLinksInaccessibleLinksScope = "internal"
+)
+
+// Defines values for AnalysisDataLinksLinkIssuesCode.
+const (
+	AnalysisDataLinksLinkIssuesCodeDanglingAnchor    AnalysisDataLinksLinkIssuesCode = "dangling_anchor"
+	AnalysisDataLinksLinkIssuesCodeHttpsDowngrade    AnalysisDataLinksLinkIssuesCode = "https_downgrade"
+	AnalysisDataLinksLinkIssuesCodeLongRedirectChain AnalysisDataLinksLinkIssuesCode = "long_redirect_chain"
+	AnalysisDataLinksLinkIssuesCodeRedirectLoop      AnalysisDataLinksLinkIssuesCode = "redirect_loop"
+	AnalysisDataLinksLinkIssuesCodeRobotsDisallowed  AnalysisDataLinksLinkIssuesCode = "robots_disallowed"
+	AnalysisDataLinksLinkIssuesCodeSoft404           AnalysisDataLinksLinkIssuesCode = "soft_404"
+)
+
+// Defines values for AnalysisDataLinksLinkIssuesScope.
+const (
+	AnalysisDataLinksLinkIssuesScopeExternal AnalysisDataLinksLinkIssuesScope = "external"
+	AnalysisDataLinksLinkIssuesScopeInternal AnalysisDataLinksLinkIssuesScope = "internal"
+)
+
+// Defines values for AnalysisDataLinksLinkIssuesSeverity.
+const (
+	AnalysisDataLinksLinkIssuesSeverityError   AnalysisDataLinksLinkIssuesSeverity = "error"
+	AnalysisDataLinksLinkIssuesSeverityInfo    AnalysisDataLinksLinkIssuesSeverity = "info"
+	AnalysisDataLinksLinkIssuesSeverityWarning AnalysisDataLinksLinkIssuesSeverity = "warning"
+)
+
+// Defines values for AnalysisDataMetaIssuesSeverity.
+const (
+	AnalysisDataMetaIssuesSeverityError   AnalysisDataMetaIssuesSeverity = "error"
+	AnalysisDataMetaIssuesSeverityInfo    AnalysisDataMetaIssuesSeverity = "info"
+	AnalysisDataMetaIssuesSeverityWarning AnalysisDataMetaIssuesSeverity = "warning"
+)
+
+// Defines values for AnalysisDataMixedContentItemsKind.
+const (
+	AnalysisDataMixedContentItemsKindActive  AnalysisDataMixedContentItemsKind = "active"
+	AnalysisDataMixedContentItemsKindForm    AnalysisDataMixedContentItemsKind = "form"
+	AnalysisDataMixedContentItemsKindPassive AnalysisDataMixedContentItemsKind = "passive"
+)
+
+// Defines values for AnalysisDataPerformanceHintsCode.
+const (
+	AnalysisDataPerformanceHintsCodeImageMissingDimensions   AnalysisDataPerformanceHintsCode = "image_missing_dimensions"
+	AnalysisDataPerformanceHintsCodeLargeHtml                AnalysisDataPerformanceHintsCode = "large_html"
+	AnalysisDataPerformanceHintsCodeLargeInlineScripts       AnalysisDataPerformanceHintsCode = "large_inline_scripts"
+	AnalysisDataPerformanceHintsCodeLargeInlineStyles        AnalysisDataPerformanceHintsCode = "large_inline_styles"
+	AnalysisDataPerformanceHintsCodeMissingCacheHeaders      AnalysisDataPerformanceHintsCode = "missing_cache_headers"
+	AnalysisDataPerformanceHintsCodeMissingPreconnect        AnalysisDataPerformanceHintsCode = "missing_preconnect"
+	AnalysisDataPerformanceHintsCodeRenderBlockingScript     AnalysisDataPerformanceHintsCode = "render_blocking_script"
+	AnalysisDataPerformanceHintsCodeRenderBlockingStylesheet AnalysisDataPerformanceHintsCode = "render_blocking_stylesheet"
+	AnalysisDataPerformanceHintsCodeUncompressedResponse     AnalysisDataPerformanceHintsCode = "uncompressed_response"
+)
+
+// Defines values for AnalysisDataPerformanceHintsImpact.
+const (
+	AnalysisDataPerformanceHintsImpactHigh   AnalysisDataPerformanceHintsImpact = "high"
+	AnalysisDataPerformanceHintsImpactLow    AnalysisDataPerformanceHintsImpact = "low"
+	AnalysisDataPerformanceHintsImpactMedium AnalysisDataPerformanceHintsImpact = "medium"
+)
+
+// Defines values for AnalysisDataResourcesInaccessibleResourcesScope.
+const (
+	AnalysisDataResourcesInaccessibleResourcesScopeExternal AnalysisDataResourcesInaccessibleResourcesScope = "external"
+	AnalysisDataResourcesInaccessibleResourcesScopeInternal AnalysisDataResourcesInaccessibleResourcesScope = "internal"
+)
+
+// Defines values for AnalysisDataResourcesResourcesOrigin.
+const (
+	AnalysisDataResourcesResourcesOriginExternal AnalysisDataResourcesResourcesOrigin = "external"
+	AnalysisDataResourcesResourcesOriginInternal AnalysisDataResourcesResourcesOrigin = "internal"
+)
+
+// Defines values for AnalysisDataResourcesResourcesType.
+const (
+	AnalysisDataResourcesResourcesTypeAudio      AnalysisDataResourcesResourcesType = "audio"
+	AnalysisDataResourcesResourcesTypeFont       AnalysisDataResourcesResourcesType = "font"
+	AnalysisDataResourcesResourcesTypeImage      AnalysisDataResourcesResourcesType = "image"
+	AnalysisDataResourcesResourcesTypeScript     AnalysisDataResourcesResourcesType = "script"
+	AnalysisDataResourcesResourcesTypeStylesheet AnalysisDataResourcesResourcesType = "stylesheet"
+	AnalysisDataResourcesResourcesTypeVideo      AnalysisDataResourcesResourcesType = "video"
+)
+
+// Defines values for AnalysisDataStructuredDataFormat.
+const (
+	AnalysisDataStructuredDataFormatJsonLd    AnalysisDataStructuredDataFormat = "json-ld"
+	AnalysisDataStructuredDataFormatMicrodata AnalysisDataStructuredDataFormat = "microdata"
+	AnalysisDataStructuredDataFormatRdfa      AnalysisDataStructuredDataFormat = "rdfa"
+)
+
 // Defines values for AnalysisErrorStatus.
 const (
 	AnalysisErrorStatusFailed AnalysisErrorStatus = "failed"
@@ -51,16 +218,183 @@ const (
 	AnalysisResponseStatusRequested  AnalysisResponseStatus = "requested"
 )
 
+// Defines values for AnalysisResultResultsAccessibilityFindingsSeverity.
+const (
+	AnalysisResultResultsAccessibilityFindingsSeverityError   AnalysisResultResultsAccessibilityFindingsSeverity = "error"
+	AnalysisResultResultsAccessibilityFindingsSeverityInfo    AnalysisResultResultsAccessibilityFindingsSeverity = "info"
+	AnalysisResultResultsAccessibilityFindingsSeverityWarning AnalysisResultResultsAccessibilityFindingsSeverity = "warning"
+)
+
+// Defines values for AnalysisResultResultsEncodingSource.
+const (
+	AnalysisResultResultsEncodingSourceBom         AnalysisResultResultsEncodingSource = "bom"
+	AnalysisResultResultsEncodingSourceContentType AnalysisResultResultsEncodingSource = "content_type"
+	AnalysisResultResultsEncodingSourceDefault     AnalysisResultResultsEncodingSource = "default"
+	AnalysisResultResultsEncodingSourceMeta        AnalysisResultResultsEncodingSource = "meta"
+	AnalysisResultResultsEncodingSourceSniffed     AnalysisResultResultsEncodingSource = "sniffed"
+)
+
+// Defines values for AnalysisResultResultsFormsClassificationsKind.
+const (
+	AnalysisResultResultsFormsClassificationsKindLogin         AnalysisResultResultsFormsClassificationsKind = "login"
+	AnalysisResultResultsFormsClassificationsKindNewsletter    AnalysisResultResultsFormsClassificationsKind = "newsletter"
+	AnalysisResultResultsFormsClassificationsKindPasswordReset AnalysisResultResultsFormsClassificationsKind = "password_reset"
+	AnalysisResultResultsFormsClassificationsKindPayment       AnalysisResultResultsFormsClassificationsKind = "payment"
+	AnalysisResultResultsFormsClassificationsKindSearch        AnalysisResultResultsFormsClassificationsKind = "search"
+	AnalysisResultResultsFormsClassificationsKindSignup        AnalysisResultResultsFormsClassificationsKind = "signup"
+	AnalysisResultResultsFormsClassificationsKindUnknown       AnalysisResultResultsFormsClassificationsKind = "unknown"
+)
+
+// Defines values for AnalysisResultResultsFormsFormsEnctype.
+const (
+	AnalysisResultResultsFormsFormsEnctypeApplicationxWwwFormUrlencoded AnalysisResultResultsFormsFormsEnctype = "application/x-www-form-urlencoded"
+	AnalysisResultResultsFormsFormsEnctypeMultipartformData             AnalysisResultResultsFormsFormsEnctype = "multipart/form-data"
+	AnalysisResultResultsFormsFormsEnctypeTextplain                     AnalysisResultResultsFormsFormsEnctype = "text/plain"
+)
+
+// Defines values for AnalysisResultResultsFormsFormsFindingsSeverity.
+const (
+	AnalysisResultResultsFormsFormsFindingsSeverityError   AnalysisResultResultsFormsFormsFindingsSeverity = "error"
+	AnalysisResultResultsFormsFormsFindingsSeverityInfo    AnalysisResultResultsFormsFormsFindingsSeverity = "info"
+	AnalysisResultResultsFormsFormsFindingsSeverityWarning AnalysisResultResultsFormsFormsFindingsSeverity = "warning"
+)
+
+// Defines values for AnalysisResultResultsFormsFormsMethod.
+const (
+	AnalysisResultResultsFormsFormsMethodGET  AnalysisResultResultsFormsFormsMethod = "GET"
+	AnalysisResultResultsFormsFormsMethodPOST AnalysisResultResultsFormsFormsMethod = "POST"
+)
+
 // Defines values for AnalysisResultResultsFormsLoginFormDetailsMethod.
 const (
+	AnalysisResultResultsFormsLoginFormDetailsMethodGET  AnalysisResultResultsFormsLoginFormDetailsMethod = "GET"
 	AnalysisResultResultsFormsLoginFormDetailsMethodPOST AnalysisResultResultsFormsLoginFormDetailsMethod = "POST"
 )
 
+// Defines values for AnalysisResultResultsFormsSecurityFindingsSeverity.
+const (
+	AnalysisResultResultsFormsSecurityFindingsSeverityError   AnalysisResultResultsFormsSecurityFindingsSeverity = "error"
+	AnalysisResultResultsFormsSecurityFindingsSeverityInfo    AnalysisResultResultsFormsSecurityFindingsSeverity = "info"
+	AnalysisResultResultsFormsSecurityFindingsSeverityWarning AnalysisResultResultsFormsSecurityFindingsSeverity = "warning"
+)
+
+// Defines values for AnalysisResultResultsFormsSecurityMethod.
+const (
+	AnalysisResultResultsFormsSecurityMethodGET  AnalysisResultResultsFormsSecurityMethod = "GET"
+	AnalysisResultResultsFormsSecurityMethodPOST AnalysisResultResultsFormsSecurityMethod = "POST"
+)
+
+// Defines values for AnalysisResultResultsHeadingOutlineIssuesSeverity.
+const (
+	AnalysisResultResultsHeadingOutlineIssuesSeverityError   AnalysisResultResultsHeadingOutlineIssuesSeverity = "error"
+	AnalysisResultResultsHeadingOutlineIssuesSeverityInfo    AnalysisResultResultsHeadingOutlineIssuesSeverity = "info"
+	AnalysisResultResultsHeadingOutlineIssuesSeverityWarning AnalysisResultResultsHeadingOutlineIssuesSeverity = "warning"
+)
+
+// Defines values for AnalysisResultResultsLinksInaccessibleLinksScope.
+const (
+	AnalysisResultResultsLinksInaccessibleLinksScopeExternal AnalysisResultResultsLinksInaccessibleLinksScope = "external"
+	AnalysisResultResultsLinksInaccessibleLinksScopeInternal AnalysisResultResultsLinksInaccessibleLinksScope = "internal"
+)
+
+// Defines values for AnalysisResultResultsLinksLinkIssuesCode.
+const (
+	AnalysisResultResultsLinksLinkIssuesCodeDanglingAnchor    AnalysisResultResultsLinksLinkIssuesCode = "dangling_anchor"
+	AnalysisResultResultsLinksLinkIssuesCodeHttpsDowngrade    AnalysisResultResultsLinksLinkIssuesCode = "https_downgrade"
+	AnalysisResultResultsLinksLinkIssuesCodeLongRedirectChain AnalysisResultResultsLinksLinkIssuesCode = "long_redirect_chain"
+	AnalysisResultResultsLinksLinkIssuesCodeRedirectLoop      AnalysisResultResultsLinksLinkIssuesCode = "redirect_loop"
+	AnalysisResultResultsLinksLinkIssuesCodeRobotsDisallowed  AnalysisResultResultsLinksLinkIssuesCode = "robots_disallowed"
+	AnalysisResultResultsLinksLinkIssuesCodeSoft404           AnalysisResultResultsLinksLinkIssuesCode = "soft_404"
+)
+
+// Defines values for AnalysisResultResultsLinksLinkIssuesScope.
+const (
+	AnalysisResultResultsLinksLinkIssuesScopeExternal AnalysisResultResultsLinksLinkIssuesScope = "external"
+	AnalysisResultResultsLinksLinkIssuesScopeInternal AnalysisResultResultsLinksLinkIssuesScope = "internal"
+)
+
+// Defines values for AnalysisResultResultsLinksLinkIssuesSeverity.
+const (
+	AnalysisResultResultsLinksLinkIssuesSeverityError   AnalysisResultResultsLinksLinkIssuesSeverity = "error"
+	AnalysisResultResultsLinksLinkIssuesSeverityInfo    AnalysisResultResultsLinksLinkIssuesSeverity = "info"
+	AnalysisResultResultsLinksLinkIssuesSeverityWarning AnalysisResultResultsLinksLinkIssuesSeverity = "warning"
+)
+
+// Defines values for AnalysisResultResultsMetaIssuesSeverity.
+const (
+	AnalysisResultResultsMetaIssuesSeverityError   AnalysisResultResultsMetaIssuesSeverity = "error"
+	AnalysisResultResultsMetaIssuesSeverityInfo    AnalysisResultResultsMetaIssuesSeverity = "info"
+	AnalysisResultResultsMetaIssuesSeverityWarning AnalysisResultResultsMetaIssuesSeverity = "warning"
+)
+
+// Defines values for AnalysisResultResultsMixedContentItemsKind.
+const (
+	AnalysisResultResultsMixedContentItemsKindActive  AnalysisResultResultsMixedContentItemsKind = "active"
+	AnalysisResultResultsMixedContentItemsKindForm    AnalysisResultResultsMixedContentItemsKind = "form"
+	AnalysisResultResultsMixedContentItemsKindPassive AnalysisResultResultsMixedContentItemsKind = "passive"
+)
+
+// Defines values for AnalysisResultResultsPerformanceHintsCode.
+const (
+	AnalysisResultResultsPerformanceHintsCodeImageMissingDimensions   AnalysisResultResultsPerformanceHintsCode = "image_missing_dimensions"
+	AnalysisResultResultsPerformanceHintsCodeLargeHtml                AnalysisResultResultsPerformanceHintsCode = "large_html"
+	AnalysisResultResultsPerformanceHintsCodeLargeInlineScripts       AnalysisResultResultsPerformanceHintsCode = "large_inline_scripts"
+	AnalysisResultResultsPerformanceHintsCodeLargeInlineStyles        AnalysisResultResultsPerformanceHintsCode = "large_inline_styles"
+	AnalysisResultResultsPerformanceHintsCodeMissingCacheHeaders      AnalysisResultResultsPerformanceHintsCode = "missing_cache_headers"
+	AnalysisResultResultsPerformanceHintsCodeMissingPreconnect        AnalysisResultResultsPerformanceHintsCode = "missing_preconnect"
+	AnalysisResultResultsPerformanceHintsCodeRenderBlockingScript     AnalysisResultResultsPerformanceHintsCode = "render_blocking_script"
+	AnalysisResultResultsPerformanceHintsCodeRenderBlockingStylesheet AnalysisResultResultsPerformanceHintsCode = "render_blocking_stylesheet"
+	AnalysisResultResultsPerformanceHintsCodeUncompressedResponse     AnalysisResultResultsPerformanceHintsCode = "uncompressed_response"
+)
+
+// Defines values for AnalysisResultResultsPerformanceHintsImpact.
+const (
+	AnalysisResultResultsPerformanceHintsImpactHigh   AnalysisResultResultsPerformanceHintsImpact = "high"
+	AnalysisResultResultsPerformanceHintsImpactLow    AnalysisResultResultsPerformanceHintsImpact = "low"
+	AnalysisResultResultsPerformanceHintsImpactMedium AnalysisResultResultsPerformanceHintsImpact = "medium"
+)
+
+// Defines values for AnalysisResultResultsResourcesInaccessibleResourcesScope.
+const (
+	AnalysisResultResultsResourcesInaccessibleResourcesScopeExternal AnalysisResultResultsResourcesInaccessibleResourcesScope = "external"
+	AnalysisResultResultsResourcesInaccessibleResourcesScopeInternal AnalysisResultResultsResourcesInaccessibleResourcesScope = "internal"
+)
+
+// Defines values for AnalysisResultResultsResourcesResourcesOrigin.
+const (
+	AnalysisResultResultsResourcesResourcesOriginExternal AnalysisResultResultsResourcesResourcesOrigin = "external"
+	AnalysisResultResultsResourcesResourcesOriginInternal AnalysisResultResultsResourcesResourcesOrigin = "internal"
+)
+
+// Defines values for AnalysisResultResultsResourcesResourcesType.
+const (
+	AnalysisResultResultsResourcesResourcesTypeAudio      AnalysisResultResultsResourcesResourcesType = "audio"
+	AnalysisResultResultsResourcesResourcesTypeFont       AnalysisResultResultsResourcesResourcesType = "font"
+	AnalysisResultResultsResourcesResourcesTypeImage      AnalysisResultResultsResourcesResourcesType = "image"
+	AnalysisResultResultsResourcesResourcesTypeScript     AnalysisResultResultsResourcesResourcesType = "script"
+	AnalysisResultResultsResourcesResourcesTypeStylesheet AnalysisResultResultsResourcesResourcesType = "stylesheet"
+	AnalysisResultResultsResourcesResourcesTypeVideo      AnalysisResultResultsResourcesResourcesType = "video"
+)
+
+// Defines values for AnalysisResultResultsStructuredDataFormat.
+const (
+	AnalysisResultResultsStructuredDataFormatJsonLd    AnalysisResultResultsStructuredDataFormat = "json-ld"
+	AnalysisResultResultsStructuredDataFormatMicrodata AnalysisResultResultsStructuredDataFormat = "microdata"
+	AnalysisResultResultsStructuredDataFormatRdfa      AnalysisResultResultsStructuredDataFormat = "rdfa"
+)
+
 // Defines values for AnalysisResultStatus.
 const (
 	Completed AnalysisResultStatus = "completed"
 )
 
+// Defines values for AnalyzeRequestOptionsLinkScope.
+const (
+	AnalyzeRequestOptionsLinkScopeAll      AnalyzeRequestOptionsLinkScope = "all"
+	AnalyzeRequestOptionsLinkScopeExternal AnalyzeRequestOptionsLinkScope = "external"
+	AnalyzeRequestOptionsLinkScopeInternal AnalyzeRequestOptionsLinkScope = "internal"
+)
+
 // Defines values for CacheDependencyCheckStatus.
 const (
 	CacheDependencyCheckStatusDegraded  CacheDependencyCheckStatus = "degraded"
@@ -69,6 +403,13 @@ const (
 	CacheDependencyCheckStatusUnknown   CacheDependencyCheckStatus = "unknown"
 )
 
+// Defines values for CookieFlagsSameSite.
+const (
+	CookieFlagsSameSiteLax    CookieFlagsSameSite = "Lax"
+	CookieFlagsSameSiteNone   CookieFlagsSameSite = "None"
+	CookieFlagsSameSiteStrict CookieFlagsSameSite = "Strict"
+)
+
 // Defines values for DependencyCheckStatus.
 const (
 	DependencyCheckStatusDegraded  DependencyCheckStatus = "degraded"
@@ -77,11 +418,123 @@ const (
 	DependencyCheckStatusUnknown   DependencyCheckStatus = "unknown"
 )
 
+// Defines values for FindingSeverity.
+const (
+	FindingSeverityError   FindingSeverity = "error"
+	FindingSeverityInfo    FindingSeverity = "info"
+	FindingSeverityWarning FindingSeverity = "warning"
+)
+
+// Defines values for FormAnalysisClassificationsKind.
+const (
+	FormAnalysisClassificationsKindLogin         FormAnalysisClassificationsKind = "login"
+	FormAnalysisClassificationsKindNewsletter    FormAnalysisClassificationsKind = "newsletter"
+	FormAnalysisClassificationsKindPasswordReset FormAnalysisClassificationsKind = "password_reset"
+	FormAnalysisClassificationsKindPayment       FormAnalysisClassificationsKind = "payment"
+	FormAnalysisClassificationsKindSearch        FormAnalysisClassificationsKind = "search"
+	FormAnalysisClassificationsKindSignup        FormAnalysisClassificationsKind = "signup"
+	FormAnalysisClassificationsKindUnknown       FormAnalysisClassificationsKind = "unknown"
+)
+
+// Defines values for FormAnalysisFormsEnctype.
+const (
+	FormAnalysisFormsEnctypeApplicationxWwwFormUrlencoded FormAnalysisFormsEnctype = "application/x-www-form-urlencoded"
+	FormAnalysisFormsEnctypeMultipartformData             FormAnalysisFormsEnctype = "multipart/form-data"
+	FormAnalysisFormsEnctypeTextplain                     FormAnalysisFormsEnctype = "text/plain"
+)
+
+// Defines values for FormAnalysisFormsFindingsSeverity.
+const (
+	FormAnalysisFormsFindingsSeverityError   FormAnalysisFormsFindingsSeverity = "error"
+	FormAnalysisFormsFindingsSeverityInfo    FormAnalysisFormsFindingsSeverity = "info"
+	FormAnalysisFormsFindingsSeverityWarning FormAnalysisFormsFindingsSeverity = "warning"
+)
+
+// Defines values for FormAnalysisFormsMethod.
+const (
+	FormAnalysisFormsMethodGET  FormAnalysisFormsMethod = "GET"
+	FormAnalysisFormsMethodPOST FormAnalysisFormsMethod = "POST"
+)
+
 // Defines values for FormAnalysisLoginFormDetailsMethod.
 const (
+	FormAnalysisLoginFormDetailsMethodGET  FormAnalysisLoginFormDetailsMethod = "GET"
 	FormAnalysisLoginFormDetailsMethodPOST FormAnalysisLoginFormDetailsMethod = "POST"
 )
 
+// Defines values for FormAnalysisSecurityFindingsSeverity.
+const (
+	FormAnalysisSecurityFindingsSeverityError   FormAnalysisSecurityFindingsSeverity = "error"
+	FormAnalysisSecurityFindingsSeverityInfo    FormAnalysisSecurityFindingsSeverity = "info"
+	FormAnalysisSecurityFindingsSeverityWarning FormAnalysisSecurityFindingsSeverity = "warning"
+)
+
+// Defines values for FormAnalysisSecurityMethod.
+const (
+	FormAnalysisSecurityMethodGET  FormAnalysisSecurityMethod = "GET"
+	FormAnalysisSecurityMethodPOST FormAnalysisSecurityMethod = "POST"
+)
+
+// Defines values for FormClassificationKind.
+const (
+	FormClassificationKindLogin         FormClassificationKind = "login"
+	FormClassificationKindNewsletter    FormClassificationKind = "newsletter"
+	FormClassificationKindPasswordReset FormClassificationKind = "password_reset"
+	FormClassificationKindPayment       FormClassificationKind = "payment"
+	FormClassificationKindSearch        FormClassificationKind = "search"
+	FormClassificationKindSignup        FormClassificationKind = "signup"
+	FormClassificationKindUnknown       FormClassificationKind = "unknown"
+)
+
+// Defines values for FormDetailEnctype.
+const (
+	FormDetailEnctypeApplicationxWwwFormUrlencoded FormDetailEnctype = "application/x-www-form-urlencoded"
+	FormDetailEnctypeMultipartformData             FormDetailEnctype = "multipart/form-data"
+	FormDetailEnctypeTextplain                     FormDetailEnctype = "text/plain"
+)
+
+// Defines values for FormDetailFindingsSeverity.
+const (
+	FormDetailFindingsSeverityError   FormDetailFindingsSeverity = "error"
+	FormDetailFindingsSeverityInfo    FormDetailFindingsSeverity = "info"
+	FormDetailFindingsSeverityWarning FormDetailFindingsSeverity = "warning"
+)
+
+// Defines values for FormDetailMethod.
+const (
+	FormDetailMethodGET  FormDetailMethod = "GET"
+	FormDetailMethodPOST FormDetailMethod = "POST"
+)
+
+// Defines values for FormSecurityFindingsSeverity.
+const (
+	FormSecurityFindingsSeverityError   FormSecurityFindingsSeverity = "error"
+	FormSecurityFindingsSeverityInfo    FormSecurityFindingsSeverity = "info"
+	FormSecurityFindingsSeverityWarning FormSecurityFindingsSeverity = "warning"
+)
+
+// Defines values for FormSecurityMethod.
+const (
+	FormSecurityMethodGET  FormSecurityMethod = "GET"
+	FormSecurityMethodPOST FormSecurityMethod = "POST"
+)
+
+// Defines values for Grade.
+const (
+	GradeA Grade = "A"
+	GradeB Grade = "B"
+	GradeC Grade = "C"
+	GradeD Grade = "D"
+	GradeF Grade = "F"
+)
+
+// Defines values for HeadingOutlineIssuesSeverity.
+const (
+	HeadingOutlineIssuesSeverityError   HeadingOutlineIssuesSeverity = "error"
+	HeadingOutlineIssuesSeverityInfo    HeadingOutlineIssuesSeverity = "info"
+	HeadingOutlineIssuesSeverityWarning HeadingOutlineIssuesSeverity = "warning"
+)
+
 // Defines values for HealthResponseChecksStatus.
 const (
 	HealthResponseChecksStatusDegraded  HealthResponseChecksStatus = "degraded"
@@ -122,57 +575,417 @@ const (
 	HealthResponseStatusOK          HealthResponseStatus = "OK"
 )
 
-// Defines values for LivenessResponseStatus.
+// Defines values for InaccessibleLinkScope.
+const (
+	InaccessibleLinkScopeExternal InaccessibleLinkScope = "external"
+	InaccessibleLinkScopeInternal InaccessibleLinkScope = "internal"
+)
+
+// Defines values for LinkRegion.
+const (
+	LinkRegionContent    LinkRegion = "content"
+	LinkRegionFooter     LinkRegion = "footer"
+	LinkRegionHeader     LinkRegion = "header"
+	LinkRegionNavigation LinkRegion = "navigation"
+	LinkRegionSidebar    LinkRegion = "sidebar"
+)
+
+// Defines values for LinkType.
+const (
+	LinkTypeExternal LinkType = "external"
+	LinkTypeInternal LinkType = "internal"
+)
+
+// Defines values for LinkAnalysisInaccessibleLinksScope.
+const (
+	LinkAnalysisInaccessibleLinksScopeExternal LinkAnalysisInaccessibleLinksScope = "external"
+	LinkAnalysisInaccessibleLinksScopeInternal LinkAnalysisInaccessibleLinksScope = "internal"
+)
+
+// Defines values for LinkAnalysisLinkIssuesCode.
+const (
+	LinkAnalysisLinkIssuesCodeDanglingAnchor    LinkAnalysisLinkIssuesCode = "dangling_anchor"
+	LinkAnalysisLinkIssuesCodeHttpsDowngrade    LinkAnalysisLinkIssuesCode = "https_downgrade"
+	LinkAnalysisLinkIssuesCodeLongRedirectChain LinkAnalysisLinkIssuesCode = "long_redirect_chain"
+	LinkAnalysisLinkIssuesCodeRedirectLoop      LinkAnalysisLinkIssuesCode = "redirect_loop"
+	LinkAnalysisLinkIssuesCodeRobotsDisallowed  LinkAnalysisLinkIssuesCode = "robots_disallowed"
+	LinkAnalysisLinkIssuesCodeSoft404           LinkAnalysisLinkIssuesCode = "soft_404"
+)
+
+// Defines values for LinkAnalysisLinkIssuesScope.
+const (
+	LinkAnalysisLinkIssuesScopeExternal LinkAnalysisLinkIssuesScope = "external"
+	LinkAnalysisLinkIssuesScopeInternal LinkAnalysisLinkIssuesScope = "internal"
+)
+
+// Defines values for LinkAnalysisLinkIssuesSeverity.
+const (
+	LinkAnalysisLinkIssuesSeverityError   LinkAnalysisLinkIssuesSeverity = "error"
+	LinkAnalysisLinkIssuesSeverityInfo    LinkAnalysisLinkIssuesSeverity = "info"
+	LinkAnalysisLinkIssuesSeverityWarning LinkAnalysisLinkIssuesSeverity = "warning"
+)
+
+// Defines values for LinkIssueCode.
+const (
+	LinkIssueCodeDanglingAnchor    LinkIssueCode = "dangling_anchor"
+	LinkIssueCodeHttpsDowngrade    LinkIssueCode = "https_downgrade"
+	LinkIssueCodeLongRedirectChain LinkIssueCode = "long_redirect_chain"
+	LinkIssueCodeRedirectLoop      LinkIssueCode = "redirect_loop"
+	LinkIssueCodeRobotsDisallowed  LinkIssueCode = "robots_disallowed"
+	LinkIssueCodeSoft404           LinkIssueCode = "soft_404"
+)
+
+// Defines values for LinkIssueScope.
+const (
+	LinkIssueScopeExternal LinkIssueScope = "external"
+	LinkIssueScopeInternal LinkIssueScope = "internal"
+)
+
+// Defines values for LinkIssueSeverity.
+const (
+	LinkIssueSeverityError   LinkIssueSeverity = "error"
+	LinkIssueSeverityInfo    LinkIssueSeverity = "info"
+	LinkIssueSeverityWarning LinkIssueSeverity = "warning"
+)
+
+// Defines values for LinkPageLinksRegion.
+const (
+	LinkPageLinksRegionContent    LinkPageLinksRegion = "content"
+	LinkPageLinksRegionFooter     LinkPageLinksRegion = "footer"
+	LinkPageLinksRegionHeader     LinkPageLinksRegion = "header"
+	LinkPageLinksRegionNavigation LinkPageLinksRegion = "navigation"
+	LinkPageLinksRegionSidebar    LinkPageLinksRegion = "sidebar"
+)
+
+// Defines values for LinkPageLinksType.
+const (
+	LinkPageLinksTypeExternal LinkPageLinksType = "external"
+	LinkPageLinksTypeInternal LinkPageLinksType = "internal"
+)
+
+// Defines values for LivenessResponseStatus.
+const (
+	LivenessResponseStatusDEGRADED    LivenessResponseStatus = "DEGRADED"
+	LivenessResponseStatusDOWN        LivenessResponseStatus = "DOWN"
+	LivenessResponseStatusMAINTENANCE LivenessResponseStatus = "MAINTENANCE"
+	LivenessResponseStatusOK          LivenessResponseStatus = "OK"
+)
+
+// Defines values for LoginFormMethod.
+const (
+	LoginFormMethodGET  LoginFormMethod = "GET"
+	LoginFormMethodPOST LoginFormMethod = "POST"
+)
+
+// Defines values for MetaAnalysisIssuesSeverity.
+const (
+	MetaAnalysisIssuesSeverityError   MetaAnalysisIssuesSeverity = "error"
+	MetaAnalysisIssuesSeverityInfo    MetaAnalysisIssuesSeverity = "info"
+	MetaAnalysisIssuesSeverityWarning MetaAnalysisIssuesSeverity = "warning"
+)
+
+// Defines values for MixedContentItemKind.
+const (
+	MixedContentItemKindActive  MixedContentItemKind = "active"
+	MixedContentItemKindForm    MixedContentItemKind = "form"
+	MixedContentItemKindPassive MixedContentItemKind = "passive"
+)
+
+// Defines values for MixedContentReportItemsKind.
+const (
+	MixedContentReportItemsKindActive  MixedContentReportItemsKind = "active"
+	MixedContentReportItemsKindForm    MixedContentReportItemsKind = "form"
+	MixedContentReportItemsKindPassive MixedContentReportItemsKind = "passive"
+)
+
+// Defines values for PageEncodingSource.
+const (
+	Bom         PageEncodingSource = "bom"
+	ContentType PageEncodingSource = "content_type"
+	Default     PageEncodingSource = "default"
+	Meta        PageEncodingSource = "meta"
+	Sniffed     PageEncodingSource = "sniffed"
+)
+
+// Defines values for PerformanceHintCode.
+const (
+	PerformanceHintCodeImageMissingDimensions   PerformanceHintCode = "image_missing_dimensions"
+	PerformanceHintCodeLargeHtml                PerformanceHintCode = "large_html"
+	PerformanceHintCodeLargeInlineScripts       PerformanceHintCode = "large_inline_scripts"
+	PerformanceHintCodeLargeInlineStyles        PerformanceHintCode = "large_inline_styles"
+	PerformanceHintCodeMissingCacheHeaders      PerformanceHintCode = "missing_cache_headers"
+	PerformanceHintCodeMissingPreconnect        PerformanceHintCode = "missing_preconnect"
+	PerformanceHintCodeRenderBlockingScript     PerformanceHintCode = "render_blocking_script"
+	PerformanceHintCodeRenderBlockingStylesheet PerformanceHintCode = "render_blocking_stylesheet"
+	PerformanceHintCodeUncompressedResponse     PerformanceHintCode = "uncompressed_response"
+)
+
+// Defines values for PerformanceHintImpact.
+const (
+	PerformanceHintImpactHigh   PerformanceHintImpact = "high"
+	PerformanceHintImpactLow    PerformanceHintImpact = "low"
+	PerformanceHintImpactMedium PerformanceHintImpact = "medium"
+)
+
+// Defines values for PerformanceReportHintsCode.
+const (
+	PerformanceReportHintsCodeImageMissingDimensions   PerformanceReportHintsCode = "image_missing_dimensions"
+	PerformanceReportHintsCodeLargeHtml                PerformanceReportHintsCode = "large_html"
+	PerformanceReportHintsCodeLargeInlineScripts       PerformanceReportHintsCode = "large_inline_scripts"
+	PerformanceReportHintsCodeLargeInlineStyles        PerformanceReportHintsCode = "large_inline_styles"
+	PerformanceReportHintsCodeMissingCacheHeaders      PerformanceReportHintsCode = "missing_cache_headers"
+	PerformanceReportHintsCodeMissingPreconnect        PerformanceReportHintsCode = "missing_preconnect"
+	PerformanceReportHintsCodeRenderBlockingScript     PerformanceReportHintsCode = "render_blocking_script"
+	PerformanceReportHintsCodeRenderBlockingStylesheet PerformanceReportHintsCode = "render_blocking_stylesheet"
+	PerformanceReportHintsCodeUncompressedResponse     PerformanceReportHintsCode = "uncompressed_response"
+)
+
+// Defines values for PerformanceReportHintsImpact.
+const (
+	PerformanceReportHintsImpactHigh   PerformanceReportHintsImpact = "high"
+	PerformanceReportHintsImpactLow    PerformanceReportHintsImpact = "low"
+	PerformanceReportHintsImpactMedium PerformanceReportHintsImpact = "medium"
+)
+
+// Defines values for ReadinessResponseChecksStatus.
+const (
+	ReadinessResponseChecksStatusDegraded  ReadinessResponseChecksStatus = "degraded"
+	ReadinessResponseChecksStatusHealthy   ReadinessResponseChecksStatus = "healthy"
+	ReadinessResponseChecksStatusUnhealthy ReadinessResponseChecksStatus = "unhealthy"
+	ReadinessResponseChecksStatusUnknown   ReadinessResponseChecksStatus = "unknown"
+)
+
+// Defines values for ReadinessResponseChecksCacheStatus.
+const (
+	ReadinessResponseChecksCacheStatusDegraded  ReadinessResponseChecksCacheStatus = "degraded"
+	ReadinessResponseChecksCacheStatusHealthy   ReadinessResponseChecksCacheStatus = "healthy"
+	ReadinessResponseChecksCacheStatusUnhealthy ReadinessResponseChecksCacheStatus = "unhealthy"
+	ReadinessResponseChecksCacheStatusUnknown   ReadinessResponseChecksCacheStatus = "unknown"
+)
+
+// Defines values for ReadinessResponseChecksQueueStatus.
+const (
+	ReadinessResponseChecksQueueStatusDegraded  ReadinessResponseChecksQueueStatus = "degraded"
+	ReadinessResponseChecksQueueStatusHealthy   ReadinessResponseChecksQueueStatus = "healthy"
+	ReadinessResponseChecksQueueStatusUnhealthy ReadinessResponseChecksQueueStatus = "unhealthy"
+	ReadinessResponseChecksQueueStatusUnknown   ReadinessResponseChecksQueueStatus = "unknown"
+)
+
+// Defines values for ReadinessResponseChecksStorageStatus.
+const (
+	ReadinessResponseChecksStorageStatusDegraded  ReadinessResponseChecksStorageStatus = "degraded"
+	ReadinessResponseChecksStorageStatusHealthy   ReadinessResponseChecksStorageStatus = "healthy"
+	ReadinessResponseChecksStorageStatusUnhealthy ReadinessResponseChecksStorageStatus = "unhealthy"
+	ReadinessResponseChecksStorageStatusUnknown   ReadinessResponseChecksStorageStatus = "unknown"
+)
+
+// Defines values for ReadinessResponseStatus.
+const (
+	DEGRADED    ReadinessResponseStatus = "DEGRADED"
+	DOWN        ReadinessResponseStatus = "DOWN"
+	MAINTENANCE ReadinessResponseStatus = "MAINTENANCE"
+	OK          ReadinessResponseStatus = "OK"
+)
+
+// Defines values for ResourceOrigin.
+const (
+	ResourceOriginExternal ResourceOrigin = "external"
+	ResourceOriginInternal ResourceOrigin = "internal"
+)
+
+// Defines values for ResourceType.
+const (
+	ResourceTypeAudio      ResourceType = "audio"
+	ResourceTypeFont       ResourceType = "font"
+	ResourceTypeImage      ResourceType = "image"
+	ResourceTypeScript     ResourceType = "script"
+	ResourceTypeStylesheet ResourceType = "stylesheet"
+	ResourceTypeVideo      ResourceType = "video"
+)
+
+// Defines values for ResourceInventoryInaccessibleResourcesScope.
+const (
+	ResourceInventoryInaccessibleResourcesScopeExternal ResourceInventoryInaccessibleResourcesScope = "external"
+	ResourceInventoryInaccessibleResourcesScopeInternal ResourceInventoryInaccessibleResourcesScope = "internal"
+)
+
+// Defines values for ResourceInventoryResourcesOrigin.
+const (
+	ResourceInventoryResourcesOriginExternal ResourceInventoryResourcesOrigin = "external"
+	ResourceInventoryResourcesOriginInternal ResourceInventoryResourcesOrigin = "internal"
+)
+
+// Defines values for ResourceInventoryResourcesType.
+const (
+	ResourceInventoryResourcesTypeAudio      ResourceInventoryResourcesType = "audio"
+	ResourceInventoryResourcesTypeFont       ResourceInventoryResourcesType = "font"
+	ResourceInventoryResourcesTypeImage      ResourceInventoryResourcesType = "image"
+	ResourceInventoryResourcesTypeScript     ResourceInventoryResourcesType = "script"
+	ResourceInventoryResourcesTypeStylesheet ResourceInventoryResourcesType = "stylesheet"
+	ResourceInventoryResourcesTypeVideo      ResourceInventoryResourcesType = "video"
+)
+
+// Defines values for SecurityHeaderAuditChecksFindingsSeverity.
+const (
+	SecurityHeaderAuditChecksFindingsSeverityError   SecurityHeaderAuditChecksFindingsSeverity = "error"
+	SecurityHeaderAuditChecksFindingsSeverityInfo    SecurityHeaderAuditChecksFindingsSeverity = "info"
+	SecurityHeaderAuditChecksFindingsSeverityWarning SecurityHeaderAuditChecksFindingsSeverity = "warning"
+)
+
+// Defines values for SecurityHeaderAuditChecksGrade.
+const (
+	SecurityHeaderAuditChecksGradeA SecurityHeaderAuditChecksGrade = "A"
+	SecurityHeaderAuditChecksGradeB SecurityHeaderAuditChecksGrade = "B"
+	SecurityHeaderAuditChecksGradeC SecurityHeaderAuditChecksGrade = "C"
+	SecurityHeaderAuditChecksGradeD SecurityHeaderAuditChecksGrade = "D"
+	SecurityHeaderAuditChecksGradeF SecurityHeaderAuditChecksGrade = "F"
+)
+
+// Defines values for SecurityHeaderAuditChecksName.
+const (
+	SecurityHeaderAuditChecksNameContentSecurityPolicy     SecurityHeaderAuditChecksName = "content_security_policy"
+	SecurityHeaderAuditChecksNameCookies                   SecurityHeaderAuditChecksName = "cookies"
+	SecurityHeaderAuditChecksNameCrossOriginEmbedderPolicy SecurityHeaderAuditChecksName = "cross_origin_embedder_policy"
+	SecurityHeaderAuditChecksNameCrossOriginOpenerPolicy   SecurityHeaderAuditChecksName = "cross_origin_opener_policy"
+	SecurityHeaderAuditChecksNameFraming                   SecurityHeaderAuditChecksName = "framing"
+	SecurityHeaderAuditChecksNamePermissionsPolicy         SecurityHeaderAuditChecksName = "permissions_policy"
+	SecurityHeaderAuditChecksNameReferrerPolicy            SecurityHeaderAuditChecksName = "referrer_policy"
+	SecurityHeaderAuditChecksNameStrictTransportSecurity   SecurityHeaderAuditChecksName = "strict_transport_security"
+)
+
+// Defines values for SecurityHeaderAuditCookiesSameSite.
+const (
+	SecurityHeaderAuditCookiesSameSiteLax    SecurityHeaderAuditCookiesSameSite = "Lax"
+	SecurityHeaderAuditCookiesSameSiteNone   SecurityHeaderAuditCookiesSameSite = "None"
+	SecurityHeaderAuditCookiesSameSiteStrict SecurityHeaderAuditCookiesSameSite = "Strict"
+)
+
+// Defines values for SecurityHeaderAuditGrade.
+const (
+	SecurityHeaderAuditGradeA SecurityHeaderAuditGrade = "A"
+	SecurityHeaderAuditGradeB SecurityHeaderAuditGrade = "B"
+	SecurityHeaderAuditGradeC SecurityHeaderAuditGrade = "C"
+	SecurityHeaderAuditGradeD SecurityHeaderAuditGrade = "D"
+	SecurityHeaderAuditGradeF SecurityHeaderAuditGrade = "F"
+)
+
+// Defines values for SecurityHeaderCheckFindingsSeverity.
+const (
+	SecurityHeaderCheckFindingsSeverityError   SecurityHeaderCheckFindingsSeverity = "error"
+	SecurityHeaderCheckFindingsSeverityInfo    SecurityHeaderCheckFindingsSeverity = "info"
+	SecurityHeaderCheckFindingsSeverityWarning SecurityHeaderCheckFindingsSeverity = "warning"
+)
+
+// Defines values for SecurityHeaderCheckGrade.
+const (
+	SecurityHeaderCheckGradeA SecurityHeaderCheckGrade = "A"
+	SecurityHeaderCheckGradeB SecurityHeaderCheckGrade = "B"
+	SecurityHeaderCheckGradeC SecurityHeaderCheckGrade = "C"
+	SecurityHeaderCheckGradeD SecurityHeaderCheckGrade = "D"
+	SecurityHeaderCheckGradeF SecurityHeaderCheckGrade = "F"
+)
+
+// Defines values for SecurityHeaderCheckName.
+const (
+	SecurityHeaderCheckNameContentSecurityPolicy     SecurityHeaderCheckName = "content_security_policy"
+	SecurityHeaderCheckNameCookies                   SecurityHeaderCheckName = "cookies"
+	SecurityHeaderCheckNameCrossOriginEmbedderPolicy SecurityHeaderCheckName = "cross_origin_embedder_policy"
+	SecurityHeaderCheckNameCrossOriginOpenerPolicy   SecurityHeaderCheckName = "cross_origin_opener_policy"
+	SecurityHeaderCheckNameFraming                   SecurityHeaderCheckName = "framing"
+	SecurityHeaderCheckNamePermissionsPolicy         SecurityHeaderCheckName = "permissions_policy"
+	SecurityHeaderCheckNameReferrerPolicy            SecurityHeaderCheckName = "referrer_policy"
+	SecurityHeaderCheckNameStrictTransportSecurity   SecurityHeaderCheckName = "strict_transport_security"
+)
+
+// Defines values for StructuredDataItemFormat.
+const (
+	JsonLd    StructuredDataItemFormat = "json-ld"
+	Microdata StructuredDataItemFormat = "microdata"
+	Rdfa      StructuredDataItemFormat = "rdfa"
+)
+
+// Defines values for TechStackTechnologiesCategory.
 const (
-	LivenessResponseStatusDEGRADED    LivenessResponseStatus = "DEGRADED"
-	LivenessResponseStatusDOWN        LivenessResponseStatus = "DOWN"
-	LivenessResponseStatusMAINTENANCE LivenessResponseStatus = "MAINTENANCE"
-	LivenessResponseStatusOK          LivenessResponseStatus = "OK"
+	TechStackTechnologiesCategoryAnalytics           TechStackTechnologiesCategory = "analytics"
+	TechStackTechnologiesCategoryCache               TechStackTechnologiesCategory = "cache"
+	TechStackTechnologiesCategoryCdn                 TechStackTechnologiesCategory = "cdn"
+	TechStackTechnologiesCategoryCms                 TechStackTechnologiesCategory = "cms"
+	TechStackTechnologiesCategoryEcommerce           TechStackTechnologiesCategory = "ecommerce"
+	TechStackTechnologiesCategoryHosting             TechStackTechnologiesCategory = "hosting"
+	TechStackTechnologiesCategoryJavascriptFramework TechStackTechnologiesCategory = "javascript_framework"
+	TechStackTechnologiesCategoryJavascriptLibrary   TechStackTechnologiesCategory = "javascript_library"
+	TechStackTechnologiesCategoryProgrammingLanguage TechStackTechnologiesCategory = "programming_language"
+	TechStackTechnologiesCategoryStaticSiteGenerator TechStackTechnologiesCategory = "static_site_generator"
+	TechStackTechnologiesCategoryTagManager          TechStackTechnologiesCategory = "tag_manager"
+	TechStackTechnologiesCategoryWebFramework        TechStackTechnologiesCategory = "web_framework"
+	TechStackTechnologiesCategoryWebServer           TechStackTechnologiesCategory = "web_server"
 )
 
-// Defines values for LoginFormMethod.
+// Defines values for TechnologyCategory.
 const (
-	LoginFormMethodPOST LoginFormMethod = "POST"
+	TechnologyCategoryAnalytics           TechnologyCategory = "analytics"
+	TechnologyCategoryCache               TechnologyCategory = "cache"
+	TechnologyCategoryCdn                 TechnologyCategory = "cdn"
+	TechnologyCategoryCms                 TechnologyCategory = "cms"
+	TechnologyCategoryEcommerce           TechnologyCategory = "ecommerce"
+	TechnologyCategoryHosting             TechnologyCategory = "hosting"
+	TechnologyCategoryJavascriptFramework TechnologyCategory = "javascript_framework"
+	TechnologyCategoryJavascriptLibrary   TechnologyCategory = "javascript_library"
+	TechnologyCategoryProgrammingLanguage TechnologyCategory = "programming_language"
+	TechnologyCategoryStaticSiteGenerator TechnologyCategory = "static_site_generator"
+	TechnologyCategoryTagManager          TechnologyCategory = "tag_manager"
+	TechnologyCategoryWebFramework        TechnologyCategory = "web_framework"
+	TechnologyCategoryWebServer           TechnologyCategory = "web_server"
 )
 
-// Defines values for ReadinessResponseChecksStatus.
+// Defines values for ThirdPartyDomainCategories.
 const (
-	ReadinessResponseChecksStatusDegraded  ReadinessResponseChecksStatus = "degraded"
-	ReadinessResponseChecksStatusHealthy   ReadinessResponseChecksStatus = "healthy"
-	ReadinessResponseChecksStatusUnhealthy ReadinessResponseChecksStatus = "unhealthy"
-	ReadinessResponseChecksStatusUnknown   ReadinessResponseChecksStatus = "unknown"
+	ThirdPartyDomainCategoriesAdvertising    ThirdPartyDomainCategories = "advertising"
+	ThirdPartyDomainCategoriesAnalytics      ThirdPartyDomainCategories = "analytics"
+	ThirdPartyDomainCategoriesFingerprinting ThirdPartyDomainCategories = "fingerprinting"
+	ThirdPartyDomainCategoriesSocial         ThirdPartyDomainCategories = "social"
 )
 
-// Defines values for ReadinessResponseChecksCacheStatus.
+// Defines values for ThirdPartyDomainResourcesType.
 const (
-	ReadinessResponseChecksCacheStatusDegraded  ReadinessResponseChecksCacheStatus = "degraded"
-	ReadinessResponseChecksCacheStatusHealthy   ReadinessResponseChecksCacheStatus = "healthy"
-	ReadinessResponseChecksCacheStatusUnhealthy ReadinessResponseChecksCacheStatus = "unhealthy"
-	ReadinessResponseChecksCacheStatusUnknown   ReadinessResponseChecksCacheStatus = "unknown"
+	ThirdPartyDomainResourcesTypeIframe     ThirdPartyDomainResourcesType = "iframe"
+	ThirdPartyDomainResourcesTypeImage      ThirdPartyDomainResourcesType = "image"
+	ThirdPartyDomainResourcesTypePixel      ThirdPartyDomainResourcesType = "pixel"
+	ThirdPartyDomainResourcesTypePreconnect ThirdPartyDomainResourcesType = "preconnect"
+	ThirdPartyDomainResourcesTypeScript     ThirdPartyDomainResourcesType = "script"
 )
 
-// Defines values for ReadinessResponseChecksQueueStatus.
+// Defines values for ThirdPartyInventoryDomainsCategories.
 const (
-	ReadinessResponseChecksQueueStatusDegraded  ReadinessResponseChecksQueueStatus = "degraded"
-	ReadinessResponseChecksQueueStatusHealthy   ReadinessResponseChecksQueueStatus = "healthy"
-	ReadinessResponseChecksQueueStatusUnhealthy ReadinessResponseChecksQueueStatus = "unhealthy"
-	ReadinessResponseChecksQueueStatusUnknown   ReadinessResponseChecksQueueStatus = "unknown"
+	ThirdPartyInventoryDomainsCategoriesAdvertising    ThirdPartyInventoryDomainsCategories = "advertising"
+	ThirdPartyInventoryDomainsCategoriesAnalytics      ThirdPartyInventoryDomainsCategories = "analytics"
+	ThirdPartyInventoryDomainsCategoriesFingerprinting ThirdPartyInventoryDomainsCategories = "fingerprinting"
+	ThirdPartyInventoryDomainsCategoriesSocial         ThirdPartyInventoryDomainsCategories = "social"
 )
 
-// Defines values for ReadinessResponseChecksStorageStatus.
+// Defines values for ThirdPartyInventoryDomainsResourcesType.
 const (
-	ReadinessResponseChecksStorageStatusDegraded  ReadinessResponseChecksStorageStatus = "degraded"
-	ReadinessResponseChecksStorageStatusHealthy   ReadinessResponseChecksStorageStatus = "healthy"
-	ReadinessResponseChecksStorageStatusUnhealthy ReadinessResponseChecksStorageStatus = "unhealthy"
-	ReadinessResponseChecksStorageStatusUnknown   ReadinessResponseChecksStorageStatus = "unknown"
+	ThirdPartyInventoryDomainsResourcesTypeIframe     ThirdPartyInventoryDomainsResourcesType = "iframe"
+	ThirdPartyInventoryDomainsResourcesTypeImage      ThirdPartyInventoryDomainsResourcesType = "image"
+	ThirdPartyInventoryDomainsResourcesTypePixel      ThirdPartyInventoryDomainsResourcesType = "pixel"
+	ThirdPartyInventoryDomainsResourcesTypePreconnect ThirdPartyInventoryDomainsResourcesType = "preconnect"
+	ThirdPartyInventoryDomainsResourcesTypeScript     ThirdPartyInventoryDomainsResourcesType = "script"
 )
 
-// Defines values for ReadinessResponseStatus.
+// Defines values for ThirdPartyInventoryIssuesSeverity.
 const (
-	DEGRADED    ReadinessResponseStatus = "DEGRADED"
-	DOWN        ReadinessResponseStatus = "DOWN"
-	MAINTENANCE ReadinessResponseStatus = "MAINTENANCE"
-	OK          ReadinessResponseStatus = "OK"
+	Error   ThirdPartyInventoryIssuesSeverity = "error"
+	Info    ThirdPartyInventoryIssuesSeverity = "info"
+	Warning ThirdPartyInventoryIssuesSeverity = "warning"
+)
+
+// Defines values for ThirdPartyResourceType.
+const (
+	Iframe     ThirdPartyResourceType = "iframe"
+	Image      ThirdPartyResourceType = "image"
+	Pixel      ThirdPartyResourceType = "pixel"
+	Preconnect ThirdPartyResourceType = "preconnect"
+	Script     ThirdPartyResourceType = "script"
 )
 
 // Defines values for HealthResponseV1DependencyCheckStatus.
@@ -198,16 +1011,187 @@ const (
 	GetAnalysisEventsParamsAPIVersionV1 GetAnalysisEventsParamsAPIVersion = "v1"
 )
 
+// Defines values for GetAnalysisLinksParamsType.
+const (
+	GetAnalysisLinksParamsTypeExternal GetAnalysisLinksParamsType = "external"
+	GetAnalysisLinksParamsTypeInternal GetAnalysisLinksParamsType = "internal"
+)
+
+// Defines values for GetAnalysisLinksParamsRegion.
+const (
+	Content    GetAnalysisLinksParamsRegion = "content"
+	Footer     GetAnalysisLinksParamsRegion = "footer"
+	Header     GetAnalysisLinksParamsRegion = "header"
+	Navigation GetAnalysisLinksParamsRegion = "navigation"
+	Sidebar    GetAnalysisLinksParamsRegion = "sidebar"
+)
+
+// Defines values for GetAnalysisLinksParamsAPIVersion.
+const (
+	GetAnalysisLinksParamsAPIVersionV1 GetAnalysisLinksParamsAPIVersion = "v1"
+)
+
 // Defines values for AnalyzeURLParamsAPIVersion.
 const (
-	AnalyzeURLParamsAPIVersionV1 AnalyzeURLParamsAPIVersion = "v1"
+	V1 AnalyzeURLParamsAPIVersion = "v1"
 )
 
+// Defines values for AnalyzeURLJSONBodyOptionsLinkScope.
+const (
+	AnalyzeURLJSONBodyOptionsLinkScopeAll      AnalyzeURLJSONBodyOptionsLinkScope = "all"
+	AnalyzeURLJSONBodyOptionsLinkScopeExternal AnalyzeURLJSONBodyOptionsLinkScope = "external"
+	AnalyzeURLJSONBodyOptionsLinkScopeInternal AnalyzeURLJSONBodyOptionsLinkScope = "internal"
+)
+
+// AccessibilityAnalysis defines model for AccessibilityAnalysis.
+type AccessibilityAnalysis struct {
+	// Findings Violations found by the static WCAG oriented checks
+	Findings *[]struct {
+		// Code Machine readable identifier of the finding
+		Code string `json:"code"`
+
+		// Message Human readable description of the finding
+		Message string `json:"message"`
+
+		// Selector CSS selector path to the offending element
+		Selector *string `json:"selector,omitempty"`
+
+		// Severity How serious the finding is
+		Severity AccessibilityAnalysisFindingsSeverity `json:"severity"`
+
+		// Wcag WCAG success criterion the finding relates to
+		Wcag *string `json:"wcag,omitempty"`
+	} `json:"findings,omitempty"`
+}
+
+// AccessibilityAnalysisFindingsSeverity How serious the finding is
+type AccessibilityAnalysisFindingsSeverity string
+
 // AnalysisData defines model for AnalysisData.
 type AnalysisData struct {
+	Accessibility *struct {
+		// Findings Violations found by the static WCAG oriented checks
+		Findings *[]struct {
+			// Code Machine readable identifier of the finding
+			Code string `json:"code"`
+
+			// Message Human readable description of the finding
+			Message string `json:"message"`
+
+			// Selector CSS selector path to the offending element
+			Selector *string `json:"selector,omitempty"`
+
+			// Severity How serious the finding is
+			Severity AnalysisDataAccessibilityFindingsSeverity `json:"severity"`
+
+			// Wcag WCAG success criterion the finding relates to
+			Wcag *string `json:"wcag,omitempty"`
+		} `json:"findings,omitempty"`
+	} `json:"accessibility,omitempty"`
+
+	// Analyzers Output of each pluggable analyzer keyed by the analyzer name
+	Analyzers *map[string]struct {
+		// Error Why the analyzer failed or was skipped
+		Error *string `json:"error,omitempty"`
+
+		// Output Analyzer specific output, absent when the analyzer failed.
+		// The tech_stack analyzer returns a TechStack, the third_parties analyzer a ThirdPartyInventory
+		// and the security_headers analyzer a SecurityHeaderAudit.
+		Output interface{} `json:"output,omitempty"`
+
+		// Version Version of the analyzer that produced the output
+		Version string `json:"version"`
+	} `json:"analyzers,omitempty"`
+
+	// Encoding Character encoding the page was decoded with before it was transcoded to UTF-8 and analysed
+	Encoding *struct {
+		// Declared Encoding declared by the Content-Type header, or else by a meta tag of the page
+		Declared *string `json:"declared,omitempty"`
+
+		// Detected Canonical name of the encoding the page was decoded with
+		Detected string `json:"detected"`
+
+		// HeaderCharset Charset label of the Content-Type header as it was sent
+		HeaderCharset *string `json:"header_charset,omitempty"`
+
+		// MetaCharset Charset label of the first meta charset or http-equiv Content-Type tag of the page
+		MetaCharset *string `json:"meta_charset,omitempty"`
+
+		// Mismatch Set when the Content-Type header, the byte order mark, the meta tag and the bytes of the page do not agree on the encoding
+		Mismatch bool `json:"mismatch"`
+
+		// Source Where the encoding was taken from, in order of precedence; `sniffed` is UTF-8 or an encoding guessed from the byte frequencies of an undeclared page, and `default` is windows-1252 for undeclared pages nothing else fits
+		Source AnalysisDataEncodingSource `json:"source"`
+	} `json:"encoding,omitempty"`
+
 	// FetchTimeMs Time spent fetching web page content from the target URL in milliseconds
 	FetchTimeMs *int64 `json:"fetch_time_ms,omitempty"`
 	Forms       *struct {
+		// Classifications Purpose of every form in document order, followed by the groups of fields that are not wrapped in a form element and could be classified
+		Classifications *[]struct {
+			Confidence *int `json:"confidence,omitempty"`
+
+			// Index Zero based position of the form in document order; standalone field groups are counted separately
+			Index *int                                  `json:"index,omitempty"`
+			Kind  *AnalysisDataFormsClassificationsKind `json:"kind,omitempty"`
+
+			// Signals Evidence the classification is based on, such as password_field or button_text
+			Signals *[]string `json:"signals,omitempty"`
+
+			// Standalone The fields are not wrapped in a form element and are submitted by JavaScript
+			Standalone *bool `json:"standalone,omitempty"`
+		} `json:"classifications,omitempty"`
+
+		// FederatedProviders Identity providers the page offers federated sign in with
+		FederatedProviders *[]string `json:"federated_providers,omitempty"`
+
+		// Forms Structure of every form on the page, in document order
+		Forms *[]struct {
+			// Action URL the form submits to, resolved against the page URL
+			Action  *string                        `json:"action,omitempty"`
+			Enctype *AnalysisDataFormsFormsEnctype `json:"enctype,omitempty"`
+
+			// Fields Inputs, selects and textareas of the form, without its buttons
+			Fields *[]struct {
+				Autocomplete *string `json:"autocomplete,omitempty"`
+
+				// Label Text the field is announced with, taken from aria-labelledby, aria-label, an associated label or the title attribute
+				Label     *string `json:"label,omitempty"`
+				MaxLength *int    `json:"max_length,omitempty"`
+				MinLength *int    `json:"min_length,omitempty"`
+				Name      *string `json:"name,omitempty"`
+				Pattern   *string `json:"pattern,omitempty"`
+				Required  *bool   `json:"required,omitempty"`
+
+				// Type Input type, or select and textarea for those elements
+				Type *string `json:"type,omitempty"`
+			} `json:"fields,omitempty"`
+
+			// Findings Fields without a label, file uploads without an accept restriction and forms the browser does not validate
+			Findings *[]struct {
+				// Code Machine readable identifier of the finding
+				Code string `json:"code"`
+
+				// Message Human readable description of the finding
+				Message string `json:"message"`
+
+				// Selector CSS selector path to the offending element
+				Selector *string `json:"selector,omitempty"`
+
+				// Severity How serious the finding is
+				Severity AnalysisDataFormsFormsFindingsSeverity `json:"severity"`
+
+				// Wcag WCAG success criterion the finding relates to
+				Wcag *string `json:"wcag,omitempty"`
+			} `json:"findings,omitempty"`
+
+			// Index Zero based position of the form in document order
+			Index  *int                          `json:"index,omitempty"`
+			Method *AnalysisDataFormsFormsMethod `json:"method,omitempty"`
+
+			// Novalidate The form disables browser validation
+			Novalidate *bool `json:"novalidate,omitempty"`
+		} `json:"forms,omitempty"`
 		LoginFormDetails *[]struct {
 			// Action Form action URL
 			Action *string `json:"action,omitempty"`
@@ -219,9 +1203,48 @@ type AnalysisData struct {
 			Method *AnalysisDataFormsLoginFormDetailsMethod `json:"method,omitempty"`
 		} `json:"login_form_details,omitempty"`
 
-		// LoginFormsDetected Number of login forms detected
+		// LoginFormsDetected Number of forms classified as login forms with at least 50 percent confidence
 		LoginFormsDetected *int `json:"login_forms_detected,omitempty"`
 
+		// Security Security assessment of every form on the page, in document order
+		Security *[]struct {
+			// Action URL the form submits to, resolved against the page URL
+			Action           *string `json:"action,omitempty"`
+			CredentialFields *[]struct {
+				// Autocomplete Value of the autocomplete attribute
+				Autocomplete *string `json:"autocomplete,omitempty"`
+				Name         *string `json:"name,omitempty"`
+				Type         *string `json:"type,omitempty"`
+			} `json:"credential_fields,omitempty"`
+			Findings *[]struct {
+				// Code Machine readable identifier of the finding
+				Code string `json:"code"`
+
+				// Message Human readable description of the finding
+				Message string `json:"message"`
+
+				// Selector CSS selector path to the offending element
+				Selector *string `json:"selector,omitempty"`
+
+				// Severity How serious the finding is
+				Severity AnalysisDataFormsSecurityFindingsSeverity `json:"severity"`
+
+				// Wcag WCAG success criterion the finding relates to
+				Wcag *string `json:"wcag,omitempty"`
+			} `json:"findings,omitempty"`
+
+			// HasCsrfToken Form contains a hidden input whose name looks like an anti-forgery token
+			HasCsrfToken     *bool `json:"has_csrf_token,omitempty"`
+			HasPasswordField *bool `json:"has_password_field,omitempty"`
+
+			// Index Zero based position of the form in document order
+			Index *int `json:"index,omitempty"`
+
+			// InsecureAction Form submits over plain HTTP
+			InsecureAction *bool                            `json:"insecure_action,omitempty"`
+			Method         *AnalysisDataFormsSecurityMethod `json:"method,omitempty"`
+		} `json:"security,omitempty"`
+
 		// TotalCount Total number of forms found
 		TotalCount *int `json:"total_count,omitempty"`
 	} `json:"forms,omitempty"`
@@ -233,6 +1256,40 @@ type AnalysisData struct {
 		H5 *int `json:"h5,omitempty"`
 		H6 *int `json:"h6,omitempty"`
 	} `json:"heading_counts,omitempty"`
+	HeadingOutline *struct {
+		// Headings Top level headings of the document outline
+		Headings *[]struct {
+			// Children Lower level headings nested under this heading
+			Children *[]map[string]interface{} `json:"children,omitempty"`
+
+			// Level Heading level (1 for h1 through 6 for h6)
+			Level int `json:"level"`
+
+			// Position Zero based index of the heading in document order
+			Position int `json:"position"`
+
+			// Text Heading text with whitespace collapsed
+			Text string `json:"text"`
+		} `json:"headings,omitempty"`
+
+		// Issues Outline problems such as multiple h1s, level jumps, empty or aria-hidden headings
+		Issues *[]struct {
+			// Code Machine readable identifier of the finding
+			Code string `json:"code"`
+
+			// Message Human readable description of the finding
+			Message string `json:"message"`
+
+			// Selector CSS selector path to the offending element
+			Selector *string `json:"selector,omitempty"`
+
+			// Severity How serious the finding is
+			Severity AnalysisDataHeadingOutlineIssuesSeverity `json:"severity"`
+
+			// Wcag WCAG success criterion the finding relates to
+			Wcag *string `json:"wcag,omitempty"`
+		} `json:"issues,omitempty"`
+	} `json:"heading_outline,omitempty"`
 
 	// HtmlVersion Detected HTML version
 	HtmlVersion *string `json:"html_version,omitempty"`
@@ -240,9 +1297,15 @@ type AnalysisData struct {
 		// ExternalCount Number of external links
 		ExternalCount     *int `json:"external_count,omitempty"`
 		InaccessibleLinks *[]struct {
+			// Cached Whether the result was taken from a recent check shared between analyses
+			Cached *bool `json:"cached,omitempty"`
+
 			// Error Error description
 			Error *string `json:"error,omitempty"`
 
+			// Scope Whether the link was checked as an internal or an external link
+			Scope *AnalysisDataLinksInaccessibleLinksScope `json:"scope,omitempty"`
+
 			// StatusCode HTTP status code received
 			StatusCode *int    `json:"status_code,omitempty"`
 			Url        *string `json:"url,omitempty"`
@@ -251,20 +1314,325 @@ type AnalysisData struct {
 		// InternalCount Number of internal links
 		InternalCount *int `json:"internal_count,omitempty"`
 
+		// LinkIssues Links that can be reached but redirect badly, serve a not found page with a success status or point at a fragment the target document does not have
+		LinkIssues *[]struct {
+			// Code Machine readable identifier of the issue
+			Code AnalysisDataLinksLinkIssuesCode `json:"code"`
+
+			// Message Human readable description of the issue
+			Message   string `json:"message"`
+			Redirects *struct {
+				// FinalUrl URL the check ended on
+				FinalUrl string `json:"final_url"`
+
+				// HopCount Number of redirects followed
+				HopCount int `json:"hop_count"`
+
+				// Hops Redirects followed from the link, in order
+				Hops []struct {
+					// Location Resolved Location the URL redirected to
+					Location string `json:"location"`
+
+					// StatusCode Redirect status the URL answered with
+					StatusCode int `json:"status_code"`
+
+					// Url URL requested
+					Url string `json:"url"`
+				} `json:"hops"`
+			} `json:"redirects,omitempty"`
+
+			// Scope Whether the link was checked as an internal or an external link
+			Scope AnalysisDataLinksLinkIssuesScope `json:"scope"`
+
+			// Severity How serious the issue is
+			Severity AnalysisDataLinksLinkIssuesSeverity `json:"severity"`
+
+			// Text Text the link is announced with
+			Text *string `json:"text,omitempty"`
+			Url  string  `json:"url"`
+		} `json:"link_issues,omitempty"`
+
+		// RegionCounts Number of links per page region (header, navigation, content, sidebar or footer), decided by landmark elements, ARIA roles and common class and id names
+		RegionCounts *map[string]int `json:"region_counts,omitempty"`
+
 		// TotalCount Total number of links
 		TotalCount *int `json:"total_count,omitempty"`
 	} `json:"links,omitempty"`
+	Meta *struct {
+		// CanonicalUrl Resolved URL of the first canonical link
+		CanonicalUrl *string `json:"canonical_url,omitempty"`
+
+		// Charset Declared character encoding of the document
+		Charset *string `json:"charset,omitempty"`
+
+		// Description Content of the meta description tag
+		Description *string `json:"description,omitempty"`
+
+		// Hreflang Alternate language versions of the page
+		Hreflang *[]struct {
+			// Lang Language (and optional region) code of the alternate
+			Lang *string `json:"lang,omitempty"`
+
+			// Url Resolved URL of the alternate
+			Url *string `json:"url,omitempty"`
+		} `json:"hreflang,omitempty"`
+
+		// Issues SEO problems detected in the document head
+		Issues *[]struct {
+			// Code Machine readable identifier of the finding
+			Code string `json:"code"`
+
+			// Message Human readable description of the finding
+			Message string `json:"message"`
+
+			// Selector CSS selector path to the offending element
+			Selector *string `json:"selector,omitempty"`
+
+			// Severity How serious the finding is
+			Severity AnalysisDataMetaIssuesSeverity `json:"severity"`
+
+			// Wcag WCAG success criterion the finding relates to
+			Wcag *string `json:"wcag,omitempty"`
+		} `json:"issues,omitempty"`
+
+		// Keywords Entries of the meta keywords tag
+		Keywords *[]string `json:"keywords,omitempty"`
+
+		// OpenGraph Open Graph properties keyed by name without the `og:` prefix
+		OpenGraph *map[string]string `json:"open_graph,omitempty"`
+
+		// Robots Directives of the robots meta tag, lower-cased
+		Robots *[]string `json:"robots,omitempty"`
+
+		// TwitterCard Twitter Card properties keyed by name without the `twitter:` prefix
+		TwitterCard *map[string]string `json:"twitter_card,omitempty"`
+
+		// Viewport Content of the viewport meta tag
+		Viewport *string `json:"viewport,omitempty"`
+	} `json:"meta,omitempty"`
+
+	// MixedContent Plain HTTP URLs loaded or submitted to by an HTTPS page; absent for pages served over HTTP
+	MixedContent *struct {
+		// ActiveCount Scripts, stylesheets, iframes and plugins browsers block outright
+		ActiveCount *int `json:"active_count,omitempty"`
+
+		// FormCount Form actions and formaction overrides that submit over plain HTTP
+		FormCount *int `json:"form_count,omitempty"`
+		Items     *[]struct {
+			// Element Name of the element referencing the URL
+			Element *string                            `json:"element,omitempty"`
+			Kind    *AnalysisDataMixedContentItemsKind `json:"kind,omitempty"`
+			Url     *string                            `json:"url,omitempty"`
+		} `json:"items,omitempty"`
+
+		// PassiveCount Images and media browsers may upgrade or block
+		PassiveCount *int `json:"passive_count,omitempty"`
+	} `json:"mixed_content,omitempty"`
+
+	// Performance Static performance estimate built from the markup and the response headers without fetching any resources
+	Performance *struct {
+		// Cache Caching related response headers of the document
+		Cache *struct {
+			CacheControl *string `json:"cache_control,omitempty"`
+			Etag         *string `json:"etag,omitempty"`
+			Expires      *string `json:"expires,omitempty"`
+			LastModified *string `json:"last_modified,omitempty"`
+		} `json:"cache,omitempty"`
+
+		// Compressed Document was served with a compressing Content-Encoding
+		Compressed *bool `json:"compressed,omitempty"`
+
+		// ContentEncoding Content-Encoding the document was served with
+		ContentEncoding *string `json:"content_encoding,omitempty"`
+		Hints           *[]struct {
+			// Code Machine readable hint identifier
+			Code *AnalysisDataPerformanceHintsCode `json:"code,omitempty"`
+
+			// Impact Estimated impact of addressing the hint
+			Impact *AnalysisDataPerformanceHintsImpact `json:"impact,omitempty"`
+
+			// Message Human readable description of the hint
+			Message *string `json:"message,omitempty"`
+
+			// Resources URLs or origins the hint applies to
+			Resources *[]string `json:"resources,omitempty"`
+		} `json:"hints,omitempty"`
+
+		// HtmlBytes Size of the HTML document in bytes once its Content-Encoding was decoded, before it was transcoded to UTF-8
+		HtmlBytes *int `json:"html_bytes,omitempty"`
+
+		// ImagesWithoutDimensions Number of images missing a width or height attribute
+		ImagesWithoutDimensions *int `json:"images_without_dimensions,omitempty"`
+
+		// InlineScriptBytes Total size of inline script contents in bytes
+		InlineScriptBytes *int `json:"inline_script_bytes,omitempty"`
+
+		// InlineStyleBytes Total size of inline style contents in bytes
+		InlineStyleBytes *int `json:"inline_style_bytes,omitempty"`
+
+		// RenderBlockingScripts Scripts in the head loaded without async, defer or type="module"
+		RenderBlockingScripts *[]string `json:"render_blocking_scripts,omitempty"`
+
+		// RenderBlockingStylesheets Stylesheets in the head that apply to the initial render
+		RenderBlockingStylesheets *[]string `json:"render_blocking_stylesheets,omitempty"`
+
+		// TransferredBytes Size of the HTML document in bytes as it was sent, before its Content-Encoding was decoded
+		TransferredBytes *int `json:"transferred_bytes,omitempty"`
+	} `json:"performance,omitempty"`
 
 	// ProcessingTimeMs Time spent analyzing the HTML content in milliseconds
 	ProcessingTimeMs *int64 `json:"processing_time_ms,omitempty"`
+	Resources        *struct {
+		// CountsByType Number of subresources per resource type
+		CountsByType *map[string]int `json:"counts_by_type,omitempty"`
+
+		// ExternalCount Number of subresources served from other hosts
+		ExternalCount *int `json:"external_count,omitempty"`
+
+		// InaccessibleResources Subresources that failed the accessibility check, only filled when check_resources is enabled
+		InaccessibleResources *[]struct {
+			// Cached Whether the result was taken from a recent check shared between analyses
+			Cached *bool `json:"cached,omitempty"`
+
+			// Error Error description
+			Error *string `json:"error,omitempty"`
+
+			// Scope Whether the link was checked as an internal or an external link
+			Scope *AnalysisDataResourcesInaccessibleResourcesScope `json:"scope,omitempty"`
+
+			// StatusCode HTTP status code received
+			StatusCode *int    `json:"status_code,omitempty"`
+			Url        *string `json:"url,omitempty"`
+		} `json:"inaccessible_resources,omitempty"`
+
+		// InternalCount Number of subresources served from the analyzed host
+		InternalCount *int `json:"internal_count,omitempty"`
+		Resources     *[]struct {
+			// Async Script is loaded with the async attribute
+			Async *bool `json:"async,omitempty"`
+
+			// Defer Script is loaded with the defer attribute
+			Defer *bool `json:"defer,omitempty"`
+
+			// HasIntegrity Element carries a Subresource Integrity hash
+			HasIntegrity *bool `json:"has_integrity,omitempty"`
+
+			// LazyLoaded Image is loaded with loading="lazy"
+			LazyLoaded *bool                                 `json:"lazy_loaded,omitempty"`
+			Origin     *AnalysisDataResourcesResourcesOrigin `json:"origin,omitempty"`
+			Type       *AnalysisDataResourcesResourcesType   `json:"type,omitempty"`
+
+			// Url Resource URL resolved against the page URL
+			Url *string `json:"url,omitempty"`
+		} `json:"resources,omitempty"`
+
+		// TotalCount Total number of distinct subresources
+		TotalCount *int `json:"total_count,omitempty"`
+	} `json:"resources,omitempty"`
+
+	// Robots What the robots.txt of the analysed origin says about fetching the page as our user agent
+	Robots *struct {
+		// Allowed Whether the page was fetched
+		Allowed bool `json:"allowed"`
+
+		// CrawlDelay Seconds the origin asks crawlers to wait between requests
+		CrawlDelay *float64 `json:"crawl_delay,omitempty"`
+
+		// Overridden Set when an admin asked to fetch the page although robots.txt disallows it
+		Overridden *bool `json:"overridden,omitempty"`
+
+		// Rule The robots.txt directive that decided it
+		Rule *string `json:"rule,omitempty"`
+
+		// Sitemaps Sitemaps the robots.txt lists
+		Sitemaps []string `json:"sitemaps"`
+	} `json:"robots,omitempty"`
+
+	// StructuredData JSON-LD, Microdata and RDFa entities found in the page
+	StructuredData *[]struct {
+		// Errors Parse errors and missing required properties
+		Errors *[]string `json:"errors,omitempty"`
+
+		// Format Syntax the item was declared with
+		Format AnalysisDataStructuredDataFormat `json:"format"`
+
+		// Properties Item properties; nested items are objects carrying their own `@type`
+		Properties map[string]interface{} `json:"properties"`
+
+		// Types Declared types, with the schema.org vocabulary prefix removed
+		Types []string `json:"types"`
+	} `json:"structured_data,omitempty"`
 
 	// Title Page title
 	Title *string `json:"title,omitempty"`
 }
 
+// AnalysisDataAccessibilityFindingsSeverity How serious the finding is
+type AnalysisDataAccessibilityFindingsSeverity string
+
+// AnalysisDataEncodingSource Where the encoding was taken from, in order of precedence; `sniffed` is UTF-8 or an encoding guessed from the byte frequencies of an undeclared page, and `default` is windows-1252 for undeclared pages nothing else fits
+type AnalysisDataEncodingSource string
+
+// AnalysisDataFormsClassificationsKind defines model for AnalysisData.Forms.Classifications.Kind.
+type AnalysisDataFormsClassificationsKind string
+
+// AnalysisDataFormsFormsEnctype defines model for AnalysisData.Forms.Forms.Enctype.
+type AnalysisDataFormsFormsEnctype string
+
+// AnalysisDataFormsFormsFindingsSeverity How serious the finding is
+type AnalysisDataFormsFormsFindingsSeverity string
+
+// AnalysisDataFormsFormsMethod defines model for AnalysisData.Forms.Forms.Method.
+type AnalysisDataFormsFormsMethod string
+
 // AnalysisDataFormsLoginFormDetailsMethod Form submission method
 type AnalysisDataFormsLoginFormDetailsMethod string
 
+// AnalysisDataFormsSecurityFindingsSeverity How serious the finding is
+type AnalysisDataFormsSecurityFindingsSeverity string
+
+// AnalysisDataFormsSecurityMethod defines model for AnalysisData.Forms.Security.Method.
+type AnalysisDataFormsSecurityMethod string
+
+// AnalysisDataHeadingOutlineIssuesSeverity How serious the finding is
+type AnalysisDataHeadingOutlineIssuesSeverity string
+
+// AnalysisDataLinksInaccessibleLinksScope Whether the link was checked as an internal or an external link
+type AnalysisDataLinksInaccessibleLinksScope string
+
+// AnalysisDataLinksLinkIssuesCode Machine readable identifier of the issue
+type AnalysisDataLinksLinkIssuesCode string
+
+// AnalysisDataLinksLinkIssuesScope Whether the link was checked as an internal or an external link
+type AnalysisDataLinksLinkIssuesScope string
+
+// AnalysisDataLinksLinkIssuesSeverity How serious the issue is
+type AnalysisDataLinksLinkIssuesSeverity string
+
+// AnalysisDataMetaIssuesSeverity How serious the finding is
+type AnalysisDataMetaIssuesSeverity string
+
+// AnalysisDataMixedContentItemsKind defines model for AnalysisData.MixedContent.Items.Kind.
+type AnalysisDataMixedContentItemsKind string
+
+// AnalysisDataPerformanceHintsCode Machine readable hint identifier
+type AnalysisDataPerformanceHintsCode string
+
+// AnalysisDataPerformanceHintsImpact Estimated impact of addressing the hint
+type AnalysisDataPerformanceHintsImpact string
+
+// AnalysisDataResourcesInaccessibleResourcesScope Whether the link was checked as an internal or an external link
+type AnalysisDataResourcesInaccessibleResourcesScope string
+
+// AnalysisDataResourcesResourcesOrigin defines model for AnalysisData.Resources.Resources.Origin.
+type AnalysisDataResourcesResourcesOrigin string
+
+// AnalysisDataResourcesResourcesType defines model for AnalysisData.Resources.Resources.Type.
+type AnalysisDataResourcesResourcesType string
+
+// AnalysisDataStructuredDataFormat Syntax the item was declared with
+type AnalysisDataStructuredDataFormat string
+
 // AnalysisError defines model for AnalysisError.
 type AnalysisError struct {
 	AnalysisId *openapi_types.UUID `json:"analysis_id,omitempty"`
@@ -272,7 +1640,8 @@ type AnalysisError struct {
 	// Details Additional error details
 	Details *string `json:"details,omitempty"`
 
-	// Error Error type
+	// Error Error type, ROBOTS_DISALLOWED when the robots.txt of the target disallows fetching it and
+	// ADDRESS_NOT_ALLOWED when the target resolves to a private or local network
 	Error *string `json:"error,omitempty"`
 
 	// ErrorMessage Human-readable error message
@@ -340,9 +1709,129 @@ type AnalysisResult struct {
 	// Duration Analysis duration
 	Duration *string `json:"duration,omitempty"`
 	Results  *struct {
+		Accessibility *struct {
+			// Findings Violations found by the static WCAG oriented checks
+			Findings *[]struct {
+				// Code Machine readable identifier of the finding
+				Code string `json:"code"`
+
+				// Message Human readable description of the finding
+				Message string `json:"message"`
+
+				// Selector CSS selector path to the offending element
+				Selector *string `json:"selector,omitempty"`
+
+				// Severity How serious the finding is
+				Severity AnalysisResultResultsAccessibilityFindingsSeverity `json:"severity"`
+
+				// Wcag WCAG success criterion the finding relates to
+				Wcag *string `json:"wcag,omitempty"`
+			} `json:"findings,omitempty"`
+		} `json:"accessibility,omitempty"`
+
+		// Analyzers Output of each pluggable analyzer keyed by the analyzer name
+		Analyzers *map[string]struct {
+			// Error Why the analyzer failed or was skipped
+			Error *string `json:"error,omitempty"`
+
+			// Output Analyzer specific output, absent when the analyzer failed.
+			// The tech_stack analyzer returns a TechStack, the third_parties analyzer a ThirdPartyInventory
+			// and the security_headers analyzer a SecurityHeaderAudit.
+			Output interface{} `json:"output,omitempty"`
+
+			// Version Version of the analyzer that produced the output
+			Version string `json:"version"`
+		} `json:"analyzers,omitempty"`
+
+		// Encoding Character encoding the page was decoded with before it was transcoded to UTF-8 and analysed
+		Encoding *struct {
+			// Declared Encoding declared by the Content-Type header, or else by a meta tag of the page
+			Declared *string `json:"declared,omitempty"`
+
+			// Detected Canonical name of the encoding the page was decoded with
+			Detected string `json:"detected"`
+
+			// HeaderCharset Charset label of the Content-Type header as it was sent
+			HeaderCharset *string `json:"header_charset,omitempty"`
+
+			// MetaCharset Charset label of the first meta charset or http-equiv Content-Type tag of the page
+			MetaCharset *string `json:"meta_charset,omitempty"`
+
+			// Mismatch Set when the Content-Type header, the byte order mark, the meta tag and the bytes of the page do not agree on the encoding
+			Mismatch bool `json:"mismatch"`
+
+			// Source Where the encoding was taken from, in order of precedence; `sniffed` is UTF-8 or an encoding guessed from the byte frequencies of an undeclared page, and `default` is windows-1252 for undeclared pages nothing else fits
+			Source AnalysisResultResultsEncodingSource `json:"source"`
+		} `json:"encoding,omitempty"`
+
 		// FetchTimeMs Time spent fetching web page content from the target URL in milliseconds
 		FetchTimeMs *int64 `json:"fetch_time_ms,omitempty"`
 		Forms       *struct {
+			// Classifications Purpose of every form in document order, followed by the groups of fields that are not wrapped in a form element and could be classified
+			Classifications *[]struct {
+				Confidence *int `json:"confidence,omitempty"`
+
+				// Index Zero based position of the form in document order; standalone field groups are counted separately
+				Index *int                                           `json:"index,omitempty"`
+				Kind  *AnalysisResultResultsFormsClassificationsKind `json:"kind,omitempty"`
+
+				// Signals Evidence the classification is based on, such as password_field or button_text
+				Signals *[]string `json:"signals,omitempty"`
+
+				// Standalone The fields are not wrapped in a form element and are submitted by JavaScript
+				Standalone *bool `json:"standalone,omitempty"`
+			} `json:"classifications,omitempty"`
+
+			// FederatedProviders Identity providers the page offers federated sign in with
+			FederatedProviders *[]string `json:"federated_providers,omitempty"`
+
+			// Forms Structure of every form on the page, in document order
+			Forms *[]struct {
+				// Action URL the form submits to, resolved against the page URL
+				Action  *string                                 `json:"action,omitempty"`
+				Enctype *AnalysisResultResultsFormsFormsEnctype `json:"enctype,omitempty"`
+
+				// Fields Inputs, selects and textareas of the form, without its buttons
+				Fields *[]struct {
+					Autocomplete *string `json:"autocomplete,omitempty"`
+
+					// Label Text the field is announced with, taken from aria-labelledby, aria-label, an associated label or the title attribute
+					Label     *string `json:"label,omitempty"`
+					MaxLength *int    `json:"max_length,omitempty"`
+					MinLength *int    `json:"min_length,omitempty"`
+					Name      *string `json:"name,omitempty"`
+					Pattern   *string `json:"pattern,omitempty"`
+					Required  *bool   `json:"required,omitempty"`
+
+					// Type Input type, or select and textarea for those elements
+					Type *string `json:"type,omitempty"`
+				} `json:"fields,omitempty"`
+
+				// Findings Fields without a label, file uploads without an accept restriction and forms the browser does not validate
+				Findings *[]struct {
+					// Code Machine readable identifier of the finding
+					Code string `json:"code"`
+
+					// Message Human readable description of the finding
+					Message string `json:"message"`
+
+					// Selector CSS selector path to the offending element
+					Selector *string `json:"selector,omitempty"`
+
+					// Severity How serious the finding is
+					Severity AnalysisResultResultsFormsFormsFindingsSeverity `json:"severity"`
+
+					// Wcag WCAG success criterion the finding relates to
+					Wcag *string `json:"wcag,omitempty"`
+				} `json:"findings,omitempty"`
+
+				// Index Zero based position of the form in document order
+				Index  *int                                   `json:"index,omitempty"`
+				Method *AnalysisResultResultsFormsFormsMethod `json:"method,omitempty"`
+
+				// Novalidate The form disables browser validation
+				Novalidate *bool `json:"novalidate,omitempty"`
+			} `json:"forms,omitempty"`
 			LoginFormDetails *[]struct {
 				// Action Form action URL
 				Action *string `json:"action,omitempty"`
@@ -354,9 +1843,48 @@ type AnalysisResult struct {
 				Method *AnalysisResultResultsFormsLoginFormDetailsMethod `json:"method,omitempty"`
 			} `json:"login_form_details,omitempty"`
 
-			// LoginFormsDetected Number of login forms detected
+			// LoginFormsDetected Number of forms classified as login forms with at least 50 percent confidence
 			LoginFormsDetected *int `json:"login_forms_detected,omitempty"`
 
+			// Security Security assessment of every form on the page, in document order
+			Security *[]struct {
+				// Action URL the form submits to, resolved against the page URL
+				Action           *string `json:"action,omitempty"`
+				CredentialFields *[]struct {
+					// Autocomplete Value of the autocomplete attribute
+					Autocomplete *string `json:"autocomplete,omitempty"`
+					Name         *string `json:"name,omitempty"`
+					Type         *string `json:"type,omitempty"`
+				} `json:"credential_fields,omitempty"`
+				Findings *[]struct {
+					// Code Machine readable identifier of the finding
+					Code string `json:"code"`
+
+					// Message Human readable description of the finding
+					Message string `json:"message"`
+
+					// Selector CSS selector path to the offending element
+					Selector *string `json:"selector,omitempty"`
+
+					// Severity How serious the finding is
+					Severity AnalysisResultResultsFormsSecurityFindingsSeverity `json:"severity"`
+
+					// Wcag WCAG success criterion the finding relates to
+					Wcag *string `json:"wcag,omitempty"`
+				} `json:"findings,omitempty"`
+
+				// HasCsrfToken Form contains a hidden input whose name looks like an anti-forgery token
+				HasCsrfToken     *bool `json:"has_csrf_token,omitempty"`
+				HasPasswordField *bool `json:"has_password_field,omitempty"`
+
+				// Index Zero based position of the form in document order
+				Index *int `json:"index,omitempty"`
+
+				// InsecureAction Form submits over plain HTTP
+				InsecureAction *bool                                     `json:"insecure_action,omitempty"`
+				Method         *AnalysisResultResultsFormsSecurityMethod `json:"method,omitempty"`
+			} `json:"security,omitempty"`
+
 			// TotalCount Total number of forms found
 			TotalCount *int `json:"total_count,omitempty"`
 		} `json:"forms,omitempty"`
@@ -368,30 +1896,312 @@ type AnalysisResult struct {
 			H5 *int `json:"h5,omitempty"`
 			H6 *int `json:"h6,omitempty"`
 		} `json:"heading_counts,omitempty"`
+		HeadingOutline *struct {
+			// Headings Top level headings of the document outline
+			Headings *[]struct {
+				// Children Lower level headings nested under this heading
+				Children *[]map[string]interface{} `json:"children,omitempty"`
+
+				// Level Heading level (1 for h1 through 6 for h6)
+				Level int `json:"level"`
+
+				// Position Zero based index of the heading in document order
+				Position int `json:"position"`
+
+				// Text Heading text with whitespace collapsed
+				Text string `json:"text"`
+			} `json:"headings,omitempty"`
+
+			// Issues Outline problems such as multiple h1s, level jumps, empty or aria-hidden headings
+			Issues *[]struct {
+				// Code Machine readable identifier of the finding
+				Code string `json:"code"`
+
+				// Message Human readable description of the finding
+				Message string `json:"message"`
+
+				// Selector CSS selector path to the offending element
+				Selector *string `json:"selector,omitempty"`
+
+				// Severity How serious the finding is
+				Severity AnalysisResultResultsHeadingOutlineIssuesSeverity `json:"severity"`
+
+				// Wcag WCAG success criterion the finding relates to
+				Wcag *string `json:"wcag,omitempty"`
+			} `json:"issues,omitempty"`
+		} `json:"heading_outline,omitempty"`
+
+		// HtmlVersion Detected HTML version
+		HtmlVersion *string `json:"html_version,omitempty"`
+		Links       *struct {
+			// ExternalCount Number of external links
+			ExternalCount     *int `json:"external_count,omitempty"`
+			InaccessibleLinks *[]struct {
+				// Cached Whether the result was taken from a recent check shared between analyses
+				Cached *bool `json:"cached,omitempty"`
+
+				// Error Error description
+				Error *string `json:"error,omitempty"`
+
+				// Scope Whether the link was checked as an internal or an external link
+				Scope *AnalysisResultResultsLinksInaccessibleLinksScope `json:"scope,omitempty"`
+
+				// StatusCode HTTP status code received
+				StatusCode *int    `json:"status_code,omitempty"`
+				Url        *string `json:"url,omitempty"`
+			} `json:"inaccessible_links,omitempty"`
+
+			// InternalCount Number of internal links
+			InternalCount *int `json:"internal_count,omitempty"`
+
+			// LinkIssues Links that can be reached but redirect badly, serve a not found page with a success status or point at a fragment the target document does not have
+			LinkIssues *[]struct {
+				// Code Machine readable identifier of the issue
+				Code AnalysisResultResultsLinksLinkIssuesCode `json:"code"`
+
+				// Message Human readable description of the issue
+				Message   string `json:"message"`
+				Redirects *struct {
+					// FinalUrl URL the check ended on
+					FinalUrl string `json:"final_url"`
+
+					// HopCount Number of redirects followed
+					HopCount int `json:"hop_count"`
+
+					// Hops Redirects followed from the link, in order
+					Hops []struct {
+						// Location Resolved Location the URL redirected to
+						Location string `json:"location"`
+
+						// StatusCode Redirect status the URL answered with
+						StatusCode int `json:"status_code"`
+
+						// Url URL requested
+						Url string `json:"url"`
+					} `json:"hops"`
+				} `json:"redirects,omitempty"`
+
+				// Scope Whether the link was checked as an internal or an external link
+				Scope AnalysisResultResultsLinksLinkIssuesScope `json:"scope"`
+
+				// Severity How serious the issue is
+				Severity AnalysisResultResultsLinksLinkIssuesSeverity `json:"severity"`
+
+				// Text Text the link is announced with
+				Text *string `json:"text,omitempty"`
+				Url  string  `json:"url"`
+			} `json:"link_issues,omitempty"`
+
+			// RegionCounts Number of links per page region (header, navigation, content, sidebar or footer), decided by landmark elements, ARIA roles and common class and id names
+			RegionCounts *map[string]int `json:"region_counts,omitempty"`
+
+			// TotalCount Total number of links
+			TotalCount *int `json:"total_count,omitempty"`
+		} `json:"links,omitempty"`
+		Meta *struct {
+			// CanonicalUrl Resolved URL of the first canonical link
+			CanonicalUrl *string `json:"canonical_url,omitempty"`
+
+			// Charset Declared character encoding of the document
+			Charset *string `json:"charset,omitempty"`
+
+			// Description Content of the meta description tag
+			Description *string `json:"description,omitempty"`
+
+			// Hreflang Alternate language versions of the page
+			Hreflang *[]struct {
+				// Lang Language (and optional region) code of the alternate
+				Lang *string `json:"lang,omitempty"`
+
+				// Url Resolved URL of the alternate
+				Url *string `json:"url,omitempty"`
+			} `json:"hreflang,omitempty"`
+
+			// Issues SEO problems detected in the document head
+			Issues *[]struct {
+				// Code Machine readable identifier of the finding
+				Code string `json:"code"`
+
+				// Message Human readable description of the finding
+				Message string `json:"message"`
+
+				// Selector CSS selector path to the offending element
+				Selector *string `json:"selector,omitempty"`
+
+				// Severity How serious the finding is
+				Severity AnalysisResultResultsMetaIssuesSeverity `json:"severity"`
+
+				// Wcag WCAG success criterion the finding relates to
+				Wcag *string `json:"wcag,omitempty"`
+			} `json:"issues,omitempty"`
+
+			// Keywords Entries of the meta keywords tag
+			Keywords *[]string `json:"keywords,omitempty"`
+
+			// OpenGraph Open Graph properties keyed by name without the `og:` prefix
+			OpenGraph *map[string]string `json:"open_graph,omitempty"`
+
+			// Robots Directives of the robots meta tag, lower-cased
+			Robots *[]string `json:"robots,omitempty"`
+
+			// TwitterCard Twitter Card properties keyed by name without the `twitter:` prefix
+			TwitterCard *map[string]string `json:"twitter_card,omitempty"`
+
+			// Viewport Content of the viewport meta tag
+			Viewport *string `json:"viewport,omitempty"`
+		} `json:"meta,omitempty"`
+
+		// MixedContent Plain HTTP URLs loaded or submitted to by an HTTPS page; absent for pages served over HTTP
+		MixedContent *struct {
+			// ActiveCount Scripts, stylesheets, iframes and plugins browsers block outright
+			ActiveCount *int `json:"active_count,omitempty"`
+
+			// FormCount Form actions and formaction overrides that submit over plain HTTP
+			FormCount *int `json:"form_count,omitempty"`
+			Items     *[]struct {
+				// Element Name of the element referencing the URL
+				Element *string                                     `json:"element,omitempty"`
+				Kind    *AnalysisResultResultsMixedContentItemsKind `json:"kind,omitempty"`
+				Url     *string                                     `json:"url,omitempty"`
+			} `json:"items,omitempty"`
+
+			// PassiveCount Images and media browsers may upgrade or block
+			PassiveCount *int `json:"passive_count,omitempty"`
+		} `json:"mixed_content,omitempty"`
+
+		// Performance Static performance estimate built from the markup and the response headers without fetching any resources
+		Performance *struct {
+			// Cache Caching related response headers of the document
+			Cache *struct {
+				CacheControl *string `json:"cache_control,omitempty"`
+				Etag         *string `json:"etag,omitempty"`
+				Expires      *string `json:"expires,omitempty"`
+				LastModified *string `json:"last_modified,omitempty"`
+			} `json:"cache,omitempty"`
+
+			// Compressed Document was served with a compressing Content-Encoding
+			Compressed *bool `json:"compressed,omitempty"`
+
+			// ContentEncoding Content-Encoding the document was served with
+			ContentEncoding *string `json:"content_encoding,omitempty"`
+			Hints           *[]struct {
+				// Code Machine readable hint identifier
+				Code *AnalysisResultResultsPerformanceHintsCode `json:"code,omitempty"`
+
+				// Impact Estimated impact of addressing the hint
+				Impact *AnalysisResultResultsPerformanceHintsImpact `json:"impact,omitempty"`
+
+				// Message Human readable description of the hint
+				Message *string `json:"message,omitempty"`
+
+				// Resources URLs or origins the hint applies to
+				Resources *[]string `json:"resources,omitempty"`
+			} `json:"hints,omitempty"`
+
+			// HtmlBytes Size of the HTML document in bytes once its Content-Encoding was decoded, before it was transcoded to UTF-8
+			HtmlBytes *int `json:"html_bytes,omitempty"`
+
+			// ImagesWithoutDimensions Number of images missing a width or height attribute
+			ImagesWithoutDimensions *int `json:"images_without_dimensions,omitempty"`
+
+			// InlineScriptBytes Total size of inline script contents in bytes
+			InlineScriptBytes *int `json:"inline_script_bytes,omitempty"`
+
+			// InlineStyleBytes Total size of inline style contents in bytes
+			InlineStyleBytes *int `json:"inline_style_bytes,omitempty"`
+
+			// RenderBlockingScripts Scripts in the head loaded without async, defer or type="module"
+			RenderBlockingScripts *[]string `json:"render_blocking_scripts,omitempty"`
+
+			// RenderBlockingStylesheets Stylesheets in the head that apply to the initial render
+			RenderBlockingStylesheets *[]string `json:"render_blocking_stylesheets,omitempty"`
+
+			// TransferredBytes Size of the HTML document in bytes as it was sent, before its Content-Encoding was decoded
+			TransferredBytes *int `json:"transferred_bytes,omitempty"`
+		} `json:"performance,omitempty"`
+
+		// ProcessingTimeMs Time spent analyzing the HTML content in milliseconds
+		ProcessingTimeMs *int64 `json:"processing_time_ms,omitempty"`
+		Resources        *struct {
+			// CountsByType Number of subresources per resource type
+			CountsByType *map[string]int `json:"counts_by_type,omitempty"`
+
+			// ExternalCount Number of subresources served from other hosts
+			ExternalCount *int `json:"external_count,omitempty"`
+
+			// InaccessibleResources Subresources that failed the accessibility check, only filled when check_resources is enabled
+			InaccessibleResources *[]struct {
+				// Cached Whether the result was taken from a recent check shared between analyses
+				Cached *bool `json:"cached,omitempty"`
 
-		// HtmlVersion Detected HTML version
-		HtmlVersion *string `json:"html_version,omitempty"`
-		Links       *struct {
-			// ExternalCount Number of external links
-			ExternalCount     *int `json:"external_count,omitempty"`
-			InaccessibleLinks *[]struct {
 				// Error Error description
 				Error *string `json:"error,omitempty"`
 
+				// Scope Whether the link was checked as an internal or an external link
+				Scope *AnalysisResultResultsResourcesInaccessibleResourcesScope `json:"scope,omitempty"`
+
 				// StatusCode HTTP status code received
 				StatusCode *int    `json:"status_code,omitempty"`
 				Url        *string `json:"url,omitempty"`
-			} `json:"inaccessible_links,omitempty"`
+			} `json:"inaccessible_resources,omitempty"`
 
-			// InternalCount Number of internal links
+			// InternalCount Number of subresources served from the analyzed host
 			InternalCount *int `json:"internal_count,omitempty"`
+			Resources     *[]struct {
+				// Async Script is loaded with the async attribute
+				Async *bool `json:"async,omitempty"`
 
-			// TotalCount Total number of links
+				// Defer Script is loaded with the defer attribute
+				Defer *bool `json:"defer,omitempty"`
+
+				// HasIntegrity Element carries a Subresource Integrity hash
+				HasIntegrity *bool `json:"has_integrity,omitempty"`
+
+				// LazyLoaded Image is loaded with loading="lazy"
+				LazyLoaded *bool                                          `json:"lazy_loaded,omitempty"`
+				Origin     *AnalysisResultResultsResourcesResourcesOrigin `json:"origin,omitempty"`
+				Type       *AnalysisResultResultsResourcesResourcesType   `json:"type,omitempty"`
+
+				// Url Resource URL resolved against the page URL
+				Url *string `json:"url,omitempty"`
+			} `json:"resources,omitempty"`
+
+			// TotalCount Total number of distinct subresources
 			TotalCount *int `json:"total_count,omitempty"`
-		} `json:"links,omitempty"`
+		} `json:"resources,omitempty"`
 
-		// ProcessingTimeMs Time spent analyzing the HTML content in milliseconds
-		ProcessingTimeMs *int64 `json:"processing_time_ms,omitempty"`
+		// Robots What the robots.txt of the analysed origin says about fetching the page as our user agent
+		Robots *struct {
+			// Allowed Whether the page was fetched
+			Allowed bool `json:"allowed"`
+
+			// CrawlDelay Seconds the origin asks crawlers to wait between requests
+			CrawlDelay *float64 `json:"crawl_delay,omitempty"`
+
+			// Overridden Set when an admin asked to fetch the page although robots.txt disallows it
+			Overridden *bool `json:"overridden,omitempty"`
+
+			// Rule The robots.txt directive that decided it
+			Rule *string `json:"rule,omitempty"`
+
+			// Sitemaps Sitemaps the robots.txt lists
+			Sitemaps []string `json:"sitemaps"`
+		} `json:"robots,omitempty"`
+
+		// StructuredData JSON-LD, Microdata and RDFa entities found in the page
+		StructuredData *[]struct {
+			// Errors Parse errors and missing required properties
+			Errors *[]string `json:"errors,omitempty"`
+
+			// Format Syntax the item was declared with
+			Format AnalysisResultResultsStructuredDataFormat `json:"format"`
+
+			// Properties Item properties; nested items are objects carrying their own `@type`
+			Properties map[string]interface{} `json:"properties"`
+
+			// Types Declared types, with the schema.org vocabulary prefix removed
+			Types []string `json:"types"`
+		} `json:"structured_data,omitempty"`
 
 		// Title Page title
 		Title *string `json:"title,omitempty"`
@@ -400,24 +2210,109 @@ type AnalysisResult struct {
 	Url    *string               `json:"url,omitempty"`
 }
 
+// AnalysisResultResultsAccessibilityFindingsSeverity How serious the finding is
+type AnalysisResultResultsAccessibilityFindingsSeverity string
+
+// AnalysisResultResultsEncodingSource Where the encoding was taken from, in order of precedence; `sniffed` is UTF-8 or an encoding guessed from the byte frequencies of an undeclared page, and `default` is windows-1252 for undeclared pages nothing else fits
+type AnalysisResultResultsEncodingSource string
+
+// AnalysisResultResultsFormsClassificationsKind defines model for AnalysisResult.Results.Forms.Classifications.Kind.
+type AnalysisResultResultsFormsClassificationsKind string
+
+// AnalysisResultResultsFormsFormsEnctype defines model for AnalysisResult.Results.Forms.Forms.Enctype.
+type AnalysisResultResultsFormsFormsEnctype string
+
+// AnalysisResultResultsFormsFormsFindingsSeverity How serious the finding is
+type AnalysisResultResultsFormsFormsFindingsSeverity string
+
+// AnalysisResultResultsFormsFormsMethod defines model for AnalysisResult.Results.Forms.Forms.Method.
+type AnalysisResultResultsFormsFormsMethod string
+
 // AnalysisResultResultsFormsLoginFormDetailsMethod Form submission method
 type AnalysisResultResultsFormsLoginFormDetailsMethod string
 
+// AnalysisResultResultsFormsSecurityFindingsSeverity How serious the finding is
+type AnalysisResultResultsFormsSecurityFindingsSeverity string
+
+// AnalysisResultResultsFormsSecurityMethod defines model for AnalysisResult.Results.Forms.Security.Method.
+type AnalysisResultResultsFormsSecurityMethod string
+
+// AnalysisResultResultsHeadingOutlineIssuesSeverity How serious the finding is
+type AnalysisResultResultsHeadingOutlineIssuesSeverity string
+
+// AnalysisResultResultsLinksInaccessibleLinksScope Whether the link was checked as an internal or an external link
+type AnalysisResultResultsLinksInaccessibleLinksScope string
+
+// AnalysisResultResultsLinksLinkIssuesCode Machine readable identifier of the issue
+type AnalysisResultResultsLinksLinkIssuesCode string
+
+// AnalysisResultResultsLinksLinkIssuesScope Whether the link was checked as an internal or an external link
+type AnalysisResultResultsLinksLinkIssuesScope string
+
+// AnalysisResultResultsLinksLinkIssuesSeverity How serious the issue is
+type AnalysisResultResultsLinksLinkIssuesSeverity string
+
+// AnalysisResultResultsMetaIssuesSeverity How serious the finding is
+type AnalysisResultResultsMetaIssuesSeverity string
+
+// AnalysisResultResultsMixedContentItemsKind defines model for AnalysisResult.Results.MixedContent.Items.Kind.
+type AnalysisResultResultsMixedContentItemsKind string
+
+// AnalysisResultResultsPerformanceHintsCode Machine readable hint identifier
+type AnalysisResultResultsPerformanceHintsCode string
+
+// AnalysisResultResultsPerformanceHintsImpact Estimated impact of addressing the hint
+type AnalysisResultResultsPerformanceHintsImpact string
+
+// AnalysisResultResultsResourcesInaccessibleResourcesScope Whether the link was checked as an internal or an external link
+type AnalysisResultResultsResourcesInaccessibleResourcesScope string
+
+// AnalysisResultResultsResourcesResourcesOrigin defines model for AnalysisResult.Results.Resources.Resources.Origin.
+type AnalysisResultResultsResourcesResourcesOrigin string
+
+// AnalysisResultResultsResourcesResourcesType defines model for AnalysisResult.Results.Resources.Resources.Type.
+type AnalysisResultResultsResourcesResourcesType string
+
+// AnalysisResultResultsStructuredDataFormat Syntax the item was declared with
+type AnalysisResultResultsStructuredDataFormat string
+
 // AnalysisResultStatus defines model for AnalysisResult.Status.
 type AnalysisResultStatus string
 
 // AnalyzeRequest defines model for AnalyzeRequest.
 type AnalyzeRequest struct {
 	Options *struct {
+		// Accessibility Whether to run the static accessibility audit
+		Accessibility *bool `json:"accessibility,omitempty"`
+
+		// Analyzers Pluggable analyzers to run by name, together with the analyzers they depend on.
+		// When omitted every registered analyzer runs; an empty list runs none. Unknown names
+		// are rejected with 400 Bad Request.
+		Analyzers *[]string `json:"analyzers,omitempty"`
+
 		// CheckLinks Whether to check link accessibility
 		CheckLinks *bool `json:"check_links,omitempty"`
 
+		// CheckResources Whether to check the accessibility of the scripts, stylesheets, images, fonts and media the page loads; same origin assets are paced by the internal rate limit
+		CheckResources *bool `json:"check_resources,omitempty"`
+
 		// DetectForms Whether to detect login forms
 		DetectForms *bool `json:"detect_forms,omitempty"`
 
+		// IgnoreRobotsTxt Fetch the page and check its links even where robots.txt disallows it. Only tokens
+		// granted the admin scope may set it; other tokens get a 403.
+		IgnoreRobotsTxt *bool `json:"ignore_robots_txt,omitempty"`
+
 		// IncludeHeadings Whether to include heading analysis
 		IncludeHeadings *bool `json:"include_headings,omitempty"`
 
+		// IncludeMeta Whether to include SEO meta tag analysis
+		IncludeMeta *bool `json:"include_meta,omitempty"`
+
+		// LinkScope Which links to check when check_links is set. Internal links are checked with a lower
+		// concurrency and a per-analysis rate limit so the analysed site is not overloaded.
+		LinkScope *AnalyzeRequestOptionsLinkScope `json:"link_scope,omitempty"`
+
 		// Timeout Request timeout in seconds
 		Timeout *int `json:"timeout,omitempty"`
 	} `json:"options,omitempty"`
@@ -426,6 +2321,32 @@ type AnalyzeRequest struct {
 	Url string `json:"url"`
 }
 
+// AnalyzeRequestOptionsLinkScope Which links to check when check_links is set. Internal links are checked with a lower
+// concurrency and a per-analysis rate limit so the analysed site is not overloaded.
+type AnalyzeRequestOptionsLinkScope string
+
+// AnalyzerResult defines model for AnalyzerResult.
+type AnalyzerResult struct {
+	// Error Why the analyzer failed or was skipped
+	Error *string `json:"error,omitempty"`
+
+	// Output Analyzer specific output, absent when the analyzer failed.
+	// The tech_stack analyzer returns a TechStack, the third_parties analyzer a ThirdPartyInventory
+	// and the security_headers analyzer a SecurityHeaderAudit.
+	Output interface{} `json:"output,omitempty"`
+
+	// Version Version of the analyzer that produced the output
+	Version string `json:"version"`
+}
+
+// CSPPolicy defines model for CSPPolicy.
+type CSPPolicy struct {
+	Directives *map[string][]string `json:"directives,omitempty"`
+
+	// ReportOnly Policy came from Content-Security-Policy-Report-Only and is not enforced
+	ReportOnly *bool `json:"report_only,omitempty"`
+}
+
 // CacheDependencyCheck defines model for CacheDependencyCheck.
 type CacheDependencyCheck struct {
 	Details *CacheDependencyCheck_Details `json:"details,omitempty"`
@@ -479,6 +2400,47 @@ type CacheDependencyCheck_Details struct {
 // CacheDependencyCheckStatus Health status of the dependency
 type CacheDependencyCheckStatus string
 
+// CacheHeaders Caching related response headers of the document
+type CacheHeaders struct {
+	CacheControl *string `json:"cache_control,omitempty"`
+	Etag         *string `json:"etag,omitempty"`
+	Expires      *string `json:"expires,omitempty"`
+	LastModified *string `json:"last_modified,omitempty"`
+}
+
+// CookieFlags defines model for CookieFlags.
+type CookieFlags struct {
+	HttpOnly *bool                `json:"http_only,omitempty"`
+	Name     *string              `json:"name,omitempty"`
+	SameSite *CookieFlagsSameSite `json:"same_site,omitempty"`
+	Secure   *bool                `json:"secure,omitempty"`
+}
+
+// CookieFlagsSameSite defines model for CookieFlags.SameSite.
+type CookieFlagsSameSite string
+
+// CredentialField defines model for CredentialField.
+type CredentialField struct {
+	// Autocomplete Value of the autocomplete attribute
+	Autocomplete *string `json:"autocomplete,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Type         *string `json:"type,omitempty"`
+}
+
+// CursorPagination defines model for CursorPagination.
+type CursorPagination struct {
+	HasNext bool `json:"has_next"`
+
+	// Limit Maximum number of items in the page
+	Limit int `json:"limit"`
+
+	// NextCursor Cursor of the following page, omitted on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// TotalCount Number of items matching the filters across all pages
+	TotalCount int `json:"total_count"`
+}
+
 // DependencyCheck defines model for DependencyCheck.
 type DependencyCheck struct {
 	// Error Error message if the dependency is unhealthy
@@ -513,8 +2475,94 @@ type ErrorResponse struct {
 	Timestamp  *time.Time `json:"timestamp,omitempty"`
 }
 
+// Finding defines model for Finding.
+type Finding struct {
+	// Code Machine readable identifier of the finding
+	Code string `json:"code"`
+
+	// Message Human readable description of the finding
+	Message string `json:"message"`
+
+	// Selector CSS selector path to the offending element
+	Selector *string `json:"selector,omitempty"`
+
+	// Severity How serious the finding is
+	Severity FindingSeverity `json:"severity"`
+
+	// Wcag WCAG success criterion the finding relates to
+	Wcag *string `json:"wcag,omitempty"`
+}
+
+// FindingSeverity How serious the finding is
+type FindingSeverity string
+
 // FormAnalysis defines model for FormAnalysis.
 type FormAnalysis struct {
+	// Classifications Purpose of every form in document order, followed by the groups of fields that are not wrapped in a form element and could be classified
+	Classifications *[]struct {
+		Confidence *int `json:"confidence,omitempty"`
+
+		// Index Zero based position of the form in document order; standalone field groups are counted separately
+		Index *int                             `json:"index,omitempty"`
+		Kind  *FormAnalysisClassificationsKind `json:"kind,omitempty"`
+
+		// Signals Evidence the classification is based on, such as password_field or button_text
+		Signals *[]string `json:"signals,omitempty"`
+
+		// Standalone The fields are not wrapped in a form element and are submitted by JavaScript
+		Standalone *bool `json:"standalone,omitempty"`
+	} `json:"classifications,omitempty"`
+
+	// FederatedProviders Identity providers the page offers federated sign in with
+	FederatedProviders *[]string `json:"federated_providers,omitempty"`
+
+	// Forms Structure of every form on the page, in document order
+	Forms *[]struct {
+		// Action URL the form submits to, resolved against the page URL
+		Action  *string                   `json:"action,omitempty"`
+		Enctype *FormAnalysisFormsEnctype `json:"enctype,omitempty"`
+
+		// Fields Inputs, selects and textareas of the form, without its buttons
+		Fields *[]struct {
+			Autocomplete *string `json:"autocomplete,omitempty"`
+
+			// Label Text the field is announced with, taken from aria-labelledby, aria-label, an associated label or the title attribute
+			Label     *string `json:"label,omitempty"`
+			MaxLength *int    `json:"max_length,omitempty"`
+			MinLength *int    `json:"min_length,omitempty"`
+			Name      *string `json:"name,omitempty"`
+			Pattern   *string `json:"pattern,omitempty"`
+			Required  *bool   `json:"required,omitempty"`
+
+			// Type Input type, or select and textarea for those elements
+			Type *string `json:"type,omitempty"`
+		} `json:"fields,omitempty"`
+
+		// Findings Fields without a label, file uploads without an accept restriction and forms the browser does not validate
+		Findings *[]struct {
+			// Code Machine readable identifier of the finding
+			Code string `json:"code"`
+
+			// Message Human readable description of the finding
+			Message string `json:"message"`
+
+			// Selector CSS selector path to the offending element
+			Selector *string `json:"selector,omitempty"`
+
+			// Severity How serious the finding is
+			Severity FormAnalysisFormsFindingsSeverity `json:"severity"`
+
+			// Wcag WCAG success criterion the finding relates to
+			Wcag *string `json:"wcag,omitempty"`
+		} `json:"findings,omitempty"`
+
+		// Index Zero based position of the form in document order
+		Index  *int                     `json:"index,omitempty"`
+		Method *FormAnalysisFormsMethod `json:"method,omitempty"`
+
+		// Novalidate The form disables browser validation
+		Novalidate *bool `json:"novalidate,omitempty"`
+	} `json:"forms,omitempty"`
 	LoginFormDetails *[]struct {
 		// Action Form action URL
 		Action *string `json:"action,omitempty"`
@@ -526,16 +2574,273 @@ type FormAnalysis struct {
 		Method *FormAnalysisLoginFormDetailsMethod `json:"method,omitempty"`
 	} `json:"login_form_details,omitempty"`
 
-	// LoginFormsDetected Number of login forms detected
+	// LoginFormsDetected Number of forms classified as login forms with at least 50 percent confidence
 	LoginFormsDetected *int `json:"login_forms_detected,omitempty"`
 
+	// Security Security assessment of every form on the page, in document order
+	Security *[]struct {
+		// Action URL the form submits to, resolved against the page URL
+		Action           *string `json:"action,omitempty"`
+		CredentialFields *[]struct {
+			// Autocomplete Value of the autocomplete attribute
+			Autocomplete *string `json:"autocomplete,omitempty"`
+			Name         *string `json:"name,omitempty"`
+			Type         *string `json:"type,omitempty"`
+		} `json:"credential_fields,omitempty"`
+		Findings *[]struct {
+			// Code Machine readable identifier of the finding
+			Code string `json:"code"`
+
+			// Message Human readable description of the finding
+			Message string `json:"message"`
+
+			// Selector CSS selector path to the offending element
+			Selector *string `json:"selector,omitempty"`
+
+			// Severity How serious the finding is
+			Severity FormAnalysisSecurityFindingsSeverity `json:"severity"`
+
+			// Wcag WCAG success criterion the finding relates to
+			Wcag *string `json:"wcag,omitempty"`
+		} `json:"findings,omitempty"`
+
+		// HasCsrfToken Form contains a hidden input whose name looks like an anti-forgery token
+		HasCsrfToken     *bool `json:"has_csrf_token,omitempty"`
+		HasPasswordField *bool `json:"has_password_field,omitempty"`
+
+		// Index Zero based position of the form in document order
+		Index *int `json:"index,omitempty"`
+
+		// InsecureAction Form submits over plain HTTP
+		InsecureAction *bool                       `json:"insecure_action,omitempty"`
+		Method         *FormAnalysisSecurityMethod `json:"method,omitempty"`
+	} `json:"security,omitempty"`
+
 	// TotalCount Total number of forms found
 	TotalCount *int `json:"total_count,omitempty"`
 }
 
+// FormAnalysisClassificationsKind defines model for FormAnalysis.Classifications.Kind.
+type FormAnalysisClassificationsKind string
+
+// FormAnalysisFormsEnctype defines model for FormAnalysis.Forms.Enctype.
+type FormAnalysisFormsEnctype string
+
+// FormAnalysisFormsFindingsSeverity How serious the finding is
+type FormAnalysisFormsFindingsSeverity string
+
+// FormAnalysisFormsMethod defines model for FormAnalysis.Forms.Method.
+type FormAnalysisFormsMethod string
+
 // FormAnalysisLoginFormDetailsMethod Form submission method
 type FormAnalysisLoginFormDetailsMethod string
 
+// FormAnalysisSecurityFindingsSeverity How serious the finding is
+type FormAnalysisSecurityFindingsSeverity string
+
+// FormAnalysisSecurityMethod defines model for FormAnalysis.Security.Method.
+type FormAnalysisSecurityMethod string
+
+// FormClassification defines model for FormClassification.
+type FormClassification struct {
+	Confidence *int `json:"confidence,omitempty"`
+
+	// Index Zero based position of the form in document order; standalone field groups are counted separately
+	Index *int                    `json:"index,omitempty"`
+	Kind  *FormClassificationKind `json:"kind,omitempty"`
+
+	// Signals Evidence the classification is based on, such as password_field or button_text
+	Signals *[]string `json:"signals,omitempty"`
+
+	// Standalone The fields are not wrapped in a form element and are submitted by JavaScript
+	Standalone *bool `json:"standalone,omitempty"`
+}
+
+// FormClassificationKind defines model for FormClassification.Kind.
+type FormClassificationKind string
+
+// FormDetail defines model for FormDetail.
+type FormDetail struct {
+	// Action URL the form submits to, resolved against the page URL
+	Action  *string            `json:"action,omitempty"`
+	Enctype *FormDetailEnctype `json:"enctype,omitempty"`
+
+	// Fields Inputs, selects and textareas of the form, without its buttons
+	Fields *[]struct {
+		Autocomplete *string `json:"autocomplete,omitempty"`
+
+		// Label Text the field is announced with, taken from aria-labelledby, aria-label, an associated label or the title attribute
+		Label     *string `json:"label,omitempty"`
+		MaxLength *int    `json:"max_length,omitempty"`
+		MinLength *int    `json:"min_length,omitempty"`
+		Name      *string `json:"name,omitempty"`
+		Pattern   *string `json:"pattern,omitempty"`
+		Required  *bool   `json:"required,omitempty"`
+
+		// Type Input type, or select and textarea for those elements
+		Type *string `json:"type,omitempty"`
+	} `json:"fields,omitempty"`
+
+	// Findings Fields without a label, file uploads without an accept restriction and forms the browser does not validate
+	Findings *[]struct {
+		// Code Machine readable identifier of the finding
+		Code string `json:"code"`
+
+		// Message Human readable description of the finding
+		Message string `json:"message"`
+
+		// Selector CSS selector path to the offending element
+		Selector *string `json:"selector,omitempty"`
+
+		// Severity How serious the finding is
+		Severity FormDetailFindingsSeverity `json:"severity"`
+
+		// Wcag WCAG success criterion the finding relates to
+		Wcag *string `json:"wcag,omitempty"`
+	} `json:"findings,omitempty"`
+
+	// Index Zero based position of the form in document order
+	Index  *int              `json:"index,omitempty"`
+	Method *FormDetailMethod `json:"method,omitempty"`
+
+	// Novalidate The form disables browser validation
+	Novalidate *bool `json:"novalidate,omitempty"`
+}
+
+// FormDetailEnctype defines model for FormDetail.Enctype.
+type FormDetailEnctype string
+
+// FormDetailFindingsSeverity How serious the finding is
+type FormDetailFindingsSeverity string
+
+// FormDetailMethod defines model for FormDetail.Method.
+type FormDetailMethod string
+
+// FormField defines model for FormField.
+type FormField struct {
+	Autocomplete *string `json:"autocomplete,omitempty"`
+
+	// Label Text the field is announced with, taken from aria-labelledby, aria-label, an associated label or the title attribute
+	Label     *string `json:"label,omitempty"`
+	MaxLength *int    `json:"max_length,omitempty"`
+	MinLength *int    `json:"min_length,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	Pattern   *string `json:"pattern,omitempty"`
+	Required  *bool   `json:"required,omitempty"`
+
+	// Type Input type, or select and textarea for those elements
+	Type *string `json:"type,omitempty"`
+}
+
+// FormSecurity defines model for FormSecurity.
+type FormSecurity struct {
+	// Action URL the form submits to, resolved against the page URL
+	Action           *string `json:"action,omitempty"`
+	CredentialFields *[]struct {
+		// Autocomplete Value of the autocomplete attribute
+		Autocomplete *string `json:"autocomplete,omitempty"`
+		Name         *string `json:"name,omitempty"`
+		Type         *string `json:"type,omitempty"`
+	} `json:"credential_fields,omitempty"`
+	Findings *[]struct {
+		// Code Machine readable identifier of the finding
+		Code string `json:"code"`
+
+		// Message Human readable description of the finding
+		Message string `json:"message"`
+
+		// Selector CSS selector path to the offending element
+		Selector *string `json:"selector,omitempty"`
+
+		// Severity How serious the finding is
+		Severity FormSecurityFindingsSeverity `json:"severity"`
+
+		// Wcag WCAG success criterion the finding relates to
+		Wcag *string `json:"wcag,omitempty"`
+	} `json:"findings,omitempty"`
+
+	// HasCsrfToken Form contains a hidden input whose name looks like an anti-forgery token
+	HasCsrfToken     *bool `json:"has_csrf_token,omitempty"`
+	HasPasswordField *bool `json:"has_password_field,omitempty"`
+
+	// Index Zero based position of the form in document order
+	Index *int `json:"index,omitempty"`
+
+	// InsecureAction Form submits over plain HTTP
+	InsecureAction *bool               `json:"insecure_action,omitempty"`
+	Method         *FormSecurityMethod `json:"method,omitempty"`
+}
+
+// FormSecurityFindingsSeverity How serious the finding is
+type FormSecurityFindingsSeverity string
+
+// FormSecurityMethod defines model for FormSecurity.Method.
+type FormSecurityMethod string
+
+// Grade Letter grade, A being best and F meaning missing or ineffective
+type Grade string
+
+// HSTSPolicy The Strict-Transport-Security policy browsers apply, taken from the first header
+type HSTSPolicy struct {
+	IncludeSubdomains *bool  `json:"include_subdomains,omitempty"`
+	MaxAge            *int64 `json:"max_age,omitempty"`
+	Preload           *bool  `json:"preload,omitempty"`
+}
+
+// HeadingNode defines model for HeadingNode.
+type HeadingNode struct {
+	// Children Lower level headings nested under this heading
+	Children *[]map[string]interface{} `json:"children,omitempty"`
+
+	// Level Heading level (1 for h1 through 6 for h6)
+	Level int `json:"level"`
+
+	// Position Zero based index of the heading in document order
+	Position int `json:"position"`
+
+	// Text Heading text with whitespace collapsed
+	Text string `json:"text"`
+}
+
+// HeadingOutline defines model for HeadingOutline.
+type HeadingOutline struct {
+	// Headings Top level headings of the document outline
+	Headings *[]struct {
+		// Children Lower level headings nested under this heading
+		Children *[]map[string]interface{} `json:"children,omitempty"`
+
+		// Level Heading level (1 for h1 through 6 for h6)
+		Level int `json:"level"`
+
+		// Position Zero based index of the heading in document order
+		Position int `json:"position"`
+
+		// Text Heading text with whitespace collapsed
+		Text string `json:"text"`
+	} `json:"headings,omitempty"`
+
+	// Issues Outline problems such as multiple h1s, level jumps, empty or aria-hidden headings
+	Issues *[]struct {
+		// Code Machine readable identifier of the finding
+		Code string `json:"code"`
+
+		// Message Human readable description of the finding
+		Message string `json:"message"`
+
+		// Selector CSS selector path to the offending element
+		Selector *string `json:"selector,omitempty"`
+
+		// Severity How serious the finding is
+		Severity HeadingOutlineIssuesSeverity `json:"severity"`
+
+		// Wcag WCAG success criterion the finding relates to
+		Wcag *string `json:"wcag,omitempty"`
+	} `json:"issues,omitempty"`
+}
+
+// HeadingOutlineIssuesSeverity How serious the finding is
+type HeadingOutlineIssuesSeverity string
+
 // HealthResponse defines model for HealthResponse.
 type HealthResponse struct {
 	// Checks Status of individual dependencies
@@ -669,65 +2974,418 @@ type HealthResponse_Checks struct {
 // HealthResponseStatus Overall health status - OK only if all dependencies are healthy, DEGRADED if some non-critical dependencies are unhealthy
 type HealthResponseStatus string
 
+// HreflangLink defines model for HreflangLink.
+type HreflangLink struct {
+	// Lang Language (and optional region) code of the alternate
+	Lang *string `json:"lang,omitempty"`
+
+	// Url Resolved URL of the alternate
+	Url *string `json:"url,omitempty"`
+}
+
 // InaccessibleLink defines model for InaccessibleLink.
 type InaccessibleLink struct {
+	// Cached Whether the result was taken from a recent check shared between analyses
+	Cached *bool `json:"cached,omitempty"`
+
 	// Error Error description
 	Error *string `json:"error,omitempty"`
 
+	// Scope Whether the link was checked as an internal or an external link
+	Scope *InaccessibleLinkScope `json:"scope,omitempty"`
+
 	// StatusCode HTTP status code received
 	StatusCode *int    `json:"status_code,omitempty"`
 	Url        *string `json:"url,omitempty"`
 }
 
+// InaccessibleLinkScope Whether the link was checked as an internal or an external link
+type InaccessibleLinkScope string
+
+// Link defines model for Link.
+type Link struct {
+	// Cached Whether the status was taken from a recent check shared between analyses, only set for checked links
+	Cached *bool `json:"cached,omitempty"`
+
+	// Error Why the link is inaccessible, only set for inaccessible links
+	Error     *string `json:"error,omitempty"`
+	Redirects *struct {
+		// FinalUrl URL the check ended on
+		FinalUrl string `json:"final_url"`
+
+		// HopCount Number of redirects followed
+		HopCount int `json:"hop_count"`
+
+		// Hops Redirects followed from the link, in order
+		Hops []struct {
+			// Location Resolved Location the URL redirected to
+			Location string `json:"location"`
+
+			// StatusCode Redirect status the URL answered with
+			StatusCode int `json:"status_code"`
+
+			// Url URL requested
+			Url string `json:"url"`
+		} `json:"hops"`
+	} `json:"redirects,omitempty"`
+
+	// Region Page region the first occurrence of the link sits in
+	Region LinkRegion `json:"region"`
+
+	// Rel Lower cased rel tokens
+	Rel []string `json:"rel"`
+
+	// StatusCode HTTP status code the link checker received, only set for checked links
+	StatusCode *int `json:"status_code,omitempty"`
+
+	// Target Browsing context the link opens in
+	Target *string `json:"target,omitempty"`
+
+	// Text Text the link is announced with, falling back to its aria-label, image alt text and title
+	Text string   `json:"text"`
+	Type LinkType `json:"type"`
+	Url  string   `json:"url"`
+}
+
+// LinkRegion Page region the first occurrence of the link sits in
+type LinkRegion string
+
+// LinkType defines model for Link.Type.
+type LinkType string
+
 // LinkAnalysis defines model for LinkAnalysis.
 type LinkAnalysis struct {
 	// ExternalCount Number of external links
 	ExternalCount     *int `json:"external_count,omitempty"`
 	InaccessibleLinks *[]struct {
+		// Cached Whether the result was taken from a recent check shared between analyses
+		Cached *bool `json:"cached,omitempty"`
+
 		// Error Error description
 		Error *string `json:"error,omitempty"`
 
+		// Scope Whether the link was checked as an internal or an external link
+		Scope *LinkAnalysisInaccessibleLinksScope `json:"scope,omitempty"`
+
 		// StatusCode HTTP status code received
 		StatusCode *int    `json:"status_code,omitempty"`
 		Url        *string `json:"url,omitempty"`
 	} `json:"inaccessible_links,omitempty"`
 
-	// InternalCount Number of internal links
-	InternalCount *int `json:"internal_count,omitempty"`
+	// InternalCount Number of internal links
+	InternalCount *int `json:"internal_count,omitempty"`
+
+	// LinkIssues Links that can be reached but redirect badly, serve a not found page with a success status or point at a fragment the target document does not have
+	LinkIssues *[]struct {
+		// Code Machine readable identifier of the issue
+		Code LinkAnalysisLinkIssuesCode `json:"code"`
+
+		// Message Human readable description of the issue
+		Message   string `json:"message"`
+		Redirects *struct {
+			// FinalUrl URL the check ended on
+			FinalUrl string `json:"final_url"`
+
+			// HopCount Number of redirects followed
+			HopCount int `json:"hop_count"`
+
+			// Hops Redirects followed from the link, in order
+			Hops []struct {
+				// Location Resolved Location the URL redirected to
+				Location string `json:"location"`
+
+				// StatusCode Redirect status the URL answered with
+				StatusCode int `json:"status_code"`
+
+				// Url URL requested
+				Url string `json:"url"`
+			} `json:"hops"`
+		} `json:"redirects,omitempty"`
+
+		// Scope Whether the link was checked as an internal or an external link
+		Scope LinkAnalysisLinkIssuesScope `json:"scope"`
+
+		// Severity How serious the issue is
+		Severity LinkAnalysisLinkIssuesSeverity `json:"severity"`
+
+		// Text Text the link is announced with
+		Text *string `json:"text,omitempty"`
+		Url  string  `json:"url"`
+	} `json:"link_issues,omitempty"`
+
+	// RegionCounts Number of links per page region (header, navigation, content, sidebar or footer), decided by landmark elements, ARIA roles and common class and id names
+	RegionCounts *map[string]int `json:"region_counts,omitempty"`
+
+	// TotalCount Total number of links
+	TotalCount *int `json:"total_count,omitempty"`
+}
+
+// LinkAnalysisInaccessibleLinksScope Whether the link was checked as an internal or an external link
+type LinkAnalysisInaccessibleLinksScope string
+
+// LinkAnalysisLinkIssuesCode Machine readable identifier of the issue
+type LinkAnalysisLinkIssuesCode string
+
+// LinkAnalysisLinkIssuesScope Whether the link was checked as an internal or an external link
+type LinkAnalysisLinkIssuesScope string
+
+// LinkAnalysisLinkIssuesSeverity How serious the issue is
+type LinkAnalysisLinkIssuesSeverity string
+
+// LinkIssue defines model for LinkIssue.
+type LinkIssue struct {
+	// Code Machine readable identifier of the issue
+	Code LinkIssueCode `json:"code"`
+
+	// Message Human readable description of the issue
+	Message   string `json:"message"`
+	Redirects *struct {
+		// FinalUrl URL the check ended on
+		FinalUrl string `json:"final_url"`
+
+		// HopCount Number of redirects followed
+		HopCount int `json:"hop_count"`
+
+		// Hops Redirects followed from the link, in order
+		Hops []struct {
+			// Location Resolved Location the URL redirected to
+			Location string `json:"location"`
+
+			// StatusCode Redirect status the URL answered with
+			StatusCode int `json:"status_code"`
+
+			// Url URL requested
+			Url string `json:"url"`
+		} `json:"hops"`
+	} `json:"redirects,omitempty"`
+
+	// Scope Whether the link was checked as an internal or an external link
+	Scope LinkIssueScope `json:"scope"`
+
+	// Severity How serious the issue is
+	Severity LinkIssueSeverity `json:"severity"`
+
+	// Text Text the link is announced with
+	Text *string `json:"text,omitempty"`
+	Url  string  `json:"url"`
+}
+
+// LinkIssueCode Machine readable identifier of the issue
+type LinkIssueCode string
+
+// LinkIssueScope Whether the link was checked as an internal or an external link
+type LinkIssueScope string
+
+// LinkIssueSeverity How serious the issue is
+type LinkIssueSeverity string
+
+// LinkPage defines model for LinkPage.
+type LinkPage struct {
+	Links []struct {
+		// Cached Whether the status was taken from a recent check shared between analyses, only set for checked links
+		Cached *bool `json:"cached,omitempty"`
+
+		// Error Why the link is inaccessible, only set for inaccessible links
+		Error     *string `json:"error,omitempty"`
+		Redirects *struct {
+			// FinalUrl URL the check ended on
+			FinalUrl string `json:"final_url"`
+
+			// HopCount Number of redirects followed
+			HopCount int `json:"hop_count"`
+
+			// Hops Redirects followed from the link, in order
+			Hops []struct {
+				// Location Resolved Location the URL redirected to
+				Location string `json:"location"`
+
+				// StatusCode Redirect status the URL answered with
+				StatusCode int `json:"status_code"`
+
+				// Url URL requested
+				Url string `json:"url"`
+			} `json:"hops"`
+		} `json:"redirects,omitempty"`
+
+		// Region Page region the first occurrence of the link sits in
+		Region LinkPageLinksRegion `json:"region"`
+
+		// Rel Lower cased rel tokens
+		Rel []string `json:"rel"`
+
+		// StatusCode HTTP status code the link checker received, only set for checked links
+		StatusCode *int `json:"status_code,omitempty"`
+
+		// Target Browsing context the link opens in
+		Target *string `json:"target,omitempty"`
+
+		// Text Text the link is announced with, falling back to its aria-label, image alt text and title
+		Text string            `json:"text"`
+		Type LinkPageLinksType `json:"type"`
+		Url  string            `json:"url"`
+	} `json:"links"`
+	Pagination struct {
+		HasNext bool `json:"has_next"`
+
+		// Limit Maximum number of items in the page
+		Limit int `json:"limit"`
+
+		// NextCursor Cursor of the following page, omitted on the last page
+		NextCursor *string `json:"next_cursor,omitempty"`
+
+		// TotalCount Number of items matching the filters across all pages
+		TotalCount int `json:"total_count"`
+	} `json:"pagination"`
+}
+
+// LinkPageLinksRegion Page region the first occurrence of the link sits in
+type LinkPageLinksRegion string
+
+// LinkPageLinksType defines model for LinkPage.Links.Type.
+type LinkPageLinksType string
+
+// LivenessResponse defines model for LivenessResponse.
+type LivenessResponse struct {
+	// Status Service liveness status - OK if service is running, DEGRADED if running with reduced capacity
+	Status LivenessResponseStatus `json:"status"`
+
+	// Timestamp ISO 8601 timestamp when the check was performed
+	Timestamp time.Time `json:"timestamp"`
+
+	// Version API version
+	Version string `json:"version"`
+}
+
+// LivenessResponseStatus Service liveness status - OK if service is running, DEGRADED if running with reduced capacity
+type LivenessResponseStatus string
+
+// LoginForm defines model for LoginForm.
+type LoginForm struct {
+	// Action Form action URL
+	Action *string `json:"action,omitempty"`
+
+	// Fields Form field names
+	Fields *[]string `json:"fields,omitempty"`
+
+	// Method Form submission method
+	Method *LoginFormMethod `json:"method,omitempty"`
+}
+
+// LoginFormMethod Form submission method
+type LoginFormMethod string
+
+// MetaAnalysis defines model for MetaAnalysis.
+type MetaAnalysis struct {
+	// CanonicalUrl Resolved URL of the first canonical link
+	CanonicalUrl *string `json:"canonical_url,omitempty"`
+
+	// Charset Declared character encoding of the document
+	Charset *string `json:"charset,omitempty"`
+
+	// Description Content of the meta description tag
+	Description *string `json:"description,omitempty"`
+
+	// Hreflang Alternate language versions of the page
+	Hreflang *[]struct {
+		// Lang Language (and optional region) code of the alternate
+		Lang *string `json:"lang,omitempty"`
+
+		// Url Resolved URL of the alternate
+		Url *string `json:"url,omitempty"`
+	} `json:"hreflang,omitempty"`
+
+	// Issues SEO problems detected in the document head
+	Issues *[]struct {
+		// Code Machine readable identifier of the finding
+		Code string `json:"code"`
+
+		// Message Human readable description of the finding
+		Message string `json:"message"`
+
+		// Selector CSS selector path to the offending element
+		Selector *string `json:"selector,omitempty"`
+
+		// Severity How serious the finding is
+		Severity MetaAnalysisIssuesSeverity `json:"severity"`
+
+		// Wcag WCAG success criterion the finding relates to
+		Wcag *string `json:"wcag,omitempty"`
+	} `json:"issues,omitempty"`
+
+	// Keywords Entries of the meta keywords tag
+	Keywords *[]string `json:"keywords,omitempty"`
+
+	// OpenGraph Open Graph properties keyed by name without the `og:` prefix
+	OpenGraph *map[string]string `json:"open_graph,omitempty"`
+
+	// Robots Directives of the robots meta tag, lower-cased
+	Robots *[]string `json:"robots,omitempty"`
 
-	// TotalCount Total number of links
-	TotalCount *int `json:"total_count,omitempty"`
+	// TwitterCard Twitter Card properties keyed by name without the `twitter:` prefix
+	TwitterCard *map[string]string `json:"twitter_card,omitempty"`
+
+	// Viewport Content of the viewport meta tag
+	Viewport *string `json:"viewport,omitempty"`
 }
 
-// LivenessResponse defines model for LivenessResponse.
-type LivenessResponse struct {
-	// Status Service liveness status - OK if service is running, DEGRADED if running with reduced capacity
-	Status LivenessResponseStatus `json:"status"`
+// MetaAnalysisIssuesSeverity How serious the finding is
+type MetaAnalysisIssuesSeverity string
 
-	// Timestamp ISO 8601 timestamp when the check was performed
-	Timestamp time.Time `json:"timestamp"`
+// MixedContentItem defines model for MixedContentItem.
+type MixedContentItem struct {
+	// Element Name of the element referencing the URL
+	Element *string               `json:"element,omitempty"`
+	Kind    *MixedContentItemKind `json:"kind,omitempty"`
+	Url     *string               `json:"url,omitempty"`
+}
 
-	// Version API version
-	Version string `json:"version"`
+// MixedContentItemKind defines model for MixedContentItem.Kind.
+type MixedContentItemKind string
+
+// MixedContentReport Plain HTTP URLs loaded or submitted to by an HTTPS page; absent for pages served over HTTP
+type MixedContentReport struct {
+	// ActiveCount Scripts, stylesheets, iframes and plugins browsers block outright
+	ActiveCount *int `json:"active_count,omitempty"`
+
+	// FormCount Form actions and formaction overrides that submit over plain HTTP
+	FormCount *int `json:"form_count,omitempty"`
+	Items     *[]struct {
+		// Element Name of the element referencing the URL
+		Element *string                      `json:"element,omitempty"`
+		Kind    *MixedContentReportItemsKind `json:"kind,omitempty"`
+		Url     *string                      `json:"url,omitempty"`
+	} `json:"items,omitempty"`
+
+	// PassiveCount Images and media browsers may upgrade or block
+	PassiveCount *int `json:"passive_count,omitempty"`
 }
 
-// LivenessResponseStatus Service liveness status - OK if service is running, DEGRADED if running with reduced capacity
-type LivenessResponseStatus string
+// MixedContentReportItemsKind defines model for MixedContentReport.Items.Kind.
+type MixedContentReportItemsKind string
 
-// LoginForm defines model for LoginForm.
-type LoginForm struct {
-	// Action Form action URL
-	Action *string `json:"action,omitempty"`
+// PageEncoding Character encoding the page was decoded with before it was transcoded to UTF-8 and analysed
+type PageEncoding struct {
+	// Declared Encoding declared by the Content-Type header, or else by a meta tag of the page
+	Declared *string `json:"declared,omitempty"`
 
-	// Fields Form field names
-	Fields *[]string `json:"fields,omitempty"`
+	// Detected Canonical name of the encoding the page was decoded with
+	Detected string `json:"detected"`
 
-	// Method Form submission method
-	Method *LoginFormMethod `json:"method,omitempty"`
+	// HeaderCharset Charset label of the Content-Type header as it was sent
+	HeaderCharset *string `json:"header_charset,omitempty"`
+
+	// MetaCharset Charset label of the first meta charset or http-equiv Content-Type tag of the page
+	MetaCharset *string `json:"meta_charset,omitempty"`
+
+	// Mismatch Set when the Content-Type header, the byte order mark, the meta tag and the bytes of the page do not agree on the encoding
+	Mismatch bool `json:"mismatch"`
+
+	// Source Where the encoding was taken from, in order of precedence; `sniffed` is UTF-8 or an encoding guessed from the byte frequencies of an undeclared page, and `default` is windows-1252 for undeclared pages nothing else fits
+	Source PageEncodingSource `json:"source"`
 }
 
-// LoginFormMethod Form submission method
-type LoginFormMethod string
+// PageEncodingSource Where the encoding was taken from, in order of precedence; `sniffed` is UTF-8 or an encoding guessed from the byte frequencies of an undeclared page, and `default` is windows-1252 for undeclared pages nothing else fits
+type PageEncodingSource string
 
 // Pagination defines model for Pagination.
 type Pagination struct {
@@ -739,6 +3397,84 @@ type Pagination struct {
 	TotalPages  *int  `json:"total_pages,omitempty"`
 }
 
+// PerformanceHint defines model for PerformanceHint.
+type PerformanceHint struct {
+	// Code Machine readable hint identifier
+	Code *PerformanceHintCode `json:"code,omitempty"`
+
+	// Impact Estimated impact of addressing the hint
+	Impact *PerformanceHintImpact `json:"impact,omitempty"`
+
+	// Message Human readable description of the hint
+	Message *string `json:"message,omitempty"`
+
+	// Resources URLs or origins the hint applies to
+	Resources *[]string `json:"resources,omitempty"`
+}
+
+// PerformanceHintCode Machine readable hint identifier
+type PerformanceHintCode string
+
+// PerformanceHintImpact Estimated impact of addressing the hint
+type PerformanceHintImpact string
+
+// PerformanceReport Static performance estimate built from the markup and the response headers without fetching any resources
+type PerformanceReport struct {
+	// Cache Caching related response headers of the document
+	Cache *struct {
+		CacheControl *string `json:"cache_control,omitempty"`
+		Etag         *string `json:"etag,omitempty"`
+		Expires      *string `json:"expires,omitempty"`
+		LastModified *string `json:"last_modified,omitempty"`
+	} `json:"cache,omitempty"`
+
+	// Compressed Document was served with a compressing Content-Encoding
+	Compressed *bool `json:"compressed,omitempty"`
+
+	// ContentEncoding Content-Encoding the document was served with
+	ContentEncoding *string `json:"content_encoding,omitempty"`
+	Hints           *[]struct {
+		// Code Machine readable hint identifier
+		Code *PerformanceReportHintsCode `json:"code,omitempty"`
+
+		// Impact Estimated impact of addressing the hint
+		Impact *PerformanceReportHintsImpact `json:"impact,omitempty"`
+
+		// Message Human readable description of the hint
+		Message *string `json:"message,omitempty"`
+
+		// Resources URLs or origins the hint applies to
+		Resources *[]string `json:"resources,omitempty"`
+	} `json:"hints,omitempty"`
+
+	// HtmlBytes Size of the HTML document in bytes once its Content-Encoding was decoded, before it was transcoded to UTF-8
+	HtmlBytes *int `json:"html_bytes,omitempty"`
+
+	// ImagesWithoutDimensions Number of images missing a width or height attribute
+	ImagesWithoutDimensions *int `json:"images_without_dimensions,omitempty"`
+
+	// InlineScriptBytes Total size of inline script contents in bytes
+	InlineScriptBytes *int `json:"inline_script_bytes,omitempty"`
+
+	// InlineStyleBytes Total size of inline style contents in bytes
+	InlineStyleBytes *int `json:"inline_style_bytes,omitempty"`
+
+	// RenderBlockingScripts Scripts in the head loaded without async, defer or type="module"
+	RenderBlockingScripts *[]string `json:"render_blocking_scripts,omitempty"`
+
+	// RenderBlockingStylesheets Stylesheets in the head that apply to the initial render
+	RenderBlockingStylesheets *[]string `json:"render_blocking_stylesheets,omitempty"`
+
+	// TransferredBytes Size of the HTML document in bytes as it was sent, before its Content-Encoding was decoded
+	TransferredBytes *int `json:"transferred_bytes,omitempty"`
+}
+
+// PerformanceReportHintsCode Machine readable hint identifier
+type PerformanceReportHintsCode string
+
+// PerformanceReportHintsImpact Estimated impact of addressing the hint
+type PerformanceReportHintsImpact string
+
 // PoolStats defines model for PoolStats.
 type PoolStats struct {
 	// Hits Number of times free connection was found in the pool
@@ -840,6 +3576,394 @@ type ReadinessResponse_Checks struct {
 // ReadinessResponseStatus Overall readiness status - ready only if all dependencies are healthy, DEGRADED if some non-critical dependencies are unhealthy
 type ReadinessResponseStatus string
 
+// RedirectChain defines model for RedirectChain.
+type RedirectChain struct {
+	// FinalUrl URL the check ended on
+	FinalUrl string `json:"final_url"`
+
+	// HopCount Number of redirects followed
+	HopCount int `json:"hop_count"`
+
+	// Hops Redirects followed from the link, in order
+	Hops []struct {
+		// Location Resolved Location the URL redirected to
+		Location string `json:"location"`
+
+		// StatusCode Redirect status the URL answered with
+		StatusCode int `json:"status_code"`
+
+		// Url URL requested
+		Url string `json:"url"`
+	} `json:"hops"`
+}
+
+// RedirectHop defines model for RedirectHop.
+type RedirectHop struct {
+	// Location Resolved Location the URL redirected to
+	Location string `json:"location"`
+
+	// StatusCode Redirect status the URL answered with
+	StatusCode int `json:"status_code"`
+
+	// Url URL requested
+	Url string `json:"url"`
+}
+
+// Resource defines model for Resource.
+type Resource struct {
+	// Async Script is loaded with the async attribute
+	Async *bool `json:"async,omitempty"`
+
+	// Defer Script is loaded with the defer attribute
+	Defer *bool `json:"defer,omitempty"`
+
+	// HasIntegrity Element carries a Subresource Integrity hash
+	HasIntegrity *bool `json:"has_integrity,omitempty"`
+
+	// LazyLoaded Image is loaded with loading="lazy"
+	LazyLoaded *bool           `json:"lazy_loaded,omitempty"`
+	Origin     *ResourceOrigin `json:"origin,omitempty"`
+	Type       *ResourceType   `json:"type,omitempty"`
+
+	// Url Resource URL resolved against the page URL
+	Url *string `json:"url,omitempty"`
+}
+
+// ResourceOrigin defines model for Resource.Origin.
+type ResourceOrigin string
+
+// ResourceType defines model for Resource.Type.
+type ResourceType string
+
+// ResourceInventory defines model for ResourceInventory.
+type ResourceInventory struct {
+	// CountsByType Number of subresources per resource type
+	CountsByType *map[string]int `json:"counts_by_type,omitempty"`
+
+	// ExternalCount Number of subresources served from other hosts
+	ExternalCount *int `json:"external_count,omitempty"`
+
+	// InaccessibleResources Subresources that failed the accessibility check, only filled when check_resources is enabled
+	InaccessibleResources *[]struct {
+		// Cached Whether the result was taken from a recent check shared between analyses
+		Cached *bool `json:"cached,omitempty"`
+
+		// Error Error description
+		Error *string `json:"error,omitempty"`
+
+		// Scope Whether the link was checked as an internal or an external link
+		Scope *ResourceInventoryInaccessibleResourcesScope `json:"scope,omitempty"`
+
+		// StatusCode HTTP status code received
+		StatusCode *int    `json:"status_code,omitempty"`
+		Url        *string `json:"url,omitempty"`
+	} `json:"inaccessible_resources,omitempty"`
+
+	// InternalCount Number of subresources served from the analyzed host
+	InternalCount *int `json:"internal_count,omitempty"`
+	Resources     *[]struct {
+		// Async Script is loaded with the async attribute
+		Async *bool `json:"async,omitempty"`
+
+		// Defer Script is loaded with the defer attribute
+		Defer *bool `json:"defer,omitempty"`
+
+		// HasIntegrity Element carries a Subresource Integrity hash
+		HasIntegrity *bool `json:"has_integrity,omitempty"`
+
+		// LazyLoaded Image is loaded with loading="lazy"
+		LazyLoaded *bool                             `json:"lazy_loaded,omitempty"`
+		Origin     *ResourceInventoryResourcesOrigin `json:"origin,omitempty"`
+		Type       *ResourceInventoryResourcesType   `json:"type,omitempty"`
+
+		// Url Resource URL resolved against the page URL
+		Url *string `json:"url,omitempty"`
+	} `json:"resources,omitempty"`
+
+	// TotalCount Total number of distinct subresources
+	TotalCount *int `json:"total_count,omitempty"`
+}
+
+// ResourceInventoryInaccessibleResourcesScope Whether the link was checked as an internal or an external link
+type ResourceInventoryInaccessibleResourcesScope string
+
+// ResourceInventoryResourcesOrigin defines model for ResourceInventory.Resources.Origin.
+type ResourceInventoryResourcesOrigin string
+
+// ResourceInventoryResourcesType defines model for ResourceInventory.Resources.Type.
+type ResourceInventoryResourcesType string
+
+// RobotsVerdict What the robots.txt of the analysed origin says about fetching the page as our user agent
+type RobotsVerdict struct {
+	// Allowed Whether the page was fetched
+	Allowed bool `json:"allowed"`
+
+	// CrawlDelay Seconds the origin asks crawlers to wait between requests
+	CrawlDelay *float64 `json:"crawl_delay,omitempty"`
+
+	// Overridden Set when an admin asked to fetch the page although robots.txt disallows it
+	Overridden *bool `json:"overridden,omitempty"`
+
+	// Rule The robots.txt directive that decided it
+	Rule *string `json:"rule,omitempty"`
+
+	// Sitemaps Sitemaps the robots.txt lists
+	Sitemaps []string `json:"sitemaps"`
+}
+
+// SecurityHeaderAudit Output of the security_headers analyzer
+type SecurityHeaderAudit struct {
+	Checks []struct {
+		Findings []struct {
+			// Code Machine readable identifier of the finding
+			Code string `json:"code"`
+
+			// Message Human readable description of the finding
+			Message string `json:"message"`
+
+			// Selector CSS selector path to the offending element
+			Selector *string `json:"selector,omitempty"`
+
+			// Severity How serious the finding is
+			Severity SecurityHeaderAuditChecksFindingsSeverity `json:"severity"`
+
+			// Wcag WCAG success criterion the finding relates to
+			Wcag *string `json:"wcag,omitempty"`
+		} `json:"findings"`
+
+		// Grade Letter grade, A being best and F meaning missing or ineffective
+		Grade SecurityHeaderAuditChecksGrade `json:"grade"`
+		Name  SecurityHeaderAuditChecksName  `json:"name"`
+
+		// Present At least one of the headers the check looks at was sent
+		Present bool `json:"present"`
+
+		// Values Every raw value of the checked headers, including repeated ones
+		Values *[]string `json:"values,omitempty"`
+	} `json:"checks"`
+
+	// ContentSecurityPolicies Every enforced and report-only policy, split into directives
+	ContentSecurityPolicies *[]struct {
+		Directives *map[string][]string `json:"directives,omitempty"`
+
+		// ReportOnly Policy came from Content-Security-Policy-Report-Only and is not enforced
+		ReportOnly *bool `json:"report_only,omitempty"`
+	} `json:"content_security_policies,omitempty"`
+	Cookies *[]struct {
+		HttpOnly *bool                               `json:"http_only,omitempty"`
+		Name     *string                             `json:"name,omitempty"`
+		SameSite *SecurityHeaderAuditCookiesSameSite `json:"same_site,omitempty"`
+		Secure   *bool                               `json:"secure,omitempty"`
+	} `json:"cookies,omitempty"`
+
+	// Grade Letter grade, A being best and F meaning missing or ineffective
+	Grade SecurityHeaderAuditGrade `json:"grade"`
+
+	// Hsts The Strict-Transport-Security policy browsers apply, taken from the first header
+	Hsts *struct {
+		IncludeSubdomains *bool  `json:"include_subdomains,omitempty"`
+		MaxAge            *int64 `json:"max_age,omitempty"`
+		Preload           *bool  `json:"preload,omitempty"`
+	} `json:"hsts,omitempty"`
+
+	// PermissionsPolicy Permissions-Policy features mapped to their allowlists
+	PermissionsPolicy *map[string][]string `json:"permissions_policy,omitempty"`
+}
+
+// SecurityHeaderAuditChecksFindingsSeverity How serious the finding is
+type SecurityHeaderAuditChecksFindingsSeverity string
+
+// SecurityHeaderAuditChecksGrade Letter grade, A being best and F meaning missing or ineffective
+type SecurityHeaderAuditChecksGrade string
+
+// SecurityHeaderAuditChecksName defines model for SecurityHeaderAudit.Checks.Name.
+type SecurityHeaderAuditChecksName string
+
+// SecurityHeaderAuditCookiesSameSite defines model for SecurityHeaderAudit.Cookies.SameSite.
+type SecurityHeaderAuditCookiesSameSite string
+
+// SecurityHeaderAuditGrade Letter grade, A being best and F meaning missing or ineffective
+type SecurityHeaderAuditGrade string
+
+// SecurityHeaderCheck defines model for SecurityHeaderCheck.
+type SecurityHeaderCheck struct {
+	Findings []struct {
+		// Code Machine readable identifier of the finding
+		Code string `json:"code"`
+
+		// Message Human readable description of the finding
+		Message string `json:"message"`
+
+		// Selector CSS selector path to the offending element
+		Selector *string `json:"selector,omitempty"`
+
+		// Severity How serious the finding is
+		Severity SecurityHeaderCheckFindingsSeverity `json:"severity"`
+
+		// Wcag WCAG success criterion the finding relates to
+		Wcag *string `json:"wcag,omitempty"`
+	} `json:"findings"`
+
+	// Grade Letter grade, A being best and F meaning missing or ineffective
+	Grade SecurityHeaderCheckGrade `json:"grade"`
+	Name  SecurityHeaderCheckName  `json:"name"`
+
+	// Present At least one of the headers the check looks at was sent
+	Present bool `json:"present"`
+
+	// Values Every raw value of the checked headers, including repeated ones
+	Values *[]string `json:"values,omitempty"`
+}
+
+// SecurityHeaderCheckFindingsSeverity How serious the finding is
+type SecurityHeaderCheckFindingsSeverity string
+
+// SecurityHeaderCheckGrade Letter grade, A being best and F meaning missing or ineffective
+type SecurityHeaderCheckGrade string
+
+// SecurityHeaderCheckName defines model for SecurityHeaderCheck.Name.
+type SecurityHeaderCheckName string
+
+// StructuredDataItem defines model for StructuredDataItem.
+type StructuredDataItem struct {
+	// Errors Parse errors and missing required properties
+	Errors *[]string `json:"errors,omitempty"`
+
+	// Format Syntax the item was declared with
+	Format StructuredDataItemFormat `json:"format"`
+
+	// Properties Item properties; nested items are objects carrying their own `@type`
+	Properties map[string]interface{} `json:"properties"`
+
+	// Types Declared types, with the schema.org vocabulary prefix removed
+	Types []string `json:"types"`
+}
+
+// StructuredDataItemFormat Syntax the item was declared with
+type StructuredDataItemFormat string
+
+// TechStack Output of the tech_stack analyzer
+type TechStack struct {
+	// SignaturesVersion Release of the embedded signature database the page was matched against
+	SignaturesVersion *string `json:"signatures_version,omitempty"`
+	Technologies      *[]struct {
+		Category *TechStackTechnologiesCategory `json:"category,omitempty"`
+
+		// Confidence How certain the detection is, summed over the matching signatures
+		Confidence *int `json:"confidence,omitempty"`
+
+		// Evidence Inputs that matched, e.g. "header:server", "meta:generator", "cookie:_ga", "script", "html" or "implied:WordPress"
+		Evidence *[]string `json:"evidence,omitempty"`
+		Name     *string   `json:"name,omitempty"`
+
+		// Version Detected version, when one of the matching signatures exposes it
+		Version *string `json:"version,omitempty"`
+	} `json:"technologies,omitempty"`
+}
+
+// TechStackTechnologiesCategory defines model for TechStack.Technologies.Category.
+type TechStackTechnologiesCategory string
+
+// Technology defines model for Technology.
+type Technology struct {
+	Category *TechnologyCategory `json:"category,omitempty"`
+
+	// Confidence How certain the detection is, summed over the matching signatures
+	Confidence *int `json:"confidence,omitempty"`
+
+	// Evidence Inputs that matched, e.g. "header:server", "meta:generator", "cookie:_ga", "script", "html" or "implied:WordPress"
+	Evidence *[]string `json:"evidence,omitempty"`
+	Name     *string   `json:"name,omitempty"`
+
+	// Version Detected version, when one of the matching signatures exposes it
+	Version *string `json:"version,omitempty"`
+}
+
+// TechnologyCategory defines model for Technology.Category.
+type TechnologyCategory string
+
+// ThirdPartyDomain defines model for ThirdPartyDomain.
+type ThirdPartyDomain struct {
+	Categories *[]ThirdPartyDomainCategories `json:"categories,omitempty"`
+	Company    *string                       `json:"company,omitempty"`
+
+	// Domain Registrable domain according to the public suffix list
+	Domain    *string   `json:"domain,omitempty"`
+	Hosts     *[]string `json:"hosts,omitempty"`
+	Resources *[]struct {
+		Type *ThirdPartyDomainResourcesType `json:"type,omitempty"`
+		Url  *string                        `json:"url,omitempty"`
+	} `json:"resources,omitempty"`
+	Tracker *bool `json:"tracker,omitempty"`
+}
+
+// ThirdPartyDomainCategories defines model for ThirdPartyDomain.Categories.
+type ThirdPartyDomainCategories string
+
+// ThirdPartyDomainResourcesType defines model for ThirdPartyDomain.Resources.Type.
+type ThirdPartyDomainResourcesType string
+
+// ThirdPartyInventory Output of the third_parties analyzer
+type ThirdPartyInventory struct {
+	// ConsentManager Consent management platform loaded by the page, if any
+	ConsentManager *string `json:"consent_manager,omitempty"`
+
+	// Domains Third-party registrable domains (eTLD+1) the page loads resources from
+	Domains *[]struct {
+		Categories *[]ThirdPartyInventoryDomainsCategories `json:"categories,omitempty"`
+		Company    *string                                 `json:"company,omitempty"`
+
+		// Domain Registrable domain according to the public suffix list
+		Domain    *string   `json:"domain,omitempty"`
+		Hosts     *[]string `json:"hosts,omitempty"`
+		Resources *[]struct {
+			Type *ThirdPartyInventoryDomainsResourcesType `json:"type,omitempty"`
+			Url  *string                                  `json:"url,omitempty"`
+		} `json:"resources,omitempty"`
+		Tracker *bool `json:"tracker,omitempty"`
+	} `json:"domains,omitempty"`
+	Issues *[]struct {
+		// Code Machine readable identifier of the finding
+		Code string `json:"code"`
+
+		// Message Human readable description of the finding
+		Message string `json:"message"`
+
+		// Selector CSS selector path to the offending element
+		Selector *string `json:"selector,omitempty"`
+
+		// Severity How serious the finding is
+		Severity ThirdPartyInventoryIssuesSeverity `json:"severity"`
+
+		// Wcag WCAG success criterion the finding relates to
+		Wcag *string `json:"wcag,omitempty"`
+	} `json:"issues,omitempty"`
+
+	// TrackerCount Number of domains found on the tracker list
+	TrackerCount *int `json:"tracker_count,omitempty"`
+
+	// TrackerListVersion Release of the embedded tracker list the domains were classified against
+	TrackerListVersion *string `json:"tracker_list_version,omitempty"`
+}
+
+// ThirdPartyInventoryDomainsCategories defines model for ThirdPartyInventory.Domains.Categories.
+type ThirdPartyInventoryDomainsCategories string
+
+// ThirdPartyInventoryDomainsResourcesType defines model for ThirdPartyInventory.Domains.Resources.Type.
+type ThirdPartyInventoryDomainsResourcesType string
+
+// ThirdPartyInventoryIssuesSeverity How serious the finding is
+type ThirdPartyInventoryIssuesSeverity string
+
+// ThirdPartyResource defines model for ThirdPartyResource.
+type ThirdPartyResource struct {
+	Type *ThirdPartyResourceType `json:"type,omitempty"`
+	Url  *string                 `json:"url,omitempty"`
+}
+
+// ThirdPartyResourceType defines model for ThirdPartyResource.Type.
+type ThirdPartyResourceType string
+
 // HealthResponseV1DependencyCheck defines model for health-response.v1_DependencyCheck.
 type HealthResponseV1DependencyCheck struct {
 	// Details Additional dependency-specific information
@@ -982,18 +4106,74 @@ type GetAnalysisEventsParams struct {
 // GetAnalysisEventsParamsAPIVersion defines parameters for GetAnalysisEvents.
 type GetAnalysisEventsParamsAPIVersion string
 
+// GetAnalysisLinksParams defines parameters for GetAnalysisLinks.
+type GetAnalysisLinksParams struct {
+	// Cursor Opaque cursor returned as `next_cursor` by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of links to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Type Only return links of this type
+	Type *GetAnalysisLinksParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Region Only return links in this page region
+	Region *GetAnalysisLinksParamsRegion `form:"region,omitempty" json:"region,omitempty"`
+
+	// StatusCode Only return checked links that answered with this HTTP status code
+	StatusCode *int `form:"status_code,omitempty" json:"status_code,omitempty"`
+
+	// Host Only return links to this host, compared case-insensitively
+	Host *string `form:"host,omitempty" json:"host,omitempty"`
+
+	// APIVersion API version to use for this request. If not specified, defaults to v1.
+	// Supported versions: v1
+	APIVersion *GetAnalysisLinksParamsAPIVersion `json:"API-Version,omitempty"`
+}
+
+// GetAnalysisLinksParamsType defines parameters for GetAnalysisLinks.
+type GetAnalysisLinksParamsType string
+
+// GetAnalysisLinksParamsRegion defines parameters for GetAnalysisLinks.
+type GetAnalysisLinksParamsRegion string
+
+// GetAnalysisLinksParamsAPIVersion defines parameters for GetAnalysisLinks.
+type GetAnalysisLinksParamsAPIVersion string
+
 // AnalyzeURLJSONBody defines parameters for AnalyzeURL.
 type AnalyzeURLJSONBody struct {
 	Options *struct {
+		// Accessibility Whether to run the static accessibility audit
+		Accessibility *bool `json:"accessibility,omitempty"`
+
+		// Analyzers Pluggable analyzers to run by name, together with the analyzers they depend on.
+		// When omitted every registered analyzer runs; an empty list runs none. Unknown names
+		// are rejected with 400 Bad Request.
+		Analyzers *[]string `json:"analyzers,omitempty"`
+
 		// CheckLinks Whether to check link accessibility
 		CheckLinks *bool `json:"check_links,omitempty"`
 
+		// CheckResources Whether to check the accessibility of the scripts, stylesheets, images, fonts and media the page loads; same origin assets are paced by the internal rate limit
+		CheckResources *bool `json:"check_resources,omitempty"`
+
 		// DetectForms Whether to detect login forms
 		DetectForms *bool `json:"detect_forms,omitempty"`
 
+		// IgnoreRobotsTxt Fetch the page and check its links even where robots.txt disallows it. Only tokens
+		// granted the admin scope may set it; other tokens get a 403.
+		IgnoreRobotsTxt *bool `json:"ignore_robots_txt,omitempty"`
+
 		// IncludeHeadings Whether to include heading analysis
 		IncludeHeadings *bool `json:"include_headings,omitempty"`
 
+		// IncludeMeta Whether to include SEO meta tag analysis
+		IncludeMeta *bool `json:"include_meta,omitempty"`
+
+		// LinkScope Which links to check when check_links is set. Internal links are checked with a lower
+		// concurrency and a per-analysis rate limit so the analysed site is not overloaded.
+		LinkScope *AnalyzeURLJSONBodyOptionsLinkScope `json:"link_scope,omitempty"`
+
 		// Timeout Request timeout in seconds
 		Timeout *int `json:"timeout,omitempty"`
 	} `json:"options,omitempty"`
@@ -1012,6 +4192,9 @@ type AnalyzeURLParams struct {
 // AnalyzeURLParamsAPIVersion defines parameters for AnalyzeURL.
 type AnalyzeURLParamsAPIVersion string
 
+// AnalyzeURLJSONBodyOptionsLinkScope defines parameters for AnalyzeURL.
+type AnalyzeURLJSONBodyOptionsLinkScope string
+
 // AnalyzeURLJSONRequestBody defines body for AnalyzeURL for application/json ContentType.
 type AnalyzeURLJSONRequestBody AnalyzeURLJSONBody
 
@@ -1505,6 +4688,9 @@ type ServerInterface interface {
 	// Get real-time analysis progress
 	// (GET /v1/analysis/{analysisId}/events)
 	GetAnalysisEvents(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params GetAnalysisEventsParams)
+	// List the links of an analysis
+	// (GET /v1/analysis/{analysisId}/links)
+	GetAnalysisLinks(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params GetAnalysisLinksParams)
 	// Analyze a web page
 	// (POST /v1/analyze)
 	AnalyzeURL(w http.ResponseWriter, r *http.Request, params AnalyzeURLParams)
@@ -1535,6 +4721,12 @@ func (_ Unimplemented) GetAnalysisEvents(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List the links of an analysis
+// (GET /v1/analysis/{analysisId}/links)
+func (_ Unimplemented) GetAnalysisLinks(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params GetAnalysisLinksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Analyze a web page
 // (POST /v1/analyze)
 func (_ Unimplemented) AnalyzeURL(w http.ResponseWriter, r *http.Request, params AnalyzeURLParams) {
@@ -1693,6 +4885,109 @@ func (siw *ServerInterfaceWrapper) GetAnalysisEvents(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
+// GetAnalysisLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalysisLinks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "analysisId" -------------
+	var analysisId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalysisLinksParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", r.URL.Query(), &params.Region)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "region", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status_code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status_code", r.URL.Query(), &params.StatusCode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status_code", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "host" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "host", r.URL.Query(), &params.Host)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "host", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "API-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("API-Version")]; found {
+		var APIVersion GetAnalysisLinksParamsAPIVersion
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "API-Version", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "API-Version", valueList[0], &APIVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "API-Version", Err: err})
+			return
+		}
+
+		params.APIVersion = &APIVersion
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAnalysisLinks(w, r, analysisId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // AnalyzeURL operation middleware
 func (siw *ServerInterfaceWrapper) AnalyzeURL(w http.ResponseWriter, r *http.Request) {
 
@@ -1906,6 +5201,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/analysis/{analysisId}/events", wrapper.GetAnalysisEvents)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/analysis/{analysisId}/links", wrapper.GetAnalysisLinks)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/analyze", wrapper.AnalyzeURL)
 	})
@@ -1925,146 +5223,460 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PbOLLoX0FxP0yyV5Kplx86tR88k2ST2kziazt3zz3jlAYiWxYmJKgFQNmalP/7",
-	"KbxIkIRkSfE8kuGXGUfEo7vR3Wg0Gt2fgyhLlxkFKngw+RzAPU6XCai/aSamDHC8nnJgKxKB/JHnaYrZ",
-	"OpgEV/pHRDiimUCqZdAJVjjJVctoAdEnNVCEo4X6CRjLWDAJLiEmHMlRgaGcMsDRAs8SCDpBgrmYqq4Q",
-	"B5NgEA7G3bDf7Y+v++FkGE7C8H+CTsAFFjkPJkFOF4ATsVgHD53gPznklXl+BM7xLSD1AUUZpRAJklEk",
-	"SApZLr5wPi4yhm8rM77AAs8wr0w2xySB+IvmenB+fvH+3++CTiBR4AKny80jrYBxktFgEvR7YS/Uw+hV",
-	"m8bZHd24nuqjs5TF3D+ev3l3/fLd+bsfXu4LwqqEoUDsUcYqSbAPYzm0X2ZZguB+gXMuvnANtvDXjGWf",
-	"npSTPZz1w9Ny72EclS9lo2DSPw3D3sDHYQ+dYAE4BqYW6HxJ/p9u8lr9KH+LgUeMLIXud37xBplRUM4h",
-	"RvOMIbEgHDHgy4xySUoeLSDFiho0T4PJT8GqH3zsWG2luEsisF7Kv7lghN5qWJaY4RTEQeCITELkAvSf",
-	"HLjooTdzpfH4EiIyJxB3UAxznCeCyz6rfu+GXuXLZcYExHY0PkGr/o2UqhrQRE6rSRZ0AooleSUYXQNp",
-	"BX0zj+1bpYYHfUtDhf0Mx1ODgxKejAqg6k+8XCYkwpIGR7/wjNZ3AkJXOCHxNFNk4lVxfaM/IkxxsuaE",
-	"I9vKEdkYBCaJ5LVrzbsozblAM0AzEHcAFI0RpjEahiHiEGU0lt0t69en7wSpFrwts6Mly1YkVjKvGX0a",
-	"ZTEEk1EY7sDqknh22pwlfow/XL6V3JFi4cdVfrd4YqT7vL6+vkAZU/+/kiN48JQTujheL6BAR01qtlzV",
-	"+nD8UsI5obeKJwiDeDonkMRVVH/UbZBtg3Qb/9IuAH2Xs+Q73QgZkZHdHCQ3zOrie1mZTI5jOh2K64Mr",
-	"Q0uWLYEJolm7AL+hCeKYyD9xghToyLZsCFqBW32Il6qfAtXTqcC33u11nmLalfaU3EnM7La1ZyAGgq2n",
-	"eC58Cu1KS5NUTHeYSFacZwyQ6iMX9plUbwwLQAlJidCz8eflPIQKuAUWPNRo34BaMrZuUUPZGcFZq8+B",
-	"EZ1JEGMBXbWxNJVY8Us2+wUioRezOvP3OLa6GXWRK5wZQ84G8NBRJu08y2m8pwK02mVaGaAUk3OrfaRY",
-	"6u9eEXmXlYpKNUN3RCyQcAX8zQtHWjwTu5LinbcmIqMd1UHOgW3C7wMHtgNucoiNeEUMYqCC4MTV7bVZ",
-	"XeQakx6EWCv737LsXwLPchaBwyeSKljAVOG0p5zHmCRr3XMK9xGANCAqkvBCtrD0si288vCKASiJ4Agz",
-	"Q2KI5WL0w9CoAeBoCQzFeO2IhBcIVzA0DIUiaQBTYYrTY7VLVmVncLajUigpuYEelw77bCVH2XCC+qFV",
-	"2Br/lNBcgEMC37QViyjLUIrpuhimhy4SkOduwdYI32JCUYKFMqkr1Dg+lBStGvmW1UiDn1AX+TjbOFCA",
-	"TYv12usYJYBRnEzrY7hHC93EOsd0E69A+RlenU7NvjtLIJXyxQkXvIMkpDgSiOuzaeXg4QOsamignML9",
-	"EiKpwzQ/ZVGUM9Y8YY13PoFYZ1RO8QqTRDlOvK4gAekyY5hJvec23ngO4a4PKQZ2m0lOTbHElGIagUdh",
-	"EIowmsOdUUeuleID1CWP47LaDGqNSMNW7/zl9Y5f3JWLFOdikTHyK+x7VoH7pTpXi+wT1Fy8L/UnJMeW",
-	"prgeBemW25QMgzkDvkDrLGe6uTxbJdmtlBkpPI6sVOevKBHPtGiBOTJdmiZ+f09XjXvG8LpsNMjVo8hm",
-	"tJVnVSPtdFGeqkJtePw31eGbvipIMUn04ZTzu4w9AeKexS48YzsvdsXPpFeHcJTiRLI7xBLicqXqSO+4",
-	"3IQj0+NwpK0LyYO09VftzeEG78JP9z1gBpbXCVVb6rkRST1m4bOte7Z2p4TjHjuIFO3m8C1vDh+cPcBx",
-	"bEmiebk8KPlB33aYA+ILLDwMMgcRLaYSumnqYZNrkgLiS6ACqZZyujuYoSW+VZebQn1hWaokQ2B2C0L7",
-	"pilKSZIQx41vLzyGo0GnpA6h4ngkxYRQkuZpMAl9lJXNeRN6pcen8uPU4XQiwNcYRxqpOo6vMpYi/dF4",
-	"4hs8qDzQfENX7Z6mOFW2YjF5YxDzA2YMr7WEiEUWbxiU5zO1vBlFpl15z3Px/urad9PTYKbmnCXBuKSY",
-	"suObELzL0xkwlM3NRqnao6L9Y4slMoGTaZTl2lKpn7EEThAtZtBjF26/LQP78JPaVypbNZlnzRd9+d/t",
-	"4C4GO7QZ7tBmtEOb8Q5tjh9r46WESJNpcRFbp/oLs3bo9fWPb+1lZOUWUn4Y+3g/IfSTh7Jwbw6LG9a5",
-	"5CHbEumRHuMeQnEUAedklsC0mHyDTG/dmtzfPIjtpe8RgwjISnF/E2ZzN1gotZyR4CD5LI7gj1LVttyR",
-	"qnvJ5C5D+rBZskytnLR+dthRlOvyV7mlyM1DcabdUrZsH/3BeO/tQxCReBb5Qu5i+psrCi/1X+hFlmrL",
-	"foeFtLvsS8uQtb3HXuCQuMooOYl9vPnb222q9aZO0yez3hZCLKf7SZrPnHhG5siceGcJbLPf3PAQE3L1",
-	"ca8VfEMvWHbLgPMvX0blGaNiygUsm5j/oL+WLnzVzOXEQkKmViSb68UFSbGAeBplspcce6rDcxoLb5uq",
-	"0CFpM5ddfEMvHTrUBMd8QUtgEVChFz/F91oG+2H4mEQ2F4vQaTHhfit2aUOEHluvumFN/pMDIuqQPifq",
-	"zpIpxrPdgs4OS8xAUR979Oq/F0ArA6I7zJHp4Q6+5YjwlCtcMtYw5Ju3xM2casQ0m9fJZBfROM4Vdu6C",
-	"dgIDiMZ7k1wWO2ltz1iA0gIzUMcdJRRVAu684To8oyKovljCLVqGAXZbUrPNTReYLzyH2tfn3cH4GMmv",
-	"FVrLE6B1AbqrCcNZGI1Gg7PTedSP+qMzPJ/NR9Hp2dnxfHY2GA1OMIz6MDoenc3OhqMIj87GZ2f92cnp",
-	"eDA7HY+3gcjJrx5GuyK/wibQ5OY9WxvPeblrD0eebbupGKrytBs545xh/9muiIwomrh064+53+MgeYO3",
-	"h+X2sNweltvDcntYbg/L7WH5KQ/LTeO/tA63GIUHWnu/wmUZ7l6VCCeSvfpBXfmVAlUE3AuWQ6dp5YuF",
-	"uiQyN4WyG7JySRIi1iW0syxLAFNzxodITIvdetdJdD93t/EOT2iU5DFMjdbfawrTF5m+rqXfnMi+Q3HH",
-	"H4bNED0dmmtaS6Yt+bU4OQ4rJ8fxbpK09cwgMmugomcm5IUjPONZkgvVgncQgwQLsgK0xGLBO+pmt6oy",
-	"nld4fiHEkk+OjswvvShLm4eRFN+/BXorFuo8bPCyvwx97FvcA05+Ujh99OD6A44W8AKWQGOg0foHyXDK",
-	"XkuS9/Ng8tOWC0BcuI4unCY+TnCcTHExVdc8dIkQoRrVylZRgrh1mzGuIkT02aEcvv7Oq6R288kTUreB",
-	"aByGYeq14KsPojYcy+WpoJxensxlN2S77Xo8t49rNhzJrXNCn8i3qOrRoFfyu95eth3JXytK1U7kJT6O",
-	"BVzSNIZbhnUQpUvqnH6i2R31W8kuUxpYPHx5INtVOy2zLFG+Qp9tSgTftsOrq1c0Z1B5cykXVQeim7t7",
-	"OUVtf3zUVUXiBKbloFvBkG0dAPimeU8em1SeZ+BAjN+9v96OtTxTPuqe2x1p1biCNYM0W0FcHnbrEAwe",
-	"N0KUpO9AAaxv/q1qcMIRy0P0rgbfTuiqxrsscv9R1pKQP269Wjxryyw7V/EcjXea0PpCpj48tXkrHFdG",
-	"xtRUyg5wYSAUUUwzn9UpNXP4mBO4plyUhBeM73BAhUweFHzL55FaH1P7dlg92CdY88dtf9lK0kG/QK7o",
-	"ldHJvkeC5i8fHzqBZ6/f41R3wHa79e36H7rTfh1boab85juJNiTrKwvJ6igno/Ukt37V1q9aw0/rn80S",
-	"X2aq8NvC7ZmtPbP9ERtVTW8XUBEakxWJc5eViNIrNb62eVda50PLyK3zoXU+tM6H1vnQOh++IedDkeqs",
-	"3dfbff33N1Cd/HctA7YM+Hsz4PbQhSrg71fAcJKgRQWBLnr/L5TRZC05Q352z1MqH4yBt4NevPzn5fmL",
-	"ly9kS56lgGhGuxEjgkTY06/CVIYk7/8VdAI7jvxTJ3R0s3X6oisqLrLaw/Sr9+j0OOyjog26szHNOtZB",
-	"MtgSmH4avDN32QSSTd+hTqCgv1djBEqD6jgMvUy1MaDrvHwr7w3n0lkrd+QTl2Ad69vxaa83TmzWW0I/",
-	"fXsBWRKrzX7RNuitDXprcswKKPAtTzY2KVirGhIzQkXFSp1Z5l5hOaWE3lZ1qvlRZ6RjEOcRxCjCSxzp",
-	"+KyvT4luVndl9tpaotnD9Zwdz6fo3ma3hL7KWNped8g5LvAtocV7hJonCfMphXvhAOtE0smvSwYrkuXc",
-	"36LIZlcIXt8ny0tjNW9vVZP4XdSDHJgfEnF9kWXJVetda71rrXet9a79Ud61SxXOvNX82Peuto2++VYv",
-	"Ndt1/lOv8wbXdLtOX4sPt12pr97Zyex+Wh7GVf2n1uW5z2n9D3FOalJ2rZu/t+pPH400bm9d2luX30UR",
-	"cYhyRsT6KlpAqnnve8xJdJ4LT7oM9UlX1Knl+5TnGxzLgwoXTL/wAxovM0LV2UMlSVQOFjlCuR4LIZba",
-	"kcNBZHbSmUoF+squ48X51cvr90Gd3fXP6NlFgoVcc1RL+nllUEPXKvvny/togektqCeH75egjz78OVqN",
-	"dH7Q3g09R4oeYBKGak7SfkzCeQ5M5yrV48txgC4wjSBGlo5oDljkDHjvhmoEJjaz6WrUS7IIJ73PS7xO",
-	"Mhw/oIw5H5f5LCFR+bX3mZNbqkZ7uKEVIqo+m6j4f3Nga//6GZJp7JaYc4gR5ug/skdZuEUt5ssVUHGl",
-	"Sz04h73eDf1gi4ZdXb0sF1mqbgYoyrnIUpOqVW8mqnJXUZ1rtkYzlt1xYC6J/LTZhSiqmpdCoCzmZfPB",
-	"WpfkkvwL1jrhp1SCNr0zjpTDwHT6N8yQenhtXjIzdFWkbVdXCsVb1FsiFvmsF2XpEWbRggiQBxl2xFdR",
-	"9w5mXfMIljXPSOcqSYmTTxqJBbYPzoEXKUx0xmOTIZeb1wkQuyoc4VmWi8kN7VZyJ8h/l8/H1VfzsFin",
-	"opD0T2AFifxUJMNWrFy5GdKfy8uf8te3RYi4iRBXs97Qv/0NnV+8QaaIGqG38sdrqa7lzzkHjjikWMqn",
-	"BVa/d45R8Vg4zRNBlgm4DZQ+gVsCfKKn+ZudA13pT2sJ1t///uHyLbrAYuGA8Pe/T9DPR6v+0c/o2ZKR",
-	"FLO18Tc/1310Vbp6D6cY3ASt+j8bdkbPcKJoJNWbGeAHkxjger2E+jBu3vAVjXsub/RW/f/zC8/oz/qR",
-	"RrFJZ6ViqmP7plx8Ofe5sgpNtbniGbkLewG3PJBLOExGA7f6nxzJNC8tBa0otfTGWZSnQAUUflD9Nclu",
-	"Zd/vGeBPir1MH7PxoBT/IiXYTEVoxEAOYzjF6uYmj1RUVHWTmWiSuy24JPSXbQCo69HievANmr+GA9JM",
-	"xOXP/kXhAtMYM2d8ox8VRj//d9dwUVdyUfe9zpQwQTTjlMznP5tGr6R6Lr++ePnu/9tP/3111b1gmZHG",
-	"Cer/F0qzGP4xS7Lok250JRiJRPeaYcqlsHUt+BOU4vsuvoV/DPvj4XEYhv9lAb/KZzrXBNdjWDBt1+5F",
-	"lpBoPbHVGLucReg7Dsn8O93hEubAGLCiIddQZIzcEtqV1n43Yhnn5hfd6wKYuRziRccIp8DwP54976CU",
-	"RCxbLjIK6p+3kMmtQyL+j2fPf1abQkIiMD5Ho91/fHPd0OPZEqguZtTL2O2R6cSPZNsyOYdnYzi/eOMr",
-	"9toJ5Ih4SYJJMOyFvWHQCVSaAwmH1EI2t8PRZ/vXm/hBfrwF4TNBBSOwAq6kTmfKkhYjRvbiKlnrizQh",
-	"pdNJHFEokTdxMAn+CeK8/ObW5/zpWy7G2fGlqcgbiQg9CfYKCMfjEE5HYdiFwdmsO+rHoy4+6R93R6Pj",
-	"4/F4NArDMLQ4yIUuMSjXN3BtcX1qKxF6JOfdw8daQdFBGO5Zm8JJmudEj5Tp+3+w38vEiZrT3Nz9lUx9",
-	"u1Klmq6vmVC/P/6foJ6eL8Djsz4+jkfhbD4ahKNwhMN+/2Q4jOazk1n/LIyPB9HxeDYPZ1GMh4PZ+GQ2",
-	"ODmJz3B8Nu+PjiGoZ9PrD8bH1Ux3myrtlpntTLI6JzddLRtdkSlu46PEn8r7+OBINQjKa/afVA0+xSlS",
-	"Hk1Fio/llbm+Aper73+/169dKA+8GccWfdVyMVBXd4uhSvKyGKlrw8VYXSYtjoNJ2EjSVeTcKgJ+6vFF",
-	"p/7IoJ/K4snvMoFebawiWNXDqpgz7bkpXqT0bq3DXBkzrI9o2lWH/NiM6OmP65QcbkgXpbI72R2hlojJ",
-	"rezs5t6swlSB5aET6FPEBrH8JxGv8xlaZCmolIaOejpcKvuPSuV4MvJJ5clsOD+Nz2AQ9fF4fjw7hVF8",
-	"Ep3h4Www78M4HkWnszN8Mj9Wfw9nA9yfh3AWn0Yns2M8bgjleDAcnWyXynFTKkePSGX/VIr67mLJgZsd",
-	"phRMK6pPIZXDjVI50FJ5qqWyP9BiOdZiOdRi2T9ALCWPeuXSy/phDd7+yXgD849OT0rm16w5QW9BfMfR",
-	"LCeJiVCQ5/8dZaE8QW+vqdKmiG1TxLYpYttUBm2K2DZFbJsito2Wb1PEfr0pYpvl1gpzoJgP8VwJ0DxP",
-	"EsVUg3Cwp8OjrONRbLzlseq8WDabzPXw09QgqBcdacy9taBEMDYRcabwx+nYMZ3dgg6ScNaCmdpw8xKn",
-	"V9a4cQ2bLz8nVjGrzr8dr0ENscE2xNx/N5eKq+KVyKlt8YU+qU3rZdXGNrz6YRWv4814PeWRpq1r85vV",
-	"tdmikrggSVLhvYdOMApHh2gjudo0E1NtKfq5nGaisCQ9NVPfZeUS60cJ6mpKuPVj37xwSqN6Jq5WF/fM",
-	"2/TT7VQcNufANuH3gQPbATc5xEa8qsV9LYK1WV3kGpMehFhb6vVbLvV6Cfrmz+ETKeD9fe9X5hmbkTgG",
-	"OtWmf21vtl9Njnpk6/x/2ebcrLwPktUogdhOJDJza2iKRCFmEHZEqAF7oyRdcL5hNGVrKF1khgh89edG",
-	"4dDZIk2EcKV6eEnoZhlta1B/Oc0GDZrZWzUUZ6CVoAoIItQUo3Ytel+98eoXh2CqorfrYYqyPInVDDP5",
-	"hXF1DmvSaiA3PB+t5GjTnDLA0ULKdpVY6hzgfH0CaoUNajnOsQo6albJcyquc6gVBMJCQLoUrrJu4NAk",
-	"3Csd4SQy7a1TRJy4Aebl3U+TeF7SPaER1taI/J1rRG6xzBzRsOE06mLHDSH96ePDx457nQbCc78t8K26",
-	"9CmiIz7KQTcGahzBCoynzhuvcaX0cPdKSr2KnuRFdKSKomCAE7VjlaBY4xLlS7mf8Z6Jhir6ccEAp1w9",
-	"xbeNdORftdihHainYiw2xn9osNookB2iQH67oI7OhqjcWkR1EYKs4mxLRfhIzOtmuPYPLhFwLzTXdzUj",
-	"1q0frZ1Ui+q2pEKDlTLSH4v9KFD/nkgNIznjhsZY4An6fOPq5Jtggm52MoZugg66MapG97IDqw+F6ai/",
-	"+Sz9m+Dhht5QA5aVIwcueaLW3StOED1B0T6YoMFY/mKUr+7h9c30er0doRvXoFMUfXqSaY2qf9dTqJ/r",
-	"m7ZuUMGvGZqxG2ZDQ3fXRTAt1WuVjwolCVZ7/Sa8FP61eGkrdNJOlcAtRJo0gRuHDeAudIeK3bw7bKc1",
-	"2CQg08Ip7IVQ3RnZZW6CeKxANFfH8ofPN5VrJj2IujiyMIrE4FL1od8ED7vg0N9r9WtOuSb8J831L33X",
-	"qs/O1O0P9qeunGELdc881K1e/8gf+wqH6mWb/P10N4KOamD7IH4iOS+H3o2iY6u9Hrbtr80X8lcvjUGn",
-	"wnjrttsmk9Z5z+Oza7eYlY6Ne2lb1YxcHU6yzLjPoFWxxRxhZfOrF15mhh66dq1PEy7OG69R3Bci7rsU",
-	"BPeC4eJD7YnKs9f97utj9cDirarvZ+d5ZpnsyHLVkXvl+nzz85SGUWxiuXXQxF/GGv7YsWXDv8/i9UGh",
-	"xPdTTgT4gojv0R3M5EdvtKJT/7FS8dE+ZHULNOrfmlUV9e9FEcTj8GFrYFkngChLU2AReIB+2bUf0e8J",
-	"9Gj8sCEytMsX2bIAncIdnxqCVgF/B3f8IFLPccIPBXvYpLWEsLeOsnRGKBYZK0DnRKIzNffEjjGnflfK",
-	"5Lck9sP2yNstbqG2Rmlbo/RrqlFabWV4v3am3zt+IopgaULrPPeUZvtARbMvvpLf4WnGtlv5YcgDx/tb",
-	"XFE8Gn9vt7MCaj/mdm8rjJAnwbz/BJgf74r5gdHWVQH60PCZaSuosgU9Hs1QiSf25ndw7Mo7zJHpsXNe",
-	"h6cLZigFXPPYzslmbFBGNcmD+8LMGGnukrmxC51K6PxGt/kjqm4Gha7+tUrAL4/Y8qgB86RVwuPaqttS",
-	"pqoXyK41bVLFuSxaNWhrlnLjyKWCNPa9w7W3es7237yNLJjStvLGMlyb7SzNubohm4G4A6BorDaRYRi6",
-	"WbZrl4rlwOWt2KbZi+iIZnRDuGPYhp22YabZOc2hT7KMF1f53eKJi3vT6wuUMfX/KxOSXsdTTujieO0G",
-	"e6gAfH0jq1ofjp+KQae3U7tBTlWwexXVH3Ub+2g91gHxG5Z2Aei7nCXfmah5Urx1jx0kN8zq4ntZmUyO",
-	"YzodimsbqfItR6p8j+NC3XaRK5wZQ47nQqm+/p6qD+6Xikv17VH1fKw/ea+l/BJykQDmkvhzBnyB1lnO",
-	"zC1Wxsz5BN/qsGQrLtX5KxFqvtuwBebIdGkKS39PxefGlXkVoAa5Gn62GW192FNIO12U3ldRETXMfVD4",
-	"ND+kmCR6qc2TxC9G3LPYxT6z82JXtLZeHanJcKKTtUmIy5WqI73jcivX4oZtoL/nNuBB2mr/vTnc4F3s",
-	"eiaHkwFaG7ESoYyRX/WYhTevvk/sTglnszmIFO0u8S3vEh8oNgwHsbNNSKJ5uVxtF4OzPbeLGJNkPVVE",
-	"msJ9BBDXj8svZAtLRtvCK0uvGIAK/NV5hlQXHQDWD0Nj8IIKD0cxXjui4wXClSANQ2EyN4Cp8Mrp8aiM",
-	"vLMiNTjbUbtIptlKj0uHq7aSo2w4Qf3Q7vga/5TQXLiRm75pKyZ1lqEU03UxTA8Z1VVsRSjBQmmkCjWO",
-	"DyVFq12+Ze3S4CfURT7OfugE4wOO3+aGWAcyT4ulds0T4301sc6NINfGFl3jc3VDZ14VzBJIpVhxwgXv",
-	"IJMJ0Cahq1grPsCqzyhQTuF+qR+oajZySidUVnO888nV1NeZ5hSvMEmaQb+2QI+AdJkxzKS6cxtvNNic",
-	"yj05jYHdZpJBUywxpZhG4NET0mpHc7gzWsj1XPgAdclzVU63GdQakYatuvnLqxu/uO8V8Wvu9xEucj5s",
-	"DfjVWXU3hvb+kKVLBgugnKxsVm2bopqIRZmgk6+5gLSSp1NfnsmV0aX2GjG+RZpPqZ84TivplQ39MXcy",
-	"gM/UfZkOubihZX5oO3sKgpFI7vk6JSCYV1Uq927NDvQFDOscxToz9RcnIDP5iqdGV/gVGeGozGtc6K6y",
-	"QEhRKcIR7moVcV3ZSBciapYaOilLAY0G3ro8A7dYztBby6YfVkvNjMa+SjC2akutwnB/MDppZLjedOtT",
-	"y0096J069zyWUG5hBjddeCTICqZ3GfukvPHDTiCZSZ41f8lmCpJD4Rj3Rn44nMIDBw3cH/cGvpHdZEIq",
-	"/fyjO0NZ79KWrmxkaXxwsmg/ypVuvu1tfGl3xEuI1Xv2IixWcimC+wXOzT3PbgQq0C4BcNfbTvejScuu",
-	"MqgxVH1h8yUzOSu6NQ3b4XO4a2tKCeyxuv3TMJQ801zdLZbBvgWH2iT8bRL+r6qOEk6S93NlGrWM3DLy",
-	"787IB7JdtVPVrGvLV7blK9vylW35yt+7fKV7dHss5ZhsJemgt+GKXhmd7JuJrPnLx80V+Np9vd3X/9DC",
-	"gi0Dtgz456yXuKgg0EXv/9VWSvTEDy/9bGU9YPp79XFGaVAZD1uDqf5U9Rc9zzANchUW0VeXwz2d260j",
-	"sXUkto7EdptsHYktI7eM3DoSW0di60hsHYmtI7F1JLb7eruvt47ElgFbR2LrSPzWHYkVldCIUv4ecxL5",
-	"g5RfO4HETnjylQrjLYOTE7ICanLL+zMP68RKtp1ZSZMbiKWEForMeQDAckoJve3d0A8cYjRbo4xFC1Dl",
-	"9jPG0bOEfAL0r3wGjIIA/tw7oEmbDgzxhUoNrtKCm8SZvuDitwbIJwovtk8QYqkZNjlf1UfH71r4C11J",
-	"2sltWLoJV2U4qYUh+7QRAiXJzfl3DWr1TLvFPblJpVl4Cj5xlZrUUg3mqGox86MOJmcQ5xHEKMJLHOmc",
-	"Vl+f2lrtkKSklp/vcM1ix9tTtWC5XIddT/zxwtFy6V+ES2PAjZz8lb3O6n31Ag+27HbFO5cdX+OU72J2",
-	"2/YAx2uVBk6lLkKC4fmcRL0bqnYkrqw6v5lWvuQx55iOPqrrDHHqaG2e4PCNu2oDOj29u3tmuXkHrWx/",
-	"QrlQL/M8e+mlRf2JNlOaiamiz6PXmTQTmpJ7XWea51xPd7u48R5TL0b0tDeN3tvMF1jgmcr/UU5mMnb9",
-	"/reavscuuy3oLou5Jza+dTp8iL3fGD3Nc6Lf9F74qV0QW3nxD/U+/NUuUNt1/lOv8wY3eLtOX4u/uF2p",
-	"r96xWtrtxQFP2+ate3WPE+CfzRG64Xh1mP+iPY98c+eR1npurefWem6t53adWuu5XanWem6tZ68Zi55V",
-	"1sDJmPd86y1LcSOw5ZplhzRqyi72FUN7m2n+WEGSLVNV5EK1rdQcmRwd4SXp3cGsa6pPsF4Mq6PPhsYP",
-	"R8pKZ0Tio3i8skKVembN0hbNemy1smcPqs6ZwbuhXkw2OLeigrlS4U6xNZsgrlmstyikV5RjXhGMmrWf",
-	"y8HK0nvN0fSqlHedmMaOaKs1dEYya/jw8eF/AwAA//+IGptdRhQBAA==",
+	"H4sIAAAAAAAC/+y9+5PbNrIv/q+geG5VnHOksTQPx55U6t6J7STedWxfj/dkv8fjK0MkJCGmQC4AzoyS",
+	"8v/+rW48CJKgHmNnd5Nwf9h4RBKPRqPR6Menf03SYl0WggmtkvNfE3ZL12XO8N+i0DPJaLaZKSavecrg",
+	"R1Wt11RukvPk0vxIuCKi0ATfTEbJNc0rfDNdsfQDNpTSdIU/MSkLmZwnr1nGFYFWmSSVkIymKzrPWTJK",
+	"cqr0DD9lWXKeHE+Oz8aT6Xh69mY6OT+ZnE8m/5OMEqWprlRynlRixWiuV5vk4yj5R8WqRj8/MqXokhF8",
+	"QNJCCJZqXgii+ZoVlf7E/pQuJF02enxCNZ1T1ehsQXnOsk/q62Pw85OXP71IRglMQWm6LvtbumZS8UIk",
+	"58n0aHI0Mc2YVZtlxY3oXU98GCyl7/vHi2cv3jx9cfHi8dNDh3Bdj8FPbCdj+TcPYqyA9mVR5ITdrmil",
+	"NMt+K/6ay+LDZ+XkCGc9/rzcezeOqkp4KTmfPpxMjo5jHPZxlKwYzZjEBboo+X+bV37AH+G3jKlU8lKb",
+	"7y5ePSO2FVIplpFFIYlecUUkU2UhFIMJpCu2pvAxE9U6OX+bXE+TdyMnrZC7YAKbEv6ttORiacZSUknX",
+	"TN9pOLqAEYUD+kfFlD4izxYo8VTJUr7gLBuRjC1olWsF31xPj67EZVWWhdQsc62pc3I9vRJJZ9AcujUk",
+	"S0aJoGtmhjG2I21M3/bjvm1SIzJ9R0Oc/ZxmMzsH+DMthGYC/0nLMucpBRrc/1kVon0ScHFNc57NCiST",
+	"am7XZ+YhoYLmG8UVcW8FWzZjmvIceO2N4V2yrpQmc0bmTN8wJsgZoSIjJ5MJUSwtRAafO9Zvdz9K1mbj",
+	"bemdlLK45hnuecPos7TIWHJ+OpnswepAPNdtJfP4jP/2+jlwx5rq+FzhuZsnJeabH968eUUKif+9hBYi",
+	"84QOwzm+WTE/HezUHrn49t3nt+ZKcbFEnuCSZbMFZ3nWnOqP5h3i3iHmnfjSrhj5opL5F+YlwpX/LJhk",
+	"T6/hfF83OoN27Ed3nevHcA+VsiiZ1JypxvA7kiDLOPyT5gSHTtybnY3m59Zu4il+h0ONfOTn2/7sh2pN",
+	"xVgymsFJYnt3b0cakkzLzYwudEygXZrdBILphnJgxUUhGcFvYGHvgXiTVDOS8zXXpjf1Zd0PF5otmUw+",
+	"tmjfGTUwtnmjNeWghWCtfk3s1jlPMqrZGB5FZLj/pZj/zFJtFrPZ87c0c7KZjEm4OQtJggPg4whV2kVR",
+	"iexAAeiky6zRQL1NLuxz3JbmeXSLvChqQYWvkRuuV0SHG/zZk2C3RDoOd0q039YWOd1THFSKyb75/U0x",
+	"ucfcoIneeaWSZUxoTvNQtrd6DSfX6fROExv2/h95779mqqhkygI+AapQzWY4pwP3eUZ5vjFfzthtyljG",
+	"WjvhCbzh6OXeiO6H7yRjuCMUodKSmGWwGNPJxIoBpkjJJMnoJtgS0UGEG8OMwQuSzmAaTPHwAZ6Szb1z",
+	"/GhPoVBTsocerwP22UqO+sVzMp04gW3mv+ai0iwgQazbhkZUFGRNxcY3c0Re5YwqRrTcELqkXJCcaibb",
+	"1HhwV1IMYuSPLEY6/ETGJMbZ1oDC5Myv10HXKM2koPms3UZ4tTCvOOOYeSW6oeIMj7dTe+7Oc7aG/aW4",
+	"0moEZhFNU02UuZs2Lh6xgTUVDVIJdluyVLPM8lORppWU3RvW2d43EGeMqgS9pjwHXo2bgjRbl4WkEuRe",
+	"+HLvPUSFNqSMyWUBnLqmMFNBRcoiAoMLQsmC3VhxFGopsYGG5AlMVv1DbRHpZJA7f3q5E9/uaCKllV4V",
+	"kv/CDr2rsNsS79W6+MBaJt6n5hGBtpnQthVi3twmZCRbSKZWZFNU0rxOCknyYsmF2TzBXmn23xAikW7J",
+	"iipiP+mq+NMDTTXhHSNqsjFDbl5F+qeNllUz6eATtFR5sRGx3zSb79qq2Jry3FxOlbop5GeYeGSxXW/7",
+	"L3bDzmRWB2wvNAd2ZxmMuF6p9qT3XG6uiP3i7pN2JqTIpJ296mAOt/P2drpvGZXM8ToXeKRe2C1p2vQ2",
+	"27Zla39KBOaxO5FiOBz+yIfD34IzIDBsAdGiXJ7U/GC8HWnKlOJznnO9cZaiLqcsuMi4WEZY5b95kWPL",
+	"zlg13+A+AGrwlPz0+OJ7UkjOhGYZsV65UcI1W0e6iVP3R5quuGDEcwVHybngTJLCKLJ2fA3PidtqYWMH",
+	"s2LdafBwW68lXTI8r0RB1kxTsqN7xXKW6tgOenx5SdxTUlK9Aj6GbovFgmHHhOVszYRuDGCl1zm5qiaT",
+	"E0bmRbZx/wa91v2br5fnQq/GxWIMA7p3/GV8aNdMcr2JkKa4IYpJXlQqJAThKnA4cbEoklFyQ6WwREJJ",
+	"8S7S001Kl91ekHdUhRxKUsk19CgaHUoGFxrY4Q0aTI+mR9PohvLS9PxtYneqn2bNC+86O8//QKWkm9je",
+	"HHlDK/j3u7xNw5027LBhhw077OAdhubMX6yjnnol5VWDxZsM36Oe/LQym8i1aANw4OC8oYqoD7wsUefq",
+	"ULKodFnpiM7kWrIu/5SYN0eEzhUTmtysmIj1eXQlQKnWLF3NlKbph/oFyXQlhSKUvGHp6hIejrAJveIy",
+	"m5UUp1m/T8kbePCKSr15Jq6Z0IXcXAm8i6DBI61gHWY2+iL88NI+M0EPF1XG9dGVgAn7+I2OYDIP3F71",
+	"jekV1aArZ1XKTMeWZk0GgliQXQzk+o6xS3MwL7ELGAuj6YqUebVcolTxw/rANswLT/8rBlNEWmciLVD0",
+	"dKXGikqaaiaJewdbRLEEvJMxYHvr3bIqJ9f4SEsqlHmqC/K3N9+NH+JF0Rr6gd/aGnqaU8my7iieur7d",
+	"K25mj40VYPxmUzJ7/xjhrSxXDN6hRmhqunQLVxrdul4bteILPfuZR/X9jGk08EUIQ0UheEpzJKprfTeR",
+	"9u/bTGeWrqhUTMeXRjFNcjpnuRtAhCCEKrckqi3dL3EAf3l2GT/KND2w+wWXShua2w9hNVZal2Ng9Ovm",
+	"+O68LGuu1lSnq9gtKJA9Ue6AB/ONZqSQQJw1lVbMeE5xEgTeUuEASVagW40uJWOkEI0lr8c5L4qcURQn",
+	"xhcXk8hMssbnZstQuAcvZLEeES7sCIsFKSVLWcZEyr4m75XgiwXL3hOu7K4qJKGibmlZMYWBY7JY19Nd",
+	"oCVfpNzMiQq0A9vtBLMb4cTf24gqbP6Gi6y4UePp8dkxhn21PkE3+8qoDgpWX4cntjXRzZAso2RerBPD",
+	"VMkosZNIRj6CqxG91vp0u9z0m9TTO+CQmDBdMJ2uZpqv2Wwd0UEhIgsONqEJvomrw+aGBezIauJqKpdM",
+	"mygkQdY8z3kQsOVmdHJ6PKrvwVzoB6c4SsHXQKxJ7A4Nr8e02pwqBWcu9bFnzfG/qmRZKJRJoItsMCQL",
+	"BpcVaQUKnmGsEVkUeV7c1NJ0KYuqRO7AOCNlDjcqjTP5RlJQE6xXAJq0+iLyTVpUeUbmjLjhsexKbFPN",
+	"xYIjR8Nfa3pr6DCdTHZRhYuM3XYn/T9MFgQCjTNSFoo39Oro9L+GO4XIaF4IZubr5g8TTosKrxiKlVRS",
+	"zfINzmb70D5wEybiNgDaVoEr+VJUZTJKnHlzJpliGp4wKlM4EwS7UTnTxkFb0o3VwyvxQUDocUzLhVZp",
+	"zKD19NpQFiff5BbY1IZIhRiBMryC08EPy5ChkGReaV2ImWa3OlzDzhiaWuwoqUka2VYr5vhqP5aCt1Q1",
+	"X3OtDY/+hV7TS2wxImw/7lSyYeNnDFYzm1nrpoyQ7xleB/WG+HfqAwDuSlIR3w6BRYDht4/2t8n3RbFE",
+	"L9dFWeJ/X5ZMPHtCbNhy8u4QunpR0DrttKxSXcn2Xi+EH/Koy/n9u5KmOqr8gnDzO8ksCVySRkQyVeTX",
+	"LDOWf6VrSplATi/xKsmj9lSRmt/qTRN6c27HNzc3Y2hkXMkcDzgT91DlmsOF4D4+yyieKcCt98uc8vh+",
+	"MbwXWW9RVlqN7OXYOjPYraaSURXKkBGuclFpAtM3W2SL9YFWuoBckpxpFrikkvME3R0xcqAuFdk57NZQ",
+	"1od/UiGKSqRWpxwFigOhktMxNpSzbL4ZBT/ACU+oUkXKkXmt6iaxbc01XCC0lnxeadaKzH4KQyY0yyRT",
+	"cZWM3s5yJpYalbLtcnLNxd7vmvjvyBYpqdZMiiZl39LxL5Pxo3f/FTefO53h14iq5vgwwhwEnuG9wvBI",
+	"g0VsODwcuFZ4NQ7+vsXeS1z1msm+M3LU8SMldn0XPGekKvOChk8FoWnKSg3bVUuOmxzngGLFqIiyuFFM",
+	"kqxgQSg1tXww2NcG+9rv2r72+dTGnUrgmulV0VADv3/6Jhklr15evolSUxRur/XoTDCOjCtga+U3qv2m",
+	"waYH6UKonM6g8VngGj1QMfgOxmYe2hN/72MXP8WHaEFRB+mZNZEjjaKCotBaZ98b7bcWh5FNzfqtQy+q",
+	"9dyIO3wzuBSBvo1t2CdoOKOagNNdk7MJKZlMgduCG9IulnOmzqhTGJ/Aqc+UMmz8+1QV6xiSWc1Se6pe",
+	"LVMuxDx4Q27waq39NMQTBvUJPQ6CU7qbuE9N8eptcOT0NXOoTjAcy8Ox/Ds/lldUzVIlF3XoUkSgY5wu",
+	"R9fQimcZxh+BWn6DWjfa3/Oi+KBIzj8wVHeF5nBtXIKYc9FHXZUfOm/aPuJXg3+a7sAFinI223reOqla",
+	"XDNJ8L6LyZPRKR6ojewjgXShaT5DA1lEYYGHRLSOP5/KtGX2sa7BZg/CBzuLSLrVdPcFcnW8xzsne7xz",
+	"usc7Z3u882DXO9soUVQ654JFSGFeiJmzi5Lk7JrlxL3juLTmTttq/01vxfNMxjbo8+KGyXb7ginNMhNo",
+	"blLF7aOwh7hLW8uKtd2dL0xzP5g2XjRizrZoajCmiNQ1rdgh35vi/X01JXoli2q5Ig/MDw9AmHu79IOA",
+	"d6exVXUCYKuUQDniiG8pcgcpgVbZ3nnBU6NV3qy4ZqqkKSNpkee0NE7XWsB/z7SGT5SmUrNsp6g3FLUD",
+	"COa81/1LqYpFuPOlYT2XnaG8UdrY+HJGVlM1sqv1c7Uu1Yiwdak36PUC05Y9EvwGGMwVg170BwwHAsrP",
+	"esNTntirKPnhzY/PHcRGY9Tw4CxqdObiQ2S3sFubAtVz0tdXXPcmMS3tVnJcdGDOZuaTLXcZmq5iF+yf",
+	"VkyvmLFcS6aqXLcc6IQSycxFGnMW1MrEjVh0DZdtGlWatkZ579pxaVGy7eOFOeNoLU4NSDsKOq0lo3Xn",
+	"h1RtML75HRfX/jPqHjwkhhtpxa/DMyBYMYv3sf2G/nEvI9y+PMVFMPvdPAVvzfqOmOfQhPFlp1SQOcp6",
+	"YCvw4RDJMi5ZqsmcZvlmZBKPCK3zp20cD9ppvMCwtAPZWXChCdXgwZR0ied4EBfgz3ZvWV/R69/Gqo7z",
+	"DzjFzWyWF0WZgATRpUKcq6WkKJzyAnFH7GvpymTvqGKhZ6cTCFDIqFjmcMxRka4wA1IW80KrGdgjTfBA",
+	"lPk+4djzs/CSCza1Iqd+pTDHAZcQI4vU+f379uWjtFjfF+wm7v2xX0fDkWnuUG3i5iwjRJjI0HW+h7lq",
+	"VZS7ubyekQvF2Mnpq6KMsPjrTkN1gArsjTqcqJ/v8sJmTURat6a75/YVbBdI4yaAMX57UGWrUHKTcJvL",
+	"dUKFumGyjp/rFVDdpbPZyyzbObbW6V0h4lA43FFNoL0O8LA1XLSQJ0YBz8Va+/c5RfbW0nDf3llHi99p",
+	"vOMbJ9vxe8c4bN+zqrvY/QqbW4997jmSLXkhAotFX+T29m3eJzHwOCQlk+ZUMr2Rey64UdBrvkQmHblI",
+	"tRFRPGNzKoEjFkWhmfxyRDKWYlLjfENyKjKIgvTu6xG5eP3sgsgiZ8rGdq3XhTBODPyBW59NM0ggSMqd",
+	"TkaJ6Ss5P3ahrPjPeoTJ+aOoznCIeWkf7SDWCUYhRpRNG9MbPwu8GEQkpTDg1X/ottlOZ0ZfVO0TF16Z",
+	"dgOvW1abxhlZ6cX4YTyCOWi+cwk06+Vabt8oiabN6+ebFWJh4mUPAZskAgLCX3leKS2p5teM2A9UaNtQ",
+	"R9FTUrJFTmOB5xc5SiXNgD+XFTC7Aw5sxQz3HWfRZp+7xu4BHxtoPJrbffSl0YVt89SNoEGCjI2fPN0i",
+	"eHazTNjuZ1Cpe5Tey6cva5uKc1S6vF2vlMLGHAwmg8Hk9+9I+sA24MaJhcMKLXkdyI9c4N62Em7/wIOi",
+	"ZGK2lLRcbTvbt0thjAQl30MjpN5wdcoOOrRc8BYM+X2xPH9PSskW/DZm+TZ3sshhguo0v64nb970qQ4j",
+	"AncFOU5pyzT81vq8Rom5TxwWrapvuNZMzlIqs08g0xvTDHlMZbYnoWzPW6l1zdkNwv3sOg7di55cDaa+",
+	"4ZlefZOxa56yMf4BlyyuOc3HKqU5+2a6n0Rf81uWzQI8k1Y0v3fwwSECwSM0M7l7dWy0LoAcVFgQVRB6",
+	"X7tMvEVhdEULL54Zt6F1GHZjOq5Zn+5lIq/ViCi9yZlaMQZ/8IUETRDVQshD48LHKCkyz4v0A3iXJF+u",
+	"9F4JD329B5FGyscumr9xRpJnzBp5DF0i7tHtvXv+7jkNnXTv3ufDBDDzEpFswSQTqcsFs7i2dXpTK4y9",
+	"3gPtJAKzKDZ5wPwLpp6869dBPl2rsH31LcazNTIULMOaZZzWK76mG1KVaF/CPAJggDvo5yWTOAkRS566",
+	"NLngwTuEKc3XVDMyr3geJOfAvaYqfUaXQ38mLifUCQ+f52Mgz0wakUpGMWt0LBcwXdXHX9btpqu5R9pF",
+	"ESCLvBkttKa3Y7pk3zyYTGLcwrQ5qrsPEJcmLmYRJH1dZBiQFnkjtiIQIyUxryxyzth52RxDlDLWXuo+",
+	"A/K4dLyn2zLmXObXlpTUVjNNjbY1hMamW/7Cy+gthAu9befvqQdDM4Ey3LDEiozJGe4G0IH97u888KI1",
+	"GSUcdtnM6818zYRycN/2x1Iyi+2P2PdyyWZc5Fww24Xq/IwdYGZRvaKzAFnetWxY0jKwbwW02ajk4euS",
+	"phFJ8dRuzIyYNzD10GQRuKUDsgW0Am1jlIBYqdYJrM1y9blNzLZH3TURu40fMyaitb+QHI841wzBdBWn",
+	"8u6rIu0jgtHfhymoEQnIf/EnDjr8PPtz4dJWQSxyrTq7LsxIHu1O2d59bOJZMLOSNGTTbe4d/KiG7SGo",
+	"QGGuMANVoRGJuaP/kN/7CGbsRsqSzXxCzAvOTqY87fbuEvbSYT3CF3foMC4/VK+O5u75sH2dwuizQdRG",
+	"pFiggaFJEHr75ipZF1mVs6sk5OJdVqyu7bNPmsWGWj9sDNfknJZlvnEXbKtVE9P8pwwQ+XvBpGTZJ2yt",
+	"Zj59sIe2b7a7qEGySI2k3Ctb2eA8OLmKo7e8ti01eXp8dnBqckNStg9LsHzP5puZC33+vBZwVc1972gI",
+	"d38Rmywe2KNRzMAMR07hPj8dJcEhe34cI/v+oQ+NwVi1A5XPAv00q0LpAwMitpxBl2FnuE0sjgtaFUPE",
+	"JeMTGpFC5BvICYOXEBUBf6/7IFwRJuCY3GYFHEIw/pQhGL28HUDKZMjih4mLvpwNOJf6TjRg1OAcM0OA",
+	"DxqKQpeN8JQ7pFH8YEejEDaOk4tbY59aE0BKJdodKQk2LnnmPgSr9Crafk5/2czMwHqu3u2Rw7+5WH5z",
+	"hd9eJdFmjeoamhb25eN2ira/vnSvK2idQO0aUueLZJTQKuPFNmtFT/0C477/lJyhzx7MnnGluUDA8Hlo",
+	"JDj0WO+z1/60omaK5oUjfasbeFOI24CrSBTdKELnDduFJw5VpKikKT5ClzGDg4vd2SokPX4R9sCyKFel",
+	"kt7ks4zldLMFk3TF3MCp+qAIfsRkCFZqzgSHNh8e4yFwSlZUFmm8S3KzTsjpxh6YMbEFIIgKQrO1GZG5",
+	"7+A0AyrmoDAvV+FquLAnRbiOkkNWeU8aZ6MVa5c3p7jzxfOm5+gCOjon97MiVfej5xxIUlpGlVjzpM1M",
+	"OTe0vaMC3XLYUB+y5EcSjWVxIBXZLLNgkc3R/uXy5Yvx8ycj8iNPZQHvoMHu9ZPvKGFCczT6m1A8Lvbw",
+	"/Bpk24glnUplUXat7bJdyytop+EMeYX4bjoovNX5ZkOuMAnwKjkc2YPGrO0boektzhYacxcJExzgLFtW",
+	"HgPo+diUDHMUTEaJzBY0KndbomDvNIxnMI76469dmgdOllDJiFl0hUffxookLklxI8j7/wPjeN/Uz21x",
+	"v594tjRwOB8q+HM8Tfpkt9oSMoHPR/VZbpB/jwq5JNdFSudVTuXGOoaIZOvimmXRdT5kBVt7wle/M4Nt",
+	"EHsfNyaicMRYd2kROpqoHOZf5AmGZOx3Ajrg1qdOw26xg30841lTQFQ860HI+41htTWCb7x++e3LN5ez",
+	"J88uL54/f/nT0yc1zFv3tHSxt15a+zOSI3jHlbh48uT108vL2YuXb2adBu3nVvvAU4qSUvJrqpmB+Ufc",
+	"P6ZvCvnhSvTOaPbZkL9XWpezw64TMYCye3xBLL7OPGfbsL9Ddc/cMpN3B7HXM/FKFkvJlPp0HrMJ2DOl",
+	"WRnxB5indfknfC3cJt4uMnPxYt31cnbqmU0GhyA+U9q136QNz4E56k+SuLj1dGjtavvE5fybxT8EDK27",
+	"WFzMfIeHrdhr5wTYtV5tUHb+j6oRBLSwiELus2S0xxJLhtSPHYU/NbBkYYXx5m6+CBvfAi//OVe4ZqyT",
+	"ieqPcu7nVJc8sGiTqfYX1WHL4YKOEgdVgPPu25c9V6s3Np56zoyn09zg73SNCngGq+9+8g5307IMsN+S",
+	"Ol8h3qS7GtQPF+Pjswd4z27B9mbOLtpYTXYyn6Snp8ePHi7SaTo9fUQX88Vp+vDRoweL+aPj0+OvKDud",
+	"stMHp4/mj05OU3r66OzRo+n8q4dnx/OHZ2fbhgiOgO3G5vbQQu9AYKs9OY0Ya7uCobmf9iNnVsmeBAC3",
+	"3MS/0gjvOlM93jSoAD2Aww/BjUNw4wAOP4DDD+DwAzj8AA4/gMMP4PADOPwADj+Aww/g8AM4/AAOP4DD",
+	"D+DwAzj8AA4/2NcG+9oADj+Aww/g8AM4/AAOP4DDD8fyAA4/gMMP4PADOPwADj+Aww/g8AM4/KAXDXrR",
+	"AA4/ZCYP4PADOPwADj+Aww/g8AM4/AAOP4DDD+DwAzj8AA4/gMMP4PCDwWQwmAzg8AM4/AAOP4DDD+Dw",
+	"Azj8AA4/gMMP4PADOPwADj+Aww/g8AM4/AAOP4DDD+DwAzj8EIIxgMMP4PADOPwADj+Aww/g8AM4/AAO",
+	"P4DDD+DwUTzpGnD4s/oULEbkayPSu9vUBF/sAx7rIMLOFzRXbNR3ZBVEViJEi200REARiQvtBtJm2/vW",
+	"hlZUriPrgBwRXSzNCGrVtH53xTYkYyUTGSnE0ZVApOvC+u1MHrZkS640AxZ2H0IH6mu8g2CuCwhu/I2I",
+	"QrAj8jeDC+WisGDvSfazifHAUZxOJuRbmhFL/aNmwPGa3j63+CsPTgMoleT/AYDKO4uiMnv3n/+rB+rl",
+	"mWnpbNLiYLArA1a4fQ5SBKOd4NbpQ/39csaETLCa+Jm5qjVZIqqHNG+2B7GN6ah7i7a6l4o7PtGECSBq",
+	"QoeuMK8/IAjL10TRdaD8KKaNqCxpWgOv+XunxIAnvu5hVBPHMwvQoPajpPkuxB2INs+XopBsZkO89a1u",
+	"9BGl4nctnUlYVGa0huGKA5MLULkk69OkjshLkdtEXXUF0emIvYYLgioa3ujRt6iYJlx/be0r5guyZJpQ",
+	"cjo5ObqKJ/pykeZVxmY+R+wA2tlvfb5egOXe35GLL9ybBV0vELYVAFFu6QpTHgJTh+2ovsl1e+Ppyq6J",
+	"Z/rAKmSecAU0PsJ7ap2AgSzrbCXWtYehI1ciLYQBSEg3uPyUlEyO3dADjiaqaF5lFNd4kxWFcdabG61Z",
+	"Q3c2BbMJbqs077mo8jUrqibbnkxGnSsmykRi3ybAYN4s6lM+TxpQhGf73ey2gvLrwsl3ck9VZVlIkAVz",
+	"VeSVxjfUyLiQ4WYAEWbKQHI2c2G+bIWXdRIguvfiQNxPJxM7L/fLyT7R0e/6z3fZVyBgAKP+o4JRP758",
+	"9arIeRpB9vdX262x7/tfj4LLgt3RYyVTGOAXiuWLL2B8hjKt30fJF6IQKRvLU5FN1l8k76KWGAa7cAZ2",
+	"8ojuh3MkKRzgaPh03h23YmPzxvg1tjLGQww3rJFpTCwKmUbtJrGxQBQJe4KqIgjTxyBtra3m5SI5f9sh",
+	"dY3atP8dLijZlPmuxn7jcGFER8OyXg9xq1XeOuQJt8EuvnmgRyVWDAwqmwYrWixMYGUnjOlCM0nOJpPJ",
+	"WsUhEpWe2ZOot44MV2H3IFbgM3eA7V1PxoVF9NSQcdV0cOzbPGynx0dnETNVXw2ZH5BSrRIy9XyC07Gm",
+	"acYw5ipDDbz+uR8/trXd7Vgiu/2ObNf8qCyKHERsFMSD662xAkBdRRaSoefc8csNbduEiiJvuTV3Ywln",
+	"OZvVjW4dBrwbDED19fvVaCf6pVLsjjN+8fLN9lmfHu/qXmm6/6Tx5casrd2k9gS1R7BzAHan70EBaozD",
+	"9gNSpKhoNmw2Jzt7swb+vaaLL++zyNOdrAUj3+1jc/NsLTN83Jzn6dleHbriPTPRG5KiA3Rz1Lm4NiE4",
+	"wRg4WBZEEQsWAMk82YXS3U5/5NoFrRl3r+OABpkiU4gtX2TXxpj6XW9q2Qe2Ubt9PfAW0MGEfDbkyulX",
+	"h7p8ur+8cwf+DzbC7k8ZVPq4KD5w9l1Ol7FzQevSa2bd+28v4h4YXGaK64bL8jm9TUbJJaLfJqPkRSFY",
+	"T75rWkkW6zE6fo9J+J1DD/vDAhE+rqQq5Cu65MKnibcWjKqZsHm8MXvFmutYFC3et4OdZ9wJTU/PdvAl",
+	"6HSW4viiFflUUWemYToL7CuDeOkssTaRCdXEsqdC51Zf8YvW+LH6hPPKLniu8daXykIpQvMcO1HJQWLU",
+	"2wWDcYxqqsckXuQ6sdcl/c4a/ROq6ZyqhuJiLtv/amX+96FtG8r31+n87Wvxpg14tX3jrQ8odCuZlpsZ",
+	"3vO2RAz4wACMrcRvYDfdA50lsClib2pbmds9w6+SPlVVabou962wGBOc39m01CG9dkiv/d2k10K6nSsJ",
+	"OpQgGkoQDSWIhhJEQwmioQTRUIJoKEE0lCAadOhBhx5KEA0liIYSREMJoqEE0XAsD8fyUIJoKEH0hyxB",
+	"BNN93DBgDZbEwZL4r7AkAic+QTV5sJoNVrPBajZYzQar2aCeD1azP7rVDM79PcMLh5NqOKl2BCMVcn0Z",
+	"2OoGi9pgURuO7OHIHixqfy6L2veSxuTJc6Y1kwSjm0fkgswZsNKcKXMgfUfWjALLehikQhIu2GLBHEq9",
+	"G9FFMkq+TUbJ42SUPElGyXdR7v7h8s1lnUrcVaFMwsb4jaRCYY6t9yaV+FUNPI+IsQ0tpS7PZMtPtRNk",
+	"HFiDquamppGKcwroHVYMHgiTWkoGN7p900nCAtdDCe6hBPcnluC2w3k5lIwf+HUoGT+UjB+07j9ZyXiT",
+	"2tafTIYpdFuBWgbEkQFx5J+fA9kt92RGBXvsmmdVyEp8W5mmATpnYOQBOmeAzhmgcwbonAE65w8EnfOP",
+	"ilVsUFCHc/1fo6AqXUi6HBhwYMB/CQNuh/VvGcuumQS8oVVjAmPy8q+mOhhfIBxReJ/CWFU73hF58vT7",
+	"1xdPnj6BN1WxZkQUYpxKrnlKI981mMqS5OVfwQlk24F/vvzpRTJKfrx49uLN0xcXLx4/7UVz9ugrrZiJ",
+	"y5fk4YPJlPh3apBfC2xNlasQfAB3VWWcrS6ZhDrfpCodX0VY6uTBZBJlql4c34s6ZJa4lw6H6rVLHxJs",
+	"5Gw7Ub+AZIuciuVzLiKIUPAkYrOnYlmBJLlHRUZM6QYsybjkhfjSFDBzwRe5ZlLQVrxFxsZPniaHVIHC",
+	"uBKIPIm0e3g1imdBkb34xIc6d7/nOnd3X1M7tjutqS2xqJi5ETgqm1IXB6y3A0XH9eKKhCUhW32Ej9od",
+	"hcekQeKOGA0WHMr/Rbedi/Myc2Yiw9yKPeK4VkW5+8bmx+RRf3b6rVZFrLzV605D9Z0aKIJZpDuSR/Oi",
+	"zubpkT3P7SuugL+fAJYL24MqW/eFm4TjP9cJFeqGBUWeevdId+ls9TSW7RxbBOK/OdxRTaC9fA+NOyss",
+	"WsgTo4DnYq2ZU6Sn6pF5GERzWFuGSP2Jg7tGcSwk3NTEDAsIes2XToG2FXFhvjxjcyqRWIVmcU+QjDmE",
+	"jQc7RQ+tZLmtQ3JoctEBMtPP0kgY6YXofgIoNOpQuWSRbfotBNJwsTQVg20wMHZZlExYynZnFfUrv2l8",
+	"H4klXtA8h77mUHNBF5iWE8YQY20dOPCNaxpjYW3xq53FKfc9tPY9abr7BN/xXOt925KFHTVPpn4otf1r",
+	"DTdO6QOrCvvaS0ON36HGb2iZFwfxFLw16wvLAD63eH0pFWQOs0W2gow7f3aSOc0gUA8LChOKKUvGLG/K",
+	"jZraQs7J7u7bkpQFF5pQDbmVki4x9gVW2cizOh7G50Gt6PVvkwOF8w84xc1slhdFmYxMSZ5ZVtwIvP7j",
+	"USqWM/9aujK1+lSx0LPTCUQRZlQsQSDOqEhXhUxcidiZq5LVU5vvE0JF/Cz8DQ02tSKngZqmC7OEJFJl",
+	"6L5gN4PiOSiev2vF89/nFNk7sgn37Z3jmu6kryWfVXvqD3Jy67FPbKBRvswyb41g2r7N+yQGHoekZNKc",
+	"SqY3cs/cKEakvlCMiL1PjIi9TgBHmPvElyNf2nm+ITkV2ZrKDz6Fa0QuXj+7ILLImbL4tet1IQzIAf7A",
+	"M1fgs1k213ZpfKmmL3Qem+HhP+sRJuePPvY69PaE0NhHO+gzyjzDg2Y4fofjdzh+h+N3OH5/l8cvCPJX",
+	"UY/357AsDBb4QRIPkniwwA8W+MEC/1tY4NsrXw5FzH4/Rcw635t9FaxhXGG5ZoIp1Z/81Bcw5UJ9cttC",
+	"I2QKYqDsc66IrASofM0YKfujsWRLZkpxp7SkqVG3fn9BUf3hS6+eRcOWrj8hbmlb3fHnxZILQEsY0MFr",
+	"ovzINN1SqYmKQvDUHv17xVmZM95/6O5UO6F8VlSq2Ln2hKU5qu7wBk01kwQBIWGTdGuY1lxU6cX4Yayn",
+	"RvMdSWmUC9dyO+OVaNpMj32DgakI/wBbGo7wSjECf+V5pbSkml8zYj9QYe61Oooq4ja6LrJdXAAbyV00",
+	"neV2H1FqpXafxvzHDM3bN+/78unLOufbAd+7M9YtCiZ1DwndQ0L37x9G6QPbAIhRDBRZaMmZagg597aV",
+	"cPsfVUXJxGwpabna5kfZLoWxshj5Hhoh9YaDMRnnBxygHtYUhvy+WJ6/J6VkC34by2owBvjIYYI3dn5d",
+	"T968aWig6XJEwBwhx3g1bJZDM4hPo8Soz4dVP9M3XGsmZymV2SeQ6Y1phjymMtuTULbnrdS65uymLKTe",
+	"eRy6Fz25Gkx9wzO9+iZjoN+O8Y8R4YJrTvOxSmnOvpnuJ9F/5Lcss10/0yyirzm50r2CAAHsaO1LRLIF",
+	"k0yk7iZiFLl63KoFo11Tvw1iTh1MVUmV4tfuvPqk6+nW2b9m8XV55RG+YDaKAEwUQ7TyGiRcF8AR1Lx2",
+	"ibrB14TOlcs/hB+UCaTJDG6YRQzrqsbXrO/KZyDI1YgovcmZWjEGf/CFpGvrlizzasmFqqG25nmRfiBF",
+	"pSVfrvROCyLW9+npPVDPlQc2Nn/jjCTPmI0pMnSJ4KNt791v8R6F4I/MiF27B/bVtxjP1shQsAxrlnFa",
+	"r/iabkhVoj8VAfWBAe7gDgZr4lOr+kdEVfd64NFE4eKaMUSRNxdrWxea23hESYUyT3VB/vbmu/FDnIZ1",
+	"EmSdPZHZO0nsaLV9u1dcZVq7ocdvNiUjLhCgkITliuE+9TK1pcwH7LHiCz37mav4raavktNjfxUTIU/u",
+	"JNL+fZvpzHqvcI/NA4fmu+gjCKHKLYlqa42XOIC/PLuMq8iaHti9uaYize2HsBrgKh+DBnbdHN+dl2XN",
+	"FVrBYoYiXdtXotwBD+YbzYwnhED0x6hW2GBIaFe1bzUugSQrMIqRLiVjzrDnljzq11JFJdO4N1Wyxuct",
+	"R1vtq4EBlJKlLGMiZV+T90rwxYJl7wlXdldZf6traVkxpULnD053gW4QkxNYLOCDSvjtZEyWMPH3GVvQ",
+	"KtfY/A0XWXGjxtPjs2NzC29+gkGdK3MlUbD6OrwJWJfCzBqG58U6MUyVjBI7iWSU2P6Sd+Hqtz7drtD7",
+	"TerpHXDIu7jIu6OpGZ6Wkl3DFWiHMXoHzpy9e25/q2UX3gcZApdl18vRc8CYIKlI2Q9c6C5l9rylr7jQ",
+	"wVW9ERQkQKDhQQU3dH8wdx54rScZJej0mPlbPV8zoSxKgvsRtoetnjxKciqXbMZFzgWzXajOz9gBpv0C",
+	"IrXE/TJzWcxBy+gonxnRUbcCd+2oUsDXJU0jsvKp0nyN0OvmDdyABlPdHRZAtoBWcBeCzZLxCnbNii9X",
+	"nzvayfYYS+bGXaSi3lSM+y4kR+3TNYNAr9xdyPe9wO3gwj4tHaCneErK+k3CLH3JvOK5rgUfCPeq9ALd",
+	"LbA9C+qaHgtmXSpUbEg9/14Iq7YqkK5qq0TW7aZrUI20C2gfWhZ5E8J8TW/HdMm+eTCZxNaKaWNB6T64",
+	"Lbnsuf0iMsC6yGB/ZpE3YutSb5PI9d/Oy6oYePOxKQPuMyCPO42fbjswneBn/Rppq5kGadtDaKgUy194",
+	"GVW0uA1X/TTz5CD4BsF3d8HXgWvX63yGGmhEAvJf/I3jhzc/Pq/ZnwuntYqUYfxAZ7sEF5LR7hvb7qs8",
+	"3k9nVpKGbLrNkY0febxyStCuhVcFBuaLRnmIHf2H/N5HMBM6rSzZzCfEvOBCxZWn3d5dwl46rEf44g4d",
+	"xuWH6rUbOfcLbF9nxPLlq9RGpCOSsQUshcQSJ99cJesiq3J2lYRcvMu52A3/75NmsaHWDxvDRbMS4sY7",
+	"v4c1dhLT/KcMEPl7waRk2SdsreZ1OthD2zfbXUwzRZFfDiCDA8jgADI4gAz+q0AGXyMe+9aorUMhqz83",
+	"OtoTqumcqsbGX1Ces+xfDYz2Z8N2Htb533qdexA6h3X6vUBZDiv1u8d8lO48rWOY4afNgPx4SJDzvwSj",
+	"0eUmPcZs5SGDbMgg+zfPIHOk+KEoh4X6vAv1mtW+/SZd0cLWZ5uDoziwyOGc8YOGybPrF0F73SGN4gc7",
+	"GgVvNlI1Hu771AZYpVRiYCsll9XcGcnJM/chhD2vou3n9JfNzAysJ7CpPXL4NxfLb67w26sk2qwxwt8l",
+	"ua2dFOcdMV3HCzIQbrFrnjHgdVplvNgWC9bdREgow5efUpL54xb+eyaumdCF3MT8VpXQajbfzNy8Py8e",
+	"i6q5wcCyuL+IDdsI0FEMTc+nxyNH9PPTBtnPj2Oz3B+IrzEY6wHEs6vAjPZVofSB8Hxb3EGXYWdosTZK",
+	"s9nOtgmew+bAE9+moy54Di+haoS/133ARmACPFbb8iQGQMA/JSBgL28ju8Hi/cIyZPE9XEkBU/ew2XCA",
+	"DQfYb3+AdZxzh2BfZVxpLlLd2Bp38LC9xjyV/2Yy47HwhZ9W1MzU5LMc6VuftOGimG1IAFF0owidN6KJ",
+	"PI2oIkWFOYyS0GUsBMghWm2VlT6gGHtgWZS5Uklv8lnGchrZEZfGOYGt2YFT9UER/IhJhLdC95E7GqxC",
+	"rVrOqvr6XlTzvMdLX9dasFkDGRNbInapIDRbmxGZCAScZkDFHFzYy1W4Gg4MTBGuo+SQVc7iZcwbrdgE",
+	"JnOYO4A43gyWvoCOzsn9rEjV/ehxBwKVllG3snnSZqacG9re0aXdusRQf/n3I4ldXVy59h8wuueiymLo",
+	"CS8rXVae25X9xEUEuVOnW7+99gX1nC42h+8zBFwN+aBDPui/PB/U4Bl2878ZphTi0xG5IHMG45wzZWBb",
+	"viNrRoEePgYKIazYYsFcDpKj30UySr5NRsljsNQmo+S7KOkEXTcVAy15qmcY8VIWUs/cDq6hfvxPs7LI",
+	"OZrOIcHMLBImVUkm62clkxYfQdU/IurIzJwks6Jkgsmeh2w9Z1nWeFwUHzhT0emUkqloAtiFJjmjSpNC",
+	"sLACO55d3sCaF8UHRWgj6aV7NFzTPJrS/vSayQ2R9IbgG64bd0Gx3YEtNM0ry38lowbs5SBIihYn4iLW",
+	"k3fMNapl5j4cGV9e3j9TBrXMUtDtRAZTKaQe433VLNWIqDLnGq5kRX1SbqnPHryzxeawf5pvYEawySJj",
+	"JVMg2BeK5YsvgCpmVq3fR8kXohApG8tTkU3WXyTvPkZhtnCDwIwjKaFIApLSNTNXLhfi5Q7RsXljbKLV",
+	"xy+BbkBHbgDPHW0jDPhxr7UsPrTp1QoE07r0Y+/yuJMLXalO12ymuG5Ijef0Nhkllyg7klHyohAsfp2G",
+	"ybNYjx9/PyJzpbSKa4aGAOM3Tnj6xbY7os7BxEDFUWhpqbPgPM5ac8GM1GAzVc0NwklPItGa3s6sQuKV",
+	"Qi70g9Odd/xSMriG7rs8EcH+WbZtayPVvdgtQxaM6koyyGQtS6Px6xXjkqAe61TjYPfDLpQ0OX/7bpQs",
+	"WVE7MN4msOEdzvD5/fug+x4FyLyxnd8Svk7WbvH/NRXnx/DioN8O+u2g3w767aDf/mb67aWWVQoHRQbR",
+	"Sj3IJbDnVAwqVSpGzFODoWDZ0Q0rwHtp4tK8kkVWpWiZ7vtmQ65wSlfJYbA17jjvGIk2QtNbXAtozCUP",
+	"mHRrl81m+f9nVYhxbuI8UllkFLOqZbagPfzWMDbuXQUZqB2Q6Gsi0LtukSipZMSsk0Ib+8YaPbkkxY0g",
+	"7/8PjON98ww3Gzn5iWdLhobrDxX8OZ4mfaqj2oJeh89HtdNApSu2pkeFXJLrIqXzKqdyYzF6XBR+dJ2T",
+	"d3fmarucbrANYsf4+Q1LV5eaph+682oa3TRLVzMFb/ab2xRfCqNFzXqDpV4zECl+n1tJlRH/LclcGGDD",
+	"yowJ9LXJv3H0HE+OHxxNJ7HjZ5TAwEWRF8vtV5eUara0nmvH1ykWs2ZQdYOZTP4bNp+B3GY3hQTX38/0",
+	"mtpsr56fcz6XVG5sPAdP8ZozWzLBJNUF0BDJqXkKfWm6nK2poEukbpoJ2yf62SzBl5Ku4eCYOYxAjMJR",
+	"2pwlJvo5tu3SQiw44jbElYuUSU0dVh7TLpFAjYiq1msHIGQyma1PoV7wBO8IFj1gsjO3gl33jeSZKCtt",
+	"/dh2zUeEHS2PyJUFITk3xLhKRuQKERzOPTXNb+asO58tqfnbNG/+DZraVQIKwFXC12XOWXb+UyGzV5Ip",
+	"1cw42yk6vRrgGdG3dFD84BMHVGjfGBkXRHDoRghO2G1ZKKbafoEHR6dHx3dxen3skQ64dzbDhhk2zJ9+",
+	"w7xZcZm9olJvnqCxpHdTtI8atz9CzqXZNXynDBeqIuXocV9wsWSylFwgf77bI5MUkuep2DQp+6OBtul8",
+	"nPmRt8/lJVdamms3vgOxQ4U0qAbmElxW85ynRFUL0GBy3jqGFzRl86L4cCSYjocPWyNXoPHYUP+jxrcH",
+	"KbD7BJH0RioYFLsgSqHktwyWIQAz+E0B17Sk6QcTi7KPeazmwEao3VbFDT6ZlRSJscVVWggF91QnTGNQ",
+	"F/ACMS9gKEuZUw0kcEEn843X2UaYPCCaCR4vBXsjK6X7+TJqA+UyG8P4N0R2mFSRe+zN8yf/Nf3Sd42j",
+	"UTVyCppCt8WxDVt22LK/3Zbtx64eDLKDQfZ3bpC1e2F31KiT1gb6wE7Afu3E0g4YANsVvHy4eSHsCp+4",
+	"Ad0wyUxBTdhLB9sXth/S/XkZ/z7izaTkjR3s0tH1dPbEZzD2uJUypinPDzTfXfg3gxTJsSpZyhc8JVyY",
+	"sTdERD3Mz51s+rjOMXXoFHShmSQAj7BW/+qMU7ccM3whwubmMY6dcEHWPM95BO3h9PjoLBID+XtJaHVe",
+	"k0swpRre+5Yqnl5UOoK3io8MZDat9IoJ7dIyAScD4zl5Xa9DZFgxHsiFllo8yaGFej3Ag2swOhXThet0",
+	"zqhk8ju3jq8uLp++eZl0fMz4M7n3yinJF80heTf+GyhMRp7episqlgwdAy9LZiA01Jfk+tSULju6Ehcm",
+	"9JGZHwwStjb2ZtQqJPhQeGbah3aYWFEs6eXo6N3cR1fCTOCcfIvTIdenR+DDzo9+LekGVOiPcOmvHxpN",
+	"sn569Ku/W3+8Eg0i4jd9VPy/FZOb+PpZkpnZlRRxY6ki/4AvSElBMMIOhcV8CrefSxMSHoCGHF2Jv8FX",
+	"8Mrl5dN6kcFCAIK+UrpYeycWlQwDY1RVloXU5grj4ikCEsVpsw9ROMwLJ5A4+0eC86vJQ0v+VwYGOEzD",
+	"WBSufrPFsHM+CjYnWGvvwt7gyKUZdGJlvw83WHK9quZYApjKdMU1AwuXvK+u0/ENm4/9FbATFnFBbtjc",
+	"oMj5VEuq3Z1R4dPSI4CXsrhG8HVzGmChFS/CTfT5+ZUYG+Qqe2DD3zgLLBWHTzEDfUlMfhjQP2fXLIdH",
+	"z1zaDfTWSLpR5nG7yCb8ihWgcGvUNrkrcSX+4z8IFKP6bzMOLpbwI5b2gZ8rxRRRbE1hf7rBGgzOzHGH",
+	"Iusq17zMWfgCyhO25Eydm27+w/VBLs2jDQzrP/8TEhdegQJbD+E///OcvL9/Pb3/ntwrJV+De8iUe/rS",
+	"fGNiO9pfXLx6NrY/nZPr6XvLzuSeK7LDr5ltwBV3QNDpVjPBOt+/FtlRyBtH19P/Aq/ee3IPtpI/pIta",
+	"MLVn+6xefOj7AtEFzCmlrPeWNcbuxw1qLIzDJilY4sKaZNCSfb3WFIygNLvXIaLVZX7M07xYwrffSkY/",
+	"IHvZb+zBQ9b0Z9jBtisuUomXCMspTjZ3eaQhopqHzLkhefiGAkJ/2gFAxhEpbhrvkfytORDDRAp+ji+K",
+	"0lRkVAbtW/mIM3r/93EIXT5+idJCnRNRIG72e/vSdyCe66dPnr74/9yjv19ejl/Jwu7GczL9mqyLjH2D",
+	"4Hzmpd4ot3PiUGdPpmcnDyaTyddu4JfV3NhhlWmjJxrynASBmsREY5oPXtu4C/+iCeQYmyiKMRiVxxhX",
+	"YX8xX3WDx86JCQb75t6XI4Iu8HJVCIZ/BqFh39z78j0eCjlPmcWustL9x2dvOnIca3riCQcu5Pv2I3Uf",
+	"3kUEDJ3HD4aLV8+CMnkOf8LW8aElT86Tk6PJ0QnWltAr1KpAClFbI+7+r+5fz7KP8DBajPQ105Kza6bC",
+	"TE9AVCUOkzzf2JIcmrlCC3g39kLkWZacJ98zfVE/86e8Ss7fbqkmCGaASjE86FHptrlBR+TZwhzpRlqw",
+	"bOSWH/OJrqdHV+LSH/e2NQVy9KpdotAd30GNWlysQIY5tYcG8cDuW6cpX0+jOnAs1rMS/B9VzLQTUK8e",
+	"4dnZhD08nUzG7PjRfHw6zU7H9Kvpg/Hp6YMHZ2enp4Dy5uYAC13PoF7fJNTFza2tnlB9max4BIPn47v6",
+	"noJMdDyZOOXFxhOFZwycJ4Ep0dq64J+aZTMaFCgE9xmVG7yl2eeeApbTEhtRhJ3YRzOe7U+VoGdtrvhn",
+	"48l0PD17M52cn0zOp2f/E0RvYVLmeULPHk3pg+x0Ml+cHk9OJ6d0Mp1+dXKSLuZfzaePJtmD4/TB2Xwx",
+	"macZPTmen301P/7qq+wRzR4tpqcPWNAioLEixOWDUZJKRvtHMpnASByoHuznM4XLBnSw9XuCJO9m2Odb",
+	"Z09sIThTJKE3+yVoE0v5eon/8MY7mjfhb2tDXa+JLePXLbNaYHALLU3n9k7vLF/WYPURomOdHmLYoZXv",
+	"Bb8V6OMIc7zexqe9UlrNRKFnNhCZZY15219dhLxiekRUEQROX3PFtXtcmkOMZc15oNYOu8HGJyYX9Vbb",
+	"FhvoA+/MxnMhcm9roPWTyVfH8SMPgojjM05VOauEogsHtN2YsAUadr4RjG4mX5j3x+b9L8CfytMVCE5G",
+	"tXLTRrXeZtxy8bNxwdbY3p2FDSnyuKZIf0RkLz26B/jXpE6ysD+1Z/E1QVPaGHQnpQupCCRgsC86lIsv",
+	"XB2e2TusT2o/EvTZ188OtaSfFWzVHrvpG3wQCYFv7QFvO7ULhjWe7DFLyuKGyUWVe4NCkwGcvbuHBaLh",
+	"rX76C5orthcNt0bE9pMTVu0TSPcYaf/SrMZTQyXZQ0TvlLS/c1WYugyYnxGuYl009iBS7oj7vQtRbajw",
+	"NgoyXLxv6DydHp98jffab+5/be4c7Gvyg9YlJB99TS7pmkG+8TeQzfMO5rAlI+xtO12rN8OqtfPwod18",
+	"/elXTfEAS99MtzIkehckOr1tpDQZKji5bkiQNJKXbM6Sy0iCD8Jlg8hyl+gTy7wxHfhcGyf9gyQaM8SP",
+	"Ue2+jtBsHpCxqMymS6MRIfk2DO1qxlKFAVEYs1RHJb1txhol7zyhXiy5uG3dR47Pjk6Sj6NGT6GjfWtH",
+	"ZnmDHr4vimVu7z/YAKoQMQqFoRBNIvk1eNuMCAgDAN4FfnvbaVJ755Ml/qLp0oZQYKqPd6G/TW5ubo6i",
+	"77xreMTf1g505xNq3gv72rm/1HR5/2f1v3n2zffjv//9739HmeHd1Y4bnQO6lnX2lbpwgg0GaWpKdWjF",
+	"lKBp3zvVDAnuqS9rYH+S9oeL9Mu3ljtx2uf1C9g3ttIfR0mzZIsr5ecrhtel9PxP7Qp3/kGz8lz9sy/7",
+	"hpuyLq/WrBcGIfZMpyt05MzWKjk/ObWYFeYGZF2P5pLklqXF/qYu7fnEVYlMFAPTbjIymzu3Qhl+m2E9",
+	"+mTU+HNmkx2WTM98Pfl5pXUhZprdauuWmnlVH5nd2IXyQrDg+Ogb29SPDQSJaA6tpEpB6WE/uDv0DVKf",
+	"ZUziTcnano3kt1vxnafq27rav981Qf7dfU89JlJvhK8vqrfjm5ubMbQ1rmSOnGQ8dzB40zzWGUS8ENuS",
+	"FUX/iNyn3W42r+I8Gkdwvbh2ac5tiX9RWKNeSP+tE3Okv/O8aKULdzMGXldMWtZxM/5b/ZOdc/BSz9Rx",
+	"mWHwrpFXlh+M03+WM7HUq+T8oW+zrF/oadO/0UvQaUDQVy8v4xR9NzL8OkMe9H7tkIM8VT2dwin7cbxr",
+	"d9dsW81qmTOtHZsHMGsqGdqFaD7zI+nMHUCoUiUXM+NkcrIJfm5tQveoZj8ucFRs5sZj32iw5d48GBvu",
+	"NgbrclObe7q8sYMX/AGHi+urSdXkaVyIXl6+MX4ja/tYIUYQ4RBfbRxhJtMt5x8YoeTx5evviGsmdp6N",
+	"mt178jdI0DxhzRsE6UWu/A9XiRtT+K3p/Gs0g2JZDaHHvolCEsFuxgGtYvaKA7jFbL56a+1kFr8HGlha",
+	"x7aWLC4E/GRwA6bY5uoYy4GsTpLzs1GyOkV0p9UZMufqQXI+CT4uKo22jfNf3U92xVc8zyQT3T/Qr4gd",
+	"lIXiZtDHI8NeGFOOWx93rXnzOHxz6t8E7Gyokh6+Og1fnfhXn5p9QWy4eEP7eufqatXqC3hKz9A/ID7Y",
+	"qMsmKt/DFiqkffFtjcZolskGyyQvCk2+g4irxMMf+jZbCLPnp5PTtqY5lxh4EOxuq7SbvuyKu866ETV7",
+	"9Dpp92k/bXb6rgtQOD0zdJp11dm8EMuZAwcGzY03d7qmH5gipwFStS6IBC85iQm0UvLU5enaD1oA28n2",
+	"zwLY7FMHdv02hDze9v34eHJ82qbayWTaplv4aZFnY9f9x1G0J7hEfKbeGp8e1l23p+PYbefQ3vbqabpn",
+	"T+Y26fg4wEaMyHy/8V/5XvdfJcu9qljo2enktMGyBoVakePJhMyr1kkEdiUTVwl3tHMTW0GusJh6/RBz",
+	"dWLbccs0LipdrQXG2srOXOAeLZj0NAMnpXkzmE1GxTJHh4NIV4VsTEoUvmy+8WSbY4uuYfBqxcsSwjQS",
+	"X8GJLlkwhT1X4tK2s3Up/sP1lhif1hKqF9XHk3doQdGoRVFoJs3RZByD8E9Br/nS8uGjNoDk8YktYI6N",
+	"uVrtW2QHIn+0L5ydtAjlov65MvWwFYYC8jyv6ig306oioYHxCISSZAvIh7PXGfhHkrGtVMrY/SRuQHCq",
+	"FRyLNiapZTiw+ot7wdc377cFFCUTs6WkJQaKOW9360T1KuANmyuu8bptEA1N8DVoK7Bk4OVAL+wN1xpu",
+	"+VRmZjEkXqetwxEsB2t+y7JZ6MNEe6Rby4m9rtZ/2jD+t78mlpvR2bZM/IUYVCl+3aTt+f37pu5kY/vM",
+	"qYAdVVoS2A/9kWfwcVyh4Uato1bV3lapXlOZN7lKzhYndDxNr5J2GV2jN3QL3ro6tbYsbb3kO6rFmgqs",
+	"voCq44Upqd8De5GLxk9hKzlnIjRpCyxysfzaFaz0dUUgJ6CQUM53Dr/rFVsnTRNalH1Tpe4rrtlRqqz3",
+	"LOoZbdS29RPxNWDruRybyqEwjRW9BklrK4dCAI8pHeocaSnF4KGcbsA4plZ8oVV7yJYf7q+YLI5+LoF/",
+	"3E8BW6AJPSzHevrweHqytfLpcU9d0unD05N4/dAHgIzeW+fz7bsd1TX3IX+0/uV0+vCrYwMQkZqivLXV",
+	"bHp81koc6iLKOzD34yaY+zSC3T7tx1d/26vhfqI6nWa1Li2Yvn+DsBNHP6uognvctgq38KXt2Bx8c3ge",
+	"+jiNYEv2i/XGwpi4lIWzF4/u0Gvcah32+LO6T8sSJz7yCN97dcdu9+xuO60bp/NJcGYE2MdWIoYAxlOH",
+	"4OvRd0N02x6+t8+PbqFaNYbeOwSZGQKlYOCCC7SpkVSaCRfW9GFPv23EDYBK3iYv5ZIK/ostHALEdtg0",
+	"b5MLqXmas12YMiCYQUIYXBk/0BDopTlUCIrE3fIXKuCcZo0R2V6NHOs51IP8hDpMZ/ucARsKw5574oi+",
+	"5/qHak5WxZoZ9Gb31qeEEU13hhGdnZ/Gwoi+mp8sHmaP2HE6pWeLB/OH7DT7Kn1ET+bHiyk7y07Th/NH",
+	"9KvFA/z3yfyYThcT9ih7mH41f0DPOlFEZ8cnp19tDyM664YRnbbDiFp+iunDswdmxW2Fnh1m0tojWhtK",
+	"nTHwjlbSzj6Nm46OjenooTEdTY+N7ejM2I5OjO1oegdzy/FZ65Rw9paoPWLSNkhsv0pMj+u7xDS4TJw2",
+	"LxMnD0eJ4hmbUxm5WUy/Ous5L08fflVvMMP+5+Q5018oMq94bgMQVkyyPfdbnVZgUhXqMMHW/g+30c4Y",
+	"wvYO+nXP/KjmjuqAV/1wMT4+e4C1FBohlL/U4SyNUEp2Mp+kp6fHjx4u0mk6PX1EF/PFafrw0aMHi/mj",
+	"49Pjryg7nbLTB6eP5o9OTlN6+ujs0aPp/KuHZ8fzh2dn24Zo9ui22t7toYXF2INq2Cenoy76ZjdRMhQD",
+	"+5KzFgudmFu7nMS/0sgxPVM92WtOqLSYox2p2A9X2RzGf3OIm8GAeGPqsFgDBiOGYJZsIbnJBLAhgaMh",
+	"w3rIsP59Z1jHEnYbsbGfVOP6p9UmlD/SFY7CouKKqA+8LOPFV4PAma60gJbqjCF8c0ToHMNBfCXOVp9H",
+	"kGgTRZkjkulKCkUo8WB1oy3IJvBeFyXlSsCtfGv1CKjI0y1GcXQltmIRufSnpiiXxmFYIp6fLcVlaXZ4",
+	"ZVDX9+5i2TXyC3ozyrxaLlGq+GF9YJsaqMX/2nS41q03o2gaUmNFJU01k8S904TryxhGFxjD7pwtCskI",
+	"t5XA4OJvnuqC/O3Nd+OHaDJxRWw6N4o6fqeT/e36dq+4mYUZSzabaQRszXLF4B3qDZBu4ax1uV4btNLM",
+	"fubRA67WUjuEceZdY8i2re8m0v59twOVYkujmCYYbOEGECEIocotiWpL90scwF+eXcaPMk0P7N7E0iPN",
+	"7YewGqBajoHRr5vju/Oy1DFZvYV9erkDHoDSZUrKkjWVVsx4TnESBN5S4QBJVqCfhS4lYw7cwu+dGBpu",
+	"DQvRlshMssbnreJ5ddFbGEApWcowGOtr8h6z8Vj2nnBld5UtV+daWlZo7K2h4XG6CwzpNkWaIX1LkEr4",
+	"7WSgnGDi720sLjZ/w0VW3Kjx9Pjs2Lgemp9grPPKqA4KVl+HJ3YjPm6UzIu1jbJLRomdBLo7sL/kXbj6",
+	"rU+3y02/ST29Aw6JCdPWBbiTocXXDA42EVTVcinRXnX3xNVULpnGemVb8Bl8MOABkPr+St7SatuRhJ30",
+	"+kqWhcFGYYi6DA2FjiHDWKO63rKVpktZVCVyh7nZ27Rwmzx/Iw1iPheEmiadVw/4Ji2qPCPzEGPlSmxT",
+	"zUPMxUMQEm1oSnvS/8NkQaD+f0ZchIYXStHpf03q2EMzXzd/mDDeuzEVt6SSapZvcDbbh2b8QDXYSxgh",
+	"WZWBeQTM0AaI2McVshuVM60NYhvdWD28H1wjiLvsQm0byuLkm9wCm9oQqRAjUIZXcDo0Y4BAnDTjNvdH",
+	"/ArDOWOJj5av9mMpeKtOLJ1vyF/oNb10duC7YHJFA0s7QJt4HdQb4t+pDwD0eyvi20HcSRh++2h/WweO",
+	"Q9l4+O/LkolnT8jjGtznMPTuWIU3Z2Nu7fVChAB9bc7v35XOyNdXSR4bN0uiiC5Gn1ihMQhgrTfNPpGs",
+	"BqCBSn0fn1n7NHDr/TKnPL5fnLUyDqw6spdjg3kBTVHJqAplyMiHvcP0zRbZYn1ohkCGAIBsTXkeRx7C",
+	"uNnOzmG3hrJmf3IYoygqxAqAITVqwVDJ6Rgbylk234yCH0ZY7lAhoCEwr1XdDHatiSbx0dmtLOmnMGRC",
+	"s6wPxBWyWVx8767azmEs8K53e2sJlVRrJkWTsm/p+JfJ+NG7/4pbq5zOECt94/gwwhyI+I73CsMjDRax",
+	"qelw4Frh1Tj4+xZ7L3HVayb7zsjROg3Dru+C54xUpUnf8E8RL5KVGrYrphg63CAUK0ZFNGA4JCuMUudw",
+	"JtiVGOxrg33t949g+LnUxp1KoHN71SeayfJAL1iMmmGyQlRngnFkXAFbK79RaxyYO+pCMT/fr4cqBgCc",
+	"RcxDe+Lvfezip/gQLSjqID2zJnKkUVRQ0EdpUZaS0X5rcRjZQh9mPyAmvtkAnlQk99hVRkgT6grwnE1I",
+	"yaQpml/fkHaxXJ1kEim9jE/g1GdKGTb+faqKkTyTX/dVvVqm3LAAUfhqAwIjMEa0ki6SLaWi+ounB0dO",
+	"XzOH6gTDsTwcy7/zY7mdmRQV6IiSyNE11EjWukGtG+3vYaC8IFRoDtfGJYi5FgxjoPLH8p9iV4N/mu7Q",
+	"SbbqP9+0MmVH8L6LWKjRKR6ojewjgRqBKR2FBR4S0Tr+FjaYctvsezCLW2FArTq0090XyNXxHu+c7PHO",
+	"6R7vnO3xzoNd72yjRJAO1yKFT47rLklpsDaJe8dxac2dttX+m55PsOvUZASAmHb7tmAbeAssbJt9FPaw",
+	"P6L0C9OchRB9YQTObk3NpOxFoI+hFTvke1O8v6+mRK9kUS1X5IH54cGXYeWeBwHvTmOrWmcFbpESKEfC",
+	"Aoso7Q+WEibjpW9e8NRolTcrrpkqKULn5jktVbMOHUDyIRqn0lRqlu0U9YaidgDBnN8dVA6h48oG1iOl",
+	"LOY5WytvlPYgrKupGtnV+rlal2pE2LrUG/R6gWnLHgl+AwzmikEv+gOGAzWDWntLZYUozI1RuyjYrtHZ",
+	"hcU2d0s7SLb/ituEbN5DyelG2m4p12gyNCIOdL2yVdcsJmnTgU4okcxcpLF6rVqZuBGmbxgTLghFRZWm",
+	"rTUIdu04ky+ybbwwZxytK35LFaGCuEhj584PqdpgfJ8a4V6JuwfDLJXOFgPsfPMGgTeQVvw6PAOCFfts",
+	"JXXawdT9PMVFMPvdPNUIyO6oKNCE8WWnVICPGpPAgRsq7bPDyZxmUFYfga062bbWTuMFhqUdyM6CC02o",
+	"Bg+mpEs8x4O4AH+2e8s6JJH9JlZ1nH/AKT41Pi+K0tWpn2XFjXAlkOMZ9EFmcjet12TQzDKuXAbNu897",
+	"7PlZeMl1YB6/YDdx70+Q1N8JR3Zpun3mLCNEmMjQdb6HuSoAA+jn8npGLhRjJ6cbWIEuSHO7oTpABfZG",
+	"HU7Uz3d1gn23dWu6e174egFotvMTwBi/PaiyVSi5SbjN5ToxSfF1/FyvgOounYWxZNnOsbVOb2ixOdxR",
+	"TaC9DvCwNVy0kCdGAc/FWvv3OUX21tJw395ZR4vfabzjGyfb8Xsnn1AfqbvY/QqbW4997jmdTKC+yO3t",
+	"27xPYuBxSEomzalkeiP3XHBjnVE0cpFqI2LTioAjTCLSlyOSsZTbUo45FRlEQXr39YhcvH52QWSR2wog",
+	"UOe3EMaJgT9w67NpBgncDUChQ9BDzEv7aAexThxIQ1vZbEA29IpBkCyNgFf/odtmO50ZfVG1vsh82g28",
+	"blltGmekA4+IRDAHzUdqfWrrEvIRsMEbFrih7uVTwSi6p6RHp+hkGNgaJ4y4as4exL8VM9x3nEWbfe4a",
+	"uwd8XJRmZ9p99KXRhW3zrspKUxnJ2PjJ0y2CZzfLhO1+BpW6R+m9fPqytqk4R6WDWPFKKWzMwWAyGEx+",
+	"/46kD2wDbpxYOKzQkteB/MgF7m0r4fYPPGii1fSd7dulMEaCku+hEVJvuDplBx1aLngLhvy+WJ6/J6Vk",
+	"C34bs3zXqAatw8SDbLvJmzd9qsOIwF1BjlPaMg3HMHX2J1IbfeeOZHpjmiGPqcz2JJTteSu1PHjRruMw",
+	"BmJUMzViwHyTsWuesjH+MSJccM1pPlYpzdk3e5YR7QARtaL5vYMPDhHlanAXMoiN1gWQg5rXLvFg/Npl",
+	"4i0KoysqY9nIjNvQOgy7MR01DFHnRMF/q1EA7aNGxNQxtZXi8mrJhY9RUhbip6i05MuV3ivhoa/3INJI",
+	"+dhF8zfOSGKJOjTyGLpE3KPbe/f83XMaetSnzn0+TAAzLxGs+sBE6nLBTIxLzT2qFcZe74F2EoFZlGQU",
+	"wEvB1H/b2tctRKpOVOzaVwdcs4zTesWhdkRVon0J8wiAAe6gn7fgr1qMaHLBg3cIU5qvqWaIeRAk58C9",
+	"pip9RperYuQLQzrh4fN8qNjUFeW7xfMdDFc7FzBd1cdf1u2mq7lH2q1RvcJooSbAV2fFDeJXRJSy25LL",
+	"HjGLBW7XRYYBaZE3YisSgoh1zhk7L5tjiFLG2kvdZ0Ael473dFvGXBearEdK+2aaGm1rCI1NZzHOurcQ",
+	"LvS2nb+nHgzNBMpwwxIbw9hKRrvQ1XrxytyPQbFoWFa5dLWAZnW9nubP2AFmFtUrOnMMG7RsWNIysG8F",
+	"tNmo5HEAah3ly27MjJg3MPXQZBG4pQOyBbRyUHIWh23Fl6vPbWK2PcbgLGrYsY4xEa39BqFK+WZMFVWn",
+	"8u6rIu0jgkPwt22gIujw8+zvEEVIAWKRa9XZdWFG8mh3yvbuY7MfkW6Lewc/8oBU1CLqFdIC6jUiMXf0",
+	"H8O8i9uNlCWb+cRWlnJ2MhWisezXZYilt1+P8MUdOuzF6OvR0dw9H7avUxh9NojaiBSLJTI0CUJv31wl",
+	"6yKrcnaVhFy8y4rVtX1uQQvsnuL+YWO4Jue0LPONu2BbrdpCRX7KACNYhHfYWs18+mAPbd9sd1GDIoBP",
+	"W7KVDc6Dk6s4+gBpqC81GREXD0xNbkjK9mHZhmv8vBZwVc1972gId38Rmywe2KNRzBjYLdMYwm6FmJHH",
+	"MbLvH/rQGIxVO1D5LNBPY2oCHRQQseUMugw7w21icVzQqhgiLhmf0IhAPSvICYOXEBUBf6/7IFwRJuCY",
+	"3GYFHEIw/pQhGL283YAyAxY/TFz05WwYeND4iQaMGpxjZgjwQUNR6LKRhTjdv1H8YEejLezSDo9aE0BK",
+	"JdodKQk2LnnmPkTQumj7Of1lMzMD67l6t0cO/+Zi+c0VfnuVRJt14Kq/HszH7RRtf33pXlfQOoHaNaTO",
+	"F8kooVXGi23Wiq7HBAll3PefkjP02YPZM640F6lubI07HOt99tqfVtRM0bxwpG91szA02v1gFYmiG0Xo",
+	"vGG78MShihQVuuYkocuYwcGj324Tkh6/CHtgWZSrGsC5kfQz0DawNTtwqj4ogh8xiYE8N5RrfybYeI2G",
+	"fhICp2RFNc977gRmnZDTjT0wY2ILQBAVhGZrMyJz38FpBlTMQWFersLVcGFPinAdJYfBDY6lcTZasXZ5",
+	"c4o7Xzxveo4c9nBWpOp+9JzziMSdSdonbWbKuaHtHRXolsOG+pAlP5JoLEsbCLk92r9cvnwxfv5kRH50",
+	"YMNosHv95DtKmNAcjf4mFK9ZIqLPUGuxjzuWdCoVI+Yp9tCHh8ybiJxvk1eI76Z3YSiDP8LgJx+G7EFj",
+	"1vaN0PQWZwuNuYuECQ5wli0rj2s06RCuWWYLGpW7LVGwdxrGMxhH/fHXLs0DJ4toLWbRFR59GyuSuCRQ",
+	"Q/L9/4FxvG/q5xbu+icE7U5GifpQwZ/jadInu9WWkAl8PqrPcoNcCwUXyHWR0nmVU7mxjiEi2bq4Zll0",
+	"nQ9ZwdaesMvpBtsg9j5uTIvk22XdpUXoaKJy9FTJ2HYCOgjgXwOcLocF/Bl9Ch8/jmKYkYor4vtz3uRF",
+	"lec4++PJcSOM6NcGGgyweWAcr1GI4co78xA5NRr4hXto0tA+CQT8OBm5ROCZ0qx0lWyDvkeJc0OAOw/n",
+	"CKFgcHOHPiw3LCVTKjl/eFYvRcLFzD/56ADKoOHSWjjrOX1nHzXQyD4d3rw5s2b/2+d13JrY8baJhX93",
+	"lwqYgwvi3/iUWU22rZcLG9s2r+mkOa8H/fP6nCjZjSF3XB/mqYe1J/haKBG6c+x0sWXS/dZ7eE50QepP",
+	"kvjJ4te2JcDsEwdvYPjqENy3rtwKF+Hdp4kkpXmeN3jv4yiBEPg7SCNYbVHomUl6jXO5Ty4IedzDcCQv",
+	"inqJ8bX6ULOgZBl59iSpK5ZEOg5L2ET77ZYxgUVWmq7Lbl2BCdYVAArCraJvflA0do+5QRO986pxHlQw",
+	"wVav4eQ6nd5pYlv2cACP0mIfrzoZrZK4N2O7bptBK22ktO7r4xp7H5fp3b0d9WxpuZnRhWZyyy3NX8bQ",
+	"no3fwElzD0JIJIZi8jXXpjf1ZdK/S/c0eUVbCNZqP1T7fTa6tyrUfAIbfDo5cIMvCjnHi+XM2FtbZ7N7",
+	"aq2xhlKffDjXe+cN4lpLCGzJmEA0GdOR9ZT4dAdvFA+2UGfs9tEskBM9rZXW5uSbsNlEs9ZWOwmOSGOb",
+	"tqc+ohWFMU410Z6Zh95T8ek0O+7QzAdk+CQsCylhYJQarpKAYu1xdwn2xhkL3OgNDCr0MIcn0sTVdWl1",
+	"DAdejFbQ2qwSmN4Ee7tJLLwHBE8/A7UmHWoFiLaN6bisORQj5MQICEK1ZmsTaeDo1plDl3DfWc+Fs7qU",
+	"WNEyjZWW7RKvh3Td1LQG7V7HzDiBNeHuJDxpkPCJbfycQGnRa6rZ/YA2r19++/LN5ezJs8uL589f/vT0",
+	"SYw4TsfvKXSKbcJ+rKcK8X+1oWd/qn1G1fW3PyY1wjB2KFgDfnftpi4L0y+4t5ZyhHG8EhdPnrx+enk5",
+	"e/HyzazToP3c2qFRMlJiV4AUkkBKWE4E0zeF/HAlemc0+2wneXdddzqWYlDV9/iC2MNunrNtZ3mocVu2",
+	"+URlO9iyjfL4r6hiurio9ArLZr8bhYW9WHDrMd5GGDNdmmpj9knyDhq9fz297969/6v717Ps4312zWyk",
+	"1zKWfHOJR+v4kglNnuKrhInMJPiiDsRojkpIPRR3XyBVCSqKwvoWPPhOacnoWpEczL32JWu416tIQ0fI",
+	"RbAPUQt5lpnJuxmaYWE0qKRrppk05blapH71zGXLAMtWilmUUq7cqX5Eni1QsNsKHiwbEQvFjmx+PT26",
+	"EpdVWRZSs8y1ps7J9bQFDXsNOgqHbm2al6/xf/Hq2fi/PRRBLWdsP+5bx13X0yhnxYzpleD/qGKZJ0HB",
+	"NxwSJGrUA6p5IQnNdsbYWY9vh6jrDunVxeXTNy8JlMSDAbkk2QI94YUkl5dPg7PNje0fFZObenAOp6p/",
+	"XO1xYOExo9kgUx9P2vosQiIj148NI7YVWiOd8I3maQlDxofEPPTnY4J/nzvQmCsB5uZz8utVeGBcJefk",
+	"ai/99ioZkSsrasxXrmF84G8D5lns8naVfLwSV8IOy+2jYFxKM/t5w65lOvDvJ+fk+Ax+scLXfBE1tx0d",
+	"He05urPW6JCin59kRqKa300X+HNbD7tKOvPrFvPfb2Ynlu6h1WdWi9cmH7kXCHPS6zfhpcmfi5e2jg6u",
+	"HjA4iCLtDu5s0hncK/NB4yq0/9getsYGA5l5O390hBjf6pa5O8QHOERz0uMPv141IHBMIwhq48aoczuX",
+	"plvkKvm4zxymB61+y87aHf9X3fWv3RH4zd7UnR4fTl3oYQt1H0Wo24wMgh+nOAd22/794X4EPW0NOzbi",
+	"z7TP66b3o+iZk14ft52vHRUWhJk5R00JnZbu1qfS/l846Pv12i1qZaDjvnZv7VJyPaRSVMd9bausIaiJ",
+	"pRrJWFYZwxfLkDkJVm5aGCzN2jPTLiBTCBcroQm9EjC6I/KKKtP8e8Fu9SytpCrke2zNvqzIe/drI+zC",
+	"5BpiqrtgR8Th9rArgWMysXrShwLUUZPG4Wy9zeHVh4rMnLk71Orn1lkyaNW7tOp6hHvalv6JavjLksL4",
+	"DW/ZeoImtrPJirbcUSnZNeZMu3CSiFJuPkm2SYlRNzEIvVptmAqDWgRj6ukLrevxJT2bBM6y48lkOxxl",
+	"hDIQCGw6t4PBleXKBU3HxmMf1cM5LG5wn1FgPA9XIZhJz2D8w+5wgg3isUV8IllSl1X2kCSHjtUFCec1",
+	"kFgDk8jMIeLdiE2kiSlUz8Yv79mjR+HyTiZ3W2BdWOTVQukRinqDK0IVG3OhmFAcQtDyTTOr+ebmKKy4",
+	"Hp+DDfr9lJvpTk8Ll0rH4h/gd1eMyeO/eNutq9z9q2OYQH5Klptq3QZsKPmhWLdcUJapewvQ308+joKW",
+	"G/zWbt3GEqkDeyjdZ6anALMsQCnzX5ZUasGkX7JCLu9njObqPq10tRYN0CkQFQY+7G2I9nVIYw3D48nk",
+	"uD2TviaSj+88NlJd3s9TLUFWKSQ6S0RRlEzYbYoWy+Q8mc1zijH4jrqmI4KNewp7aXTAuNDlwoWHPoPo",
+	"boGdmPPISGUoLx+cISDlMX81DB8+Pv34sZ3O4ZVnr5U19+33T9+QHarc/8YMLdcQQIYcPwjW4RuD1Ffv",
+	"kKcNOE4rqUCpMi9GtorzTaAEM2/5pbIi06+UKGpEiIij3azOyzwjluR7LM5cguVrDOMJZc99G2q5dY0W",
+	"NFf1Ip1NWksy/bjVxfE50EetxL9T6ovN0FHM2Jcb58whaTGuwLODSQtZsNVH+Ij0xggNYIkDWOLvBizR",
+	"yapo0Kx5GGC1FamJsUt9mqe58HLMQw0uTp9BrbRSM46Zj6A7RLLcOArUoYU2D/AA+lm6K7TLNdtPAAXM",
+	"4w7kdpffAgAIHDJInxC1EQ5zS9nPg/o4Igua59DXHOrH6wIzb8Mqh3xt8zcMGD6if9jY6Z25TfvmRN0d",
+	"Z9Jeq/yFxqLpSxZ2tA2aJTwJW0Ug/LkYK2NiD8nd11Vkw1auxfbyBw3VKBK1qooaG84beUzNqcJiGNlN",
+	"mlOr3kcXa1u21ovW+LH+s8uLWvBcM6kITWWhFKF5jp3skb0VLqC7oYfjGNVU7y5f53tnZvJr+G4fz3nr",
+	"voOxqYdeqFwwk8E8VPEgLG/sc29FQzjfGE8RWVcKA4OcWnOGW+1kMiFBmnkrlqpuuI536evdB4V2gzon",
+	"e0arum7tdu3OGA4+n7QRmSs8d/OkPlzszStSSIu2ZaGdWvM0e70VKWang53yoM7m3efn4Focl9VljOqp",
+	"/thOWTLvxJd2xcgXlcy/qGvNus+CSfb0Gs73daOzIHPqrnMdAnT/yAG639LM6Z9kTMLNifB13io/hOUP",
+	"YfnDrv9jh+Wf3UG3sVY2Exw/86sanvfmFRc/3wmcrrfCq5xRxQgGNwPuAMmpZhJde3ZLAKoyKZlUXGll",
+	"kNYpwgKgY6+hDcQG1pQBpBLstjR2AcMx9ora2TRne6sF0B1PIfiHXlOedwPJL80LRLN1WUgqeb4h4cu9",
+	"yoFtGQ50LO+2LIAXIc5CM0FFCg7gNv24gOIo7IasuagM3pUjUGygIXku6+76h9oi0skgWf70kiW+3Q8K",
+	"OX7OVW2GUK1oiN3Bx78gPcpC6SiSE1bwpO764ds9Im/CqGAu0rzKmDq/EuNGaS8L6Q6BUGJM6vRwwm61",
+	"pP6BKwpoQLnIvR+m4x8efAlPIOKh7ueeE1T3ndHjfmgoNl/4qtVh552oChPlxMyt6E8TT/HOXPSZ0t8W",
+	"2ebA48vE4dzOFNctMf3YPCE3bA4PQ/bz8jm40DdAwJwDy3IjLsG7UWIgwKznw7zRQgVzP5tVdon15jfL",
+	"kLO63Gjzd1NYw3nOxIeZBeNKaI62L5vVc/5g8rHtBlpyvarm6HWGjcSg6giTKYuQ5enYPST7kCUy5U+d",
+	"m5/I6dnHHnfuWK2K0k9HsBs1s8vYnMwLdqP2XeDGTKzv6+5TsQ34uZx0FwWGfbRJi/WcC6oL6eejOMyx",
+	"a1e5xN+tK+Kfuyoft/jVtx/4waiaD1obKhAKnvhRv2BBZCW8e5CnLXg+WmU9+EHBZu0CwVfLJZ7+/iXX",
+	"kcXCHxFdLM0IapS0+t0V25CMlUyAsfXoSvy0YsKbX9k1kxv0liiN/iP3IXSgvoazz5RdzeFYhN+IwCi9",
+	"v4kPAgBebEEgiorEz0adxVGcTiYErvevrUhv1r5b09vnTCzhAH5wCgeGhvMnOU/+31s6/uUd/N9k/Gj2",
+	"7j//V1RforfPTEtnk5apfJSYcDb73DJIg+mC5Yzh3QSriZ8Zf0STJWJr2BGnB7CN6agL6GiN5yqOwY9o",
+	"uiMCyGshKruHssoLmqmviaLrAIdLMW1Qe0qamgRCjWCrVnGq9b8eZL1wq+5PSfMdyb0yEXd086UoJJvZ",
+	"lE59qxt9RKn4XQu+S2SWmlwrq8OxayYguU+yPlCvI4KRVMYjdwWFEoV2CJuIFobnGcLcK6YJ119bqE/z",
+	"BVkyTSg5nZwcXcVrznfF2f60s9/60tGhMtrbkSt1tTcLul6ggpCrfLG9q/CgDzoKIz7avfF0VcepmWUK",
+	"AErNE66AxkfkmWgGtEjmHZUWZR6rmFyJtBDWt7vB5adwRx/XaYP1jUYVTVQ9PHutIa64ZtKAK5o1dIpf",
+	"MJvASQh6TRQz0R2qAUFOJl0DiDF52rfhply7TnxE4Ekj4PNsP5DBqJv/jY0S0IWT7+SetVpAVqIq8krj",
+	"G2pkqhnwa4bFjtQIKdosy/plq9JR99TtBBIE4n46mdh5uV9O9nGgxh1mzSjij53ww4NxpdKUlZr1GYqd",
+	"qdq/9slQRZIhJA/VfRaL7WhFJ4hW5PO7w1CObTrRyN89/KjjM3cXEb+ZPsvMp59h5g/2nXnjfrF/3nsr",
+	"TqYTIm+urA3NfTfKUzDnSGyZaDRocIrNF8loLwvJ5wR5qje44bGeMKV+sCpXKrmbSuCrY9RLFmI6jQJk",
+	"ulF/7vkOUTdn/qj8Zb/Qpv0z2SNiwFXMOP+1YVg432b8qOAICkwfvhhHN9bdWB9aZo32FD4OAQJDgMAQ",
+	"IDAY9P+sAQLTA0WfKZaVzfAS1zI1mkdRbIet7kPJFpKpFdkAFDe+bgBj8PK9NHCtbrs0+2+4ByPdYj1T",
+	"+0l3s0wPFHyhYz8qAM2Qw9e2Tdvc5XDSwScmBlNuOjOPjSIm+dma8twstVJQuvPTJx5ZbH/O7L3YDalt",
+	"VgckGc2B702Zxnql2pPec7nRD9RzDEwPPAYik3bS/2AOt/P2p963jEpnCXHRozChQvJfTJve9dI+J/an",
+	"RHDY3IkUwynxRz4l/iaoZTiWBccEEC3K5ea4ODnUOACWQGPwmtWGh4YgAfMldBeYGX0BLld4YMsOg62D",
+	"j0lOU5MED6AT8NlVYmyQMWTHxg7ikTHY0aruILpIjsNm+pNvphrOdIxRCYYhwfQN95JKsRZKqLu5wZ46",
+	"fnTgnsoozzczpNWM3aaMZe099QTecNR0b0R3z3eSMRifNMZi/MSATU4nE598h8XDMvQUuY0UHUS4p8wY",
+	"/DW0M5gGyzx8cDqZtFb39PjRnic28M5WerwOmGsrOeoXz8l04hbMzN9EZAUkiHXbuKYWBVmbKrmmmSMS",
+	"j5drU+PBXUkxCJk/spDp8BMZkxhnD3GhQ1zoEBc6iJt/fVyoDXCEosVs7lMVe4NBV4zmetWLx/UYq3Cv",
+	"mFDgajUvO4c4OLcNJ7GMqI3SbE24MKSAS7Hx1MPKVCVib7XBZ+0N3dwfMPrDxP+gh9zSnyqslc0FU4rM",
+	"K21bxViemq1t72umJU/hzJeFDmJ85lTxtHW3ikFu/YDzewzTSz4ZIsYQazOzsiIuyLiyRN2EsgsJ3C7p",
+	"7zd3WRQ5Yiybbjj8d3oMEUY8y7FOv8XMVMn5V8a2AiM6PUa2b79x7IMBlHFx2+TR4JXpZJTAjnOpradn",
+	"9u+sMsSb4VtnE/yfT4T9wDY4stOvXFF/GxfR70l1JLe+wOOjh4Hv1BHq4wgAf6o2WSjWrJsB1DV6uE5G",
+	"CTAT2G9+LuY4kruO4+zoND4OpQtpBd+dGp6eHR3HWg78lsnLvyZ7nAyjxGyy5PzkwWRydDZKrp1vL5ke",
+	"TY4m2Ggl9uXKSuzHlx44nmVYO8uxDQEuJex2RSvrO92PQH7alYitt+vuR3OGEARnkaSJ5v8pPQUr6vp6",
+	"HIP9v3sf4do+efnTi8NWd/pwMjk6jq3uFs2gXre+Yte9msT+9fcCLaMW42MbGZ+GJ0MSK2q9Te+wGgPh",
+	"C1sF158SLU6tPc/dRbOFIUBKraOqT3NJewIfuAq7h9gH+MxFfO0dANGSAxGUFnyMY99WHf30+OgsUmK0",
+	"L+jBHHCtmId6Pk14EUvTjC0lNffskNSVCayNo+2HUVF2LDHwiHbJfTcqLjJ+zbMqZCXerlcYSiGa5y8X",
+	"qBoNjDww8j+dke/Ids2Pmmpd85lR8vohRfAAIQvJWHgEY3HkRmHaoshDohutcUf5/45O2T8MeDcYgOrr",
+	"96tdnTqd9S4zfvHyzfZZnx7v6j6iJvePBF9uzNoWb61hu9oj2DmAWiPfRQFq7sL2g9AG43s72dlbV+Xf",
+	"0i28vM8iT3eyVnin2D3P1jLDx815np7t1WHj0hKvqY7CSpXMFlaBz9A5F46BQ06JKCKizF2EDsLuwR3u",
+	"GT/ggAaZIlOILV9k18aYOnYkh1e3XQXn4S2ggzmGG3Ll9KtD6853f3kXKv7DuT6c6/98BTW4DQ4MODDg",
+	"P5sBtxdIb0FsXzNJ89zZaO0ExuTlXw2IIlRUy5v3KXQ/2/GOyJOn37++ePL0CbypijWDBMpxKrnmKY18",
+	"12AqSxI0Vbl2kpEzb/x48ezFm6cvLl48ftqbjORN6S2D+OVL8vDBZEr8O3X1O2uGpugqNgFte3OXM6fE",
+	"aqzxlFmLdTPhqVaorIWtw1TXveH0ta3YhdWHDVobzp58EhJs5Gw7+wD1uck1WMS4Lg+NMRoMiYMhcTAk",
+	"DsfkYEgcGHlg5MGQOBgSB0PiYEgcDImDIXE414dzfTAkDgw4GBIHQ+If3ZDYEAmdKOVvqeJpPEj5hyCQ",
+	"OAhPvsQw3jo4OefXTDDVXy7Ywjm69+xKWhw3uebCC7IgAUBWQnCxPLoSf1MGWa6Q6YopLakupCL3cv6B",
+	"kb9WcyYF00x9GW0Qsye4YJKoVVHlUD2NSGYruseCi5/bQX6m8GKXgpCBZOgzvuLDwO7q9nxjJ+1lNvQc",
+	"mVzX4aRuDMWH3hG8/Gu0/5d/vXO3W8yTfSLNjcfzSSjUQEp1mKMpxeyPJphcsqxKsVRpSVOuf59i63oP",
+	"4J8WQPHdJYtr70DRQmG57uae+NdvjoFL/yRcmjGatc++FlC7XU7IwGNbTjuf57JnNo5/f89jj9FsAy8Z",
+	"ODCiJV0seHp0JfBEMlXH4mpanclj7zEjc1U3qIt4tbYpOKr3VO2MznQfnp5FZfOgUffnQmnMzIucpa/d",
+	"1D/TYQo1YpA+O92ZotCGkge5M2061+fzLvb6Mc1ipJ/X0xj1Zj6hms6panRmUfD++V7NWLLLfgu6z2Ie",
+	"OJvYOt29iYNzjD5POtFv6hf+3CaIrbz4L7U+/NkcqMM6/1uvc48ZfFin34u9eFip371htdbb/QXP6OaD",
+	"efWAG+C/myG053p1N/vFcB/5w91HBu150J4H7XnQnod1GrTnYaUG7XnQnqNqLLnXWIMAMe/LrV4W7xHY",
+	"4mbZA0YN9eJYNdjnheGPa5YX5RoLx+C7jTo+5/fv05If3bD52NUnPMrY9f1fLY0/3kctXXKYD/J4Y4Ua",
+	"BV275WK6BWlbdV8/YqFXO++OeLFocGGVEutSUUG1WfsQY9HbgVI0R04jVQlcp8g1p+QSqTC+BIo8vWZC",
+	"B435LyKtmVWpfZ3gSJLNNQxaMm9DJOf/PwAnEIfVFAMDAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
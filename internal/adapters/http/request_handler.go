@@ -2,12 +2,14 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/architeacher/svc-web-analyzer/internal/adapters/http/handlers"
 	"github.com/architeacher/svc-web-analyzer/internal/adapters/http/mappers"
+	"github.com/architeacher/svc-web-analyzer/internal/adapters/middleware"
 	"github.com/architeacher/svc-web-analyzer/internal/domain"
 	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
 	"github.com/architeacher/svc-web-analyzer/internal/usecases"
@@ -16,18 +18,29 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// defaultLinksPageSize is the number of links returned when a request does not set a limit.
+const defaultLinksPageSize = 50
+
 type RequestHandler struct {
-	app    *usecases.WebApplication
-	logger infrastructure.Logger
+	app        *usecases.WebApplication
+	analyzers  *domain.AnalyzerRegistry
+	adminScope string
+	logger     infrastructure.Logger
 }
 
+// NewRequestHandler creates the API handler. Analyses may only select the analyzers of the
+// registry, and tokens granted adminScope may ask them to ignore robots.txt.
 func NewRequestHandler(
 	a *usecases.WebApplication,
+	analyzers *domain.AnalyzerRegistry,
+	adminScope string,
 	logger infrastructure.Logger,
 ) *RequestHandler {
 	return &RequestHandler{
-		app:    a,
-		logger: logger,
+		app:        a,
+		analyzers:  analyzers,
+		adminScope: adminScope,
+		logger:     logger,
 	}
 }
 
@@ -47,6 +60,21 @@ func (h *RequestHandler) AnalyzeURL(w http.ResponseWriter, r *http.Request, para
 
 	options := h.mapRequestOptionsToDomainOptions(req.Options)
 
+	if options.Analyzers != nil && h.analyzers != nil {
+		if _, err := h.analyzers.Resolve(options.Analyzers); err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "bad_request", "unknown analyzer", err.Error())
+
+			return
+		}
+	}
+
+	if options.IgnoreRobotsTxt && !h.isAdmin(r) {
+		h.writeErrorResponse(w, http.StatusForbidden, "forbidden", "ignoring robots.txt requires an admin token",
+			fmt.Sprintf("the token lacks the %q scope", h.adminScope))
+
+		return
+	}
+
 	result, err := h.app.Commands.AnalyzeCommandHandler.Handle(
 		r.Context(),
 		commands.AnalyzeCommand{
@@ -93,6 +121,32 @@ func (h *RequestHandler) GetAnalysis(w http.ResponseWriter, r *http.Request, ana
 	}
 }
 
+// GetAnalysisLinks implements ServerInterface.GetAnalysisLinks
+func (h *RequestHandler) GetAnalysisLinks(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params handlers.GetAnalysisLinksParams) {
+	result, err := h.app.Queries.FetchAnalysisLinksQueryHandler.Execute(
+		r.Context(),
+		h.mapLinkParamsToQuery(analysisId, params),
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidCursor):
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_cursor", "invalid pagination cursor", err.Error())
+		case errors.Is(err, domain.ErrInvalidPageLimit):
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_limit", "invalid pagination limit", err.Error())
+		case errors.Is(err, domain.ErrAnalysisNotFound):
+			h.writeErrorResponse(w, http.StatusNotFound, "not_found", "analysis not found", err.Error())
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "internal_server_error", "failed to fetch analysis links", err.Error())
+		}
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
+
 // GetAnalysisEvents implements ServerInterface.GetAnalysisEvents
 func (h *RequestHandler) GetAnalysisEvents(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params handlers.GetAnalysisEventsParams) {
 	// Check if the response writer supports flushing before setting headers
@@ -315,18 +369,31 @@ func stringPtr(s string) *string {
 	return &s
 }
 
+// analyzeRequestOptions mirrors the anonymous options struct generated for handlers.AnalyzeRequest.
+type analyzeRequestOptions = struct {
+	Accessibility   *bool                                        `json:"accessibility,omitempty"`
+	Analyzers       *[]string                                    `json:"analyzers,omitempty"`
+	CheckLinks      *bool                                        `json:"check_links,omitempty"`
+	CheckResources  *bool                                        `json:"check_resources,omitempty"`
+	DetectForms     *bool                                        `json:"detect_forms,omitempty"`
+	IgnoreRobotsTxt *bool                                        `json:"ignore_robots_txt,omitempty"`
+	IncludeHeadings *bool                                        `json:"include_headings,omitempty"`
+	IncludeMeta     *bool                                        `json:"include_meta,omitempty"`
+	LinkScope       *handlers.AnalyzeURLJSONBodyOptionsLinkScope `json:"link_scope,omitempty"`
+	Timeout         *int                                         `json:"timeout,omitempty"`
+}
+
 // mapRequestOptionsToDomainOptions maps HTTP request options to domain options
-func (h *RequestHandler) mapRequestOptionsToDomainOptions(reqOptions *struct {
-	CheckLinks      *bool `json:"check_links,omitempty"`
-	DetectForms     *bool `json:"detect_forms,omitempty"`
-	IncludeHeadings *bool `json:"include_headings,omitempty"`
-	Timeout         *int  `json:"timeout,omitempty"`
-}) domain.AnalysisOptions {
+func (h *RequestHandler) mapRequestOptionsToDomainOptions(reqOptions *analyzeRequestOptions) domain.AnalysisOptions {
 	options := domain.AnalysisOptions{
-		IncludeHeadings: true,             // Default to true
-		CheckLinks:      true,             // Default to true
-		DetectForms:     true,             // Default to true
-		Timeout:         30 * time.Second, // Default timeout
+		IncludeHeadings: true,                     // Default to true
+		CheckLinks:      true,                     // Default to true
+		LinkScope:       domain.LinkScopeExternal, // Default to external links only
+		DetectForms:     true,                     // Default to true
+		IncludeMeta:     false,                    // Opt-in
+		Accessibility:   false,                    // Opt-in
+		CheckResources:  false,                    // Opt-in
+		Timeout:         30 * time.Second,         // Default timeout
 	}
 
 	if reqOptions == nil {
@@ -341,17 +408,82 @@ func (h *RequestHandler) mapRequestOptionsToDomainOptions(reqOptions *struct {
 		options.CheckLinks = *reqOptions.CheckLinks
 	}
 
+	if reqOptions.LinkScope != nil {
+		options.LinkScope = domain.LinkScope(*reqOptions.LinkScope)
+	}
+
+	if reqOptions.CheckResources != nil {
+		options.CheckResources = *reqOptions.CheckResources
+	}
+
 	if reqOptions.DetectForms != nil {
 		options.DetectForms = *reqOptions.DetectForms
 	}
 
+	if reqOptions.IncludeMeta != nil {
+		options.IncludeMeta = *reqOptions.IncludeMeta
+	}
+
+	if reqOptions.Accessibility != nil {
+		options.Accessibility = *reqOptions.Accessibility
+	}
+
+	if reqOptions.Analyzers != nil {
+		options.Analyzers = append([]string{}, *reqOptions.Analyzers...)
+	}
+
 	if reqOptions.Timeout != nil {
 		options.Timeout = time.Duration(*reqOptions.Timeout) * time.Second
 	}
 
+	if reqOptions.IgnoreRobotsTxt != nil {
+		options.IgnoreRobotsTxt = *reqOptions.IgnoreRobotsTxt
+	}
+
 	return options
 }
 
+// mapLinkParamsToQuery maps the HTTP query parameters of the links listing to a query
+func (h *RequestHandler) mapLinkParamsToQuery(analysisId openapi_types.UUID, params handlers.GetAnalysisLinksParams) queries.FetchAnalysisLinksQuery {
+	query := queries.FetchAnalysisLinksQuery{
+		AnalysisID: analysisId.String(),
+		Limit:      defaultLinksPageSize,
+	}
+
+	if params.Cursor != nil {
+		query.Cursor = *params.Cursor
+	}
+
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+
+	if params.Type != nil {
+		query.Filter.Type = domain.LinkType(*params.Type)
+	}
+
+	if params.Region != nil {
+		query.Filter.Region = domain.LinkRegion(*params.Region)
+	}
+
+	if params.StatusCode != nil {
+		query.Filter.StatusCode = *params.StatusCode
+	}
+
+	if params.Host != nil {
+		query.Filter.Host = *params.Host
+	}
+
+	return query
+}
+
+// isAdmin reports whether the request was authenticated with a token granted the admin scope.
+func (h *RequestHandler) isAdmin(r *http.Request) bool {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+
+	return ok && claims.HasScope(h.adminScope)
+}
+
 // writeErrorResponse writes a standardized error response
 func (h *RequestHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorType, message, details string) {
 	errorResp := handlers.ErrorResponse{
@@ -1,11 +1,24 @@
 package http
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/architeacher/svc-web-analyzer/internal/adapters"
+	"github.com/architeacher/svc-web-analyzer/internal/adapters/http/handlers"
+	"github.com/architeacher/svc-web-analyzer/internal/adapters/middleware"
 	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+	"github.com/architeacher/svc-web-analyzer/internal/usecases/queries"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRequestHandler_mapRequestOptionsToDomainOptions(t *testing.T) {
@@ -14,13 +27,8 @@ func TestRequestHandler_mapRequestOptionsToDomainOptions(t *testing.T) {
 	h := &RequestHandler{}
 
 	tests := []struct {
-		name  string
-		input *struct {
-			CheckLinks      *bool `json:"check_links,omitempty"`
-			DetectForms     *bool `json:"detect_forms,omitempty"`
-			IncludeHeadings *bool `json:"include_headings,omitempty"`
-			Timeout         *int  `json:"timeout,omitempty"`
-		}
+		name     string
+		input    *analyzeRequestOptions
 		expected domain.AnalysisOptions
 	}{
 		{
@@ -29,64 +37,116 @@ func TestRequestHandler_mapRequestOptionsToDomainOptions(t *testing.T) {
 			expected: domain.AnalysisOptions{
 				IncludeHeadings: true,
 				CheckLinks:      true,
+				LinkScope:       domain.LinkScopeExternal,
 				DetectForms:     true,
 				Timeout:         30 * time.Second,
 			},
 		},
 		{
 			name: "include_headings false should be respected",
-			input: &struct {
-				CheckLinks      *bool `json:"check_links,omitempty"`
-				DetectForms     *bool `json:"detect_forms,omitempty"`
-				IncludeHeadings *bool `json:"include_headings,omitempty"`
-				Timeout         *int  `json:"timeout,omitempty"`
-			}{
+			input: &analyzeRequestOptions{
 				IncludeHeadings: boolPtr(false),
 			},
 			expected: domain.AnalysisOptions{
 				IncludeHeadings: false,
 				CheckLinks:      true,
+				LinkScope:       domain.LinkScopeExternal,
 				DetectForms:     true,
 				Timeout:         30 * time.Second,
 			},
 		},
 		{
 			name: "include_headings true should be respected",
-			input: &struct {
-				CheckLinks      *bool `json:"check_links,omitempty"`
-				DetectForms     *bool `json:"detect_forms,omitempty"`
-				IncludeHeadings *bool `json:"include_headings,omitempty"`
-				Timeout         *int  `json:"timeout,omitempty"`
-			}{
+			input: &analyzeRequestOptions{
 				IncludeHeadings: boolPtr(true),
 			},
 			expected: domain.AnalysisOptions{
 				IncludeHeadings: true,
 				CheckLinks:      true,
+				LinkScope:       domain.LinkScopeExternal,
 				DetectForms:     true,
 				Timeout:         30 * time.Second,
 			},
 		},
 		{
 			name: "all options should be mapped correctly",
-			input: &struct {
-				CheckLinks      *bool `json:"check_links,omitempty"`
-				DetectForms     *bool `json:"detect_forms,omitempty"`
-				IncludeHeadings *bool `json:"include_headings,omitempty"`
-				Timeout         *int  `json:"timeout,omitempty"`
-			}{
+			input: &analyzeRequestOptions{
 				IncludeHeadings: boolPtr(false),
 				CheckLinks:      boolPtr(false),
+				CheckResources:  boolPtr(true),
 				DetectForms:     boolPtr(false),
+				IncludeMeta:     boolPtr(false),
+				Accessibility:   boolPtr(true),
+				Analyzers:       &[]string{"tech_stack"},
 				Timeout:         intPtr(60),
 			},
 			expected: domain.AnalysisOptions{
 				IncludeHeadings: false,
 				CheckLinks:      false,
+				LinkScope:       domain.LinkScopeExternal,
+				CheckResources:  true,
 				DetectForms:     false,
+				IncludeMeta:     false,
+				Accessibility:   true,
+				Analyzers:       []string{"tech_stack"},
 				Timeout:         60 * time.Second,
 			},
 		},
+		{
+			name: "include_meta true should be respected",
+			input: &analyzeRequestOptions{
+				IncludeMeta: boolPtr(true),
+			},
+			expected: domain.AnalysisOptions{
+				IncludeHeadings: true,
+				CheckLinks:      true,
+				LinkScope:       domain.LinkScopeExternal,
+				DetectForms:     true,
+				IncludeMeta:     true,
+				Timeout:         30 * time.Second,
+			},
+		},
+		{
+			name: "link scope should be respected",
+			input: &analyzeRequestOptions{
+				LinkScope: linkScopePtr(handlers.AnalyzeURLJSONBodyOptionsLinkScopeAll),
+			},
+			expected: domain.AnalysisOptions{
+				IncludeHeadings: true,
+				CheckLinks:      true,
+				LinkScope:       domain.LinkScopeAll,
+				DetectForms:     true,
+				Timeout:         30 * time.Second,
+			},
+		},
+		{
+			name: "robots.txt override should be respected",
+			input: &analyzeRequestOptions{
+				IgnoreRobotsTxt: boolPtr(true),
+			},
+			expected: domain.AnalysisOptions{
+				IncludeHeadings: true,
+				CheckLinks:      true,
+				LinkScope:       domain.LinkScopeExternal,
+				DetectForms:     true,
+				IgnoreRobotsTxt: true,
+				Timeout:         30 * time.Second,
+			},
+		},
+		{
+			name: "empty analyzers list is kept distinct from an omitted one",
+			input: &analyzeRequestOptions{
+				Analyzers: &[]string{},
+			},
+			expected: domain.AnalysisOptions{
+				IncludeHeadings: true,
+				CheckLinks:      true,
+				LinkScope:       domain.LinkScopeExternal,
+				DetectForms:     true,
+				Analyzers:       []string{},
+				Timeout:         30 * time.Second,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -99,6 +159,140 @@ func TestRequestHandler_mapRequestOptionsToDomainOptions(t *testing.T) {
 	}
 }
 
+func TestRequestHandler_AnalyzeURL_IgnoreRobotsTxtRequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	h := &RequestHandler{adminScope: "admin", logger: infrastructure.Logger{Logger: zerolog.Nop()}}
+
+	tests := []struct {
+		name   string
+		claims *middleware.PasetoTokenClaims
+	}{
+		{
+			name: "unauthenticated request",
+		},
+		{
+			name:   "token without the admin scope",
+			claims: &middleware.PasetoTokenClaims{Subject: "user", Scopes: []string{"analyze"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/analyze",
+				strings.NewReader(`{"url": "https://example.com", "options": {"ignore_robots_txt": true}}`))
+			if tt.claims != nil {
+				req = req.WithContext(context.WithValue(req.Context(), "paseto_claims", tt.claims))
+			}
+
+			recorder := httptest.NewRecorder()
+			h.AnalyzeURL(recorder, req, handlers.AnalyzeURLParams{})
+
+			assert.Equal(t, http.StatusForbidden, recorder.Code)
+
+			var resp handlers.ErrorResponse
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&resp))
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, "forbidden", *resp.Error)
+		})
+	}
+}
+
+func TestRequestHandler_AnalyzeURL_RejectsUnknownAnalyzers(t *testing.T) {
+	t.Parallel()
+
+	analyzers, err := adapters.NewAnalyzerRegistry()
+	require.NoError(t, err)
+
+	h := &RequestHandler{analyzers: analyzers, logger: infrastructure.Logger{Logger: zerolog.Nop()}}
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/analyze",
+		strings.NewReader(`{"url": "https://example.com", "options": {"analyzers": ["tech_stack", "typo"]}}`))
+
+	recorder := httptest.NewRecorder()
+	h.AnalyzeURL(recorder, req, handlers.AnalyzeURLParams{})
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var resp handlers.ErrorResponse
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&resp))
+	require.NotNil(t, resp.Message)
+	assert.Equal(t, "unknown analyzer", *resp.Message)
+	require.NotNil(t, resp.Details)
+	assert.Contains(t, *resp.Details, `"typo"`)
+}
+
+func TestRequestHandler_isAdmin(t *testing.T) {
+	t.Parallel()
+
+	h := &RequestHandler{adminScope: "admin"}
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/analyze", nil)
+	assert.False(t, h.isAdmin(req))
+
+	admin := &middleware.PasetoTokenClaims{Subject: "ops", Scopes: []string{"analyze", "admin"}}
+	assert.True(t, h.isAdmin(req.WithContext(context.WithValue(req.Context(), "paseto_claims", admin))))
+}
+
+func TestRequestHandler_mapLinkParamsToQuery(t *testing.T) {
+	t.Parallel()
+
+	h := &RequestHandler{}
+	analysisID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	linkType := handlers.GetAnalysisLinksParamsType("external")
+	region := handlers.GetAnalysisLinksParamsRegion("footer")
+	cursor := "NDk"
+	host := "Partner.example.org"
+
+	tests := []struct {
+		name     string
+		input    handlers.GetAnalysisLinksParams
+		expected queries.FetchAnalysisLinksQuery
+	}{
+		{
+			name:  "no parameters should list all links with the default page size",
+			input: handlers.GetAnalysisLinksParams{},
+			expected: queries.FetchAnalysisLinksQuery{
+				AnalysisID: analysisID.String(),
+				Limit:      defaultLinksPageSize,
+			},
+		},
+		{
+			name: "all parameters should be mapped correctly",
+			input: handlers.GetAnalysisLinksParams{
+				Cursor:     &cursor,
+				Limit:      intPtr(10),
+				Type:       &linkType,
+				Region:     &region,
+				StatusCode: intPtr(404),
+				Host:       &host,
+			},
+			expected: queries.FetchAnalysisLinksQuery{
+				AnalysisID: analysisID.String(),
+				Cursor:     cursor,
+				Limit:      10,
+				Filter: domain.LinkFilter{
+					Type:       domain.LinkTypeExternal,
+					Region:     domain.LinkRegionFooter,
+					StatusCode: 404,
+					Host:       host,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, h.mapLinkParamsToQuery(analysisID, tt.input))
+		})
+	}
+}
+
 func boolPtr(b bool) *bool {
 	return &b
 }
@@ -106,3 +300,7 @@ func boolPtr(b bool) *bool {
 func intPtr(i int) *int {
 	return &i
 }
+
+func linkScopePtr(scope handlers.AnalyzeURLJSONBodyOptionsLinkScope) *handlers.AnalyzeURLJSONBodyOptionsLinkScope {
+	return &scope
+}
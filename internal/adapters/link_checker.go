@@ -1,116 +1,219 @@
 package adapters
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/PuerkitoBio/goquery"
 	"github.com/architeacher/svc-web-analyzer/internal/config"
 	"github.com/architeacher/svc-web-analyzer/internal/domain"
 	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+	"github.com/architeacher/svc-web-analyzer/internal/ports"
 	"github.com/go-resty/resty/v2"
 	"github.com/sony/gobreaker"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
 )
 
 type (
 	LinkChecker struct {
-		client         *resty.Client
-		circuitBreaker *gobreaker.CircuitBreaker
-		logger         infrastructure.Logger
-		config         config.LinkCheckerConfig
-		metrics        infrastructure.Metrics
+		client   *resty.Client
+		hosts    *hostRegistry
+		cache    ports.LinkCheckCacheRepository
+		robots   ports.RobotsPolicy
+		inflight singleflight.Group
+		logger   infrastructure.Logger
+		config   config.LinkCheckerConfig
+		metrics  infrastructure.Metrics
+	}
+
+	// sharedDocumentCheck is a check of a document fetched to look up the fragments of the links
+	// into it.
+	sharedDocumentCheck struct {
+		result  domain.LinkCheckResult
+		anchors anchorTargets
+	}
+
+	// linkGroup is a document and the links into it, whose results are derived from checking it.
+	// The fragments of the document are collected when any of the links has its fragment checked.
+	linkGroup struct {
+		document  domain.Link
+		links     []domain.Link
+		fragments bool
+	}
+
+	checkedLink struct {
+		link   domain.Link
+		result domain.LinkCheckResult
+	}
+
+	// documentAnchors receives the fragments of a document that was read whole. Targets stay nil
+	// when it was not.
+	documentAnchors struct {
+		targets anchorTargets
 	}
 
 	linkCheckResult struct {
-		StatusCode int
-		Error      string
+		StatusCode    int
+		Error         string
+		RetryAfter    time.Duration
+		Redirects     *domain.RedirectChain
+		RedirectLoop  bool
+		Soft404Reason string
 	}
 )
 
-func NewLinkChecker(config config.LinkCheckerConfig, logger infrastructure.Logger, metrics infrastructure.Metrics) *LinkChecker {
+// NewLinkChecker creates a link checker sending userAgent, the agent robots.txt is evaluated for.
+// A nil guard lets links be checked at any address their host resolves to, a nil cache checks
+// every link itself and a nil robots policy checks links regardless of robots.txt.
+func NewLinkChecker(
+	config config.LinkCheckerConfig,
+	userAgent string,
+	guard *NetworkGuard,
+	cache ports.LinkCheckCacheRepository,
+	robots ports.RobotsPolicy,
+	logger infrastructure.Logger,
+	metrics infrastructure.Metrics,
+) *LinkChecker {
 	client := resty.New()
 
+	if guard != nil {
+		client.SetTransport(guard.Transport())
+	}
+
 	client.SetTimeout(config.Timeout).
 		SetRetryCount(config.Retries).
 		SetRetryWaitTime(config.RetryWaitTime).
 		SetRetryMaxWaitTime(config.MaxRetryWaitTime).
-		SetRedirectPolicy(resty.FlexibleRedirectPolicy(5)) // Limit redirects for link checking
+		SetRedirectPolicy(resty.RedirectPolicyFunc(func(req *http.Request, via []*http.Request) error {
+			return recordRedirect(req, via, config.MaxRedirects)
+		})).
+		OnBeforeRequest(resetRedirectRecorder).
+		AddRetryCondition(func(_ *resty.Response, err error) bool {
+			return err != nil && !isRefusal(err)
+		})
 
 	client.SetHeaders(map[string]string{
-		"User-Agent": "WebAnalyzer-WebCrawler/1.0",
+		"User-Agent": userAgent,
 		"Accept":     "*/*",
 	})
 
-	cbSettings := gobreaker.Settings{
-		Name:        "link-checker",
-		MaxRequests: config.CircuitBreaker.MaxRequests,
-		Interval:    config.CircuitBreaker.Interval,
-		Timeout:     config.CircuitBreaker.Timeout,
-		ReadyToTrip: func(counts gobreaker.Counts) bool {
-			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
-			return counts.Requests >= 5 && failureRatio >= 0.8
-		},
-		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
-			logger.Info().
-				Str("name", name).
-				Str("from", from.String()).
-				Str("to", to.String()).
-				Msg("Link checker circuit breaker state changed")
-		},
-	}
-
-	circuitBreaker := gobreaker.NewCircuitBreaker(cbSettings)
+	newHost := func(host string) *hostState {
+		cbSettings := gobreaker.Settings{
+			Name:        "link-checker:" + host,
+			MaxRequests: config.CircuitBreaker.MaxRequests,
+			Interval:    config.CircuitBreaker.Interval,
+			Timeout:     config.CircuitBreaker.Timeout,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
+				return counts.Requests >= 5 && failureRatio >= 0.8
+			},
+			OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
+				logger.Info().
+					Str("name", name).
+					Str("from", from.String()).
+					Str("to", to.String()).
+					Msg("Link checker circuit breaker state changed")
+
+				metrics.RecordLinkCheckerBreakerState(context.Background(), host, to.String())
+			},
+		}
+
+		return &hostState{
+			name:    host,
+			breaker: gobreaker.NewCircuitBreaker(cbSettings),
+			slots:   make(chan struct{}, max(config.MaxConcurrentChecksPerHost, 1)),
+		}
+	}
+
+	// A host that is no longer tracked drops out of the breaker state metric, and starts with a
+	// closed breaker when it is tracked again.
+	onEvict := func(host *hostState) {
+		if host.breaker.State() != gobreaker.StateClosed {
+			metrics.RecordLinkCheckerBreakerState(context.Background(), host.name, gobreaker.StateClosed.String())
+		}
+	}
 
 	return &LinkChecker{
-		client:         client,
-		circuitBreaker: circuitBreaker,
-		logger:         logger,
-		config:         config,
-		metrics:        metrics,
+		client:  client,
+		hosts:   newHostRegistry(config.MaxTrackedHosts, newHost, onEvict),
+		cache:   cache,
+		robots:  robots,
+		logger:  logger,
+		config:  config,
+		metrics: metrics,
 	}
 }
 
-func (lc *LinkChecker) CheckAccessibility(ctx context.Context, links []domain.Link) []domain.InaccessibleLink {
+func (lc *LinkChecker) CheckAccessibility(ctx context.Context, links []domain.Link, scope domain.LinkScope) domain.LinkCheckReport {
 	if len(links) == 0 {
-		return []domain.InaccessibleLink{}
+		return newLinkCheckReport()
 	}
 
-	// Filter to only external links and limit the number
-	externalLinks := lc.filterExternalLinks(links)
-	if len(externalLinks) > lc.config.MaxLinksToCheck {
-		lc.logger.Warn().
-			Int("total_links", len(externalLinks)).
-			Int("max_links", lc.config.MaxLinksToCheck).
-			Msg("Too many links to check, limiting to maximum allowed")
-		externalLinks = externalLinks[:lc.config.MaxLinksToCheck]
-	}
+	// Filter to the links in scope and limit the number per link type
+	externalLinks, internalLinks := lc.filterLinks(links, scope)
+	externalLinks = lc.limitLinks(externalLinks, domain.LinkTypeExternal)
+	internalLinks = lc.limitLinks(internalLinks, domain.LinkTypeInternal)
 
 	lc.logger.Info().
 		Int("total_links", len(links)).
+		Str("scope", string(scope)).
 		Int("external_links", len(externalLinks)).
-		Int("links_to_check", len(externalLinks)).
+		Int("internal_links", len(internalLinks)).
+		Int("links_to_check", len(externalLinks)+len(internalLinks)).
 		Msg("Starting link accessibility check")
 
-	inaccessibleLinks := lc.checkLinks(ctx, externalLinks)
+	var (
+		externalReport, internalReport domain.LinkCheckReport
+		wg                             sync.WaitGroup
+	)
+
+	wg.Go(func() {
+		externalReport = lc.checkLinks(ctx, externalLinks, lc.config.MaxConcurrentChecks, nil)
+	})
+
+	wg.Go(func() {
+		// Every internal link hits the analysed origin, so they are paced per analysis.
+		limit := rate.Inf
+		if lc.config.InternalRequestsPerSecond > 0 {
+			limit = rate.Limit(lc.config.InternalRequestsPerSecond)
+		}
+
+		internalReport = lc.checkLinks(ctx, internalLinks, lc.config.InternalMaxConcurrentChecks, rate.NewLimiter(limit, 1))
+	})
+
+	wg.Wait()
+
+	report := domain.LinkCheckReport{
+		InaccessibleLinks: append(externalReport.InaccessibleLinks, internalReport.InaccessibleLinks...),
+		Issues:            append(externalReport.Issues, internalReport.Issues...),
+		Results:           append(externalReport.Results, internalReport.Results...),
+	}
 
 	lc.logger.Info().
-		Int("total_checked", len(externalLinks)).
-		Int("inaccessible", len(inaccessibleLinks)).
+		Int("total_checked", len(externalLinks)+len(internalLinks)).
+		Int("inaccessible", len(report.InaccessibleLinks)).
+		Int("issues", len(report.Issues)).
 		Msg("Link accessibility check completed")
 
-	return inaccessibleLinks
+	return report
 }
 
-func (lc *LinkChecker) filterExternalLinks(links []domain.Link) []domain.Link {
-	var externalLinks []domain.Link
+// filterLinks splits the distinct, parseable links the scope includes by link type.
+func (lc *LinkChecker) filterLinks(links []domain.Link, scope domain.LinkScope) (externalLinks, internalLinks []domain.Link) {
 	seen := make(map[string]bool)
 
 	for _, link := range links {
-		// Skip internal links and duplicates
-		if link.Type == domain.LinkTypeInternal {
+		// Skip links outside the scope
+		if !scope.Includes(link.Type) {
 			continue
 		}
 
@@ -125,43 +228,298 @@ func (lc *LinkChecker) filterExternalLinks(links []domain.Link) []domain.Link {
 			continue
 		}
 
-		externalLinks = append(externalLinks, link)
+		if link.Type == domain.LinkTypeInternal {
+			internalLinks = append(internalLinks, link)
+		} else {
+			externalLinks = append(externalLinks, link)
+		}
 	}
 
-	return externalLinks
+	return externalLinks, internalLinks
 }
 
-func (lc *LinkChecker) checkLinks(ctx context.Context, links []domain.Link) []domain.InaccessibleLink {
-	var inaccessibleLinks []domain.InaccessibleLink
+func (lc *LinkChecker) limitLinks(links []domain.Link, linkType domain.LinkType) []domain.Link {
+	if len(links) <= lc.config.MaxLinksToCheck {
+		return links
+	}
+
+	lc.logger.Warn().
+		Str("link_type", string(linkType)).
+		Int("total_links", len(links)).
+		Int("max_links", lc.config.MaxLinksToCheck).
+		Msg("Too many links to check, limiting to maximum allowed")
+
+	return links[:lc.config.MaxLinksToCheck]
+}
+
+// checkLinks checks the links with at most concurrency requests in flight, waiting for the turn
+// of the link's host and on the limiter before each request when one is given.
+func (lc *LinkChecker) checkLinks(ctx context.Context, links []domain.Link, concurrency int, limiter *rate.Limiter) domain.LinkCheckReport {
+	report := newLinkCheckReport()
 	var mu sync.Mutex
 
-	semaphore := make(chan struct{}, lc.config.MaxConcurrentChecks)
+	semaphore := make(chan struct{}, max(concurrency, 1))
 	var wg sync.WaitGroup
 
-	for _, link := range links {
+	for _, group := range lc.groupLinks(links) {
 		wg.Go(func() {
-			semaphore <- struct{}{}        // Acquire semaphore
-			defer func() { <-semaphore }() // Release semaphore
-
-			if inaccessibleLink := lc.checkSingleLink(ctx, link); inaccessibleLink != nil {
-				mu.Lock()
-				inaccessibleLinks = append(inaccessibleLinks, *inaccessibleLink)
-				mu.Unlock()
+			checked := lc.checkGroup(ctx, group, semaphore, limiter)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			for _, outcome := range checked {
+				report.Results = append(report.Results, outcome.result)
+				report.Issues = append(report.Issues, lc.linkIssues(outcome.link, outcome.result)...)
+
+				if !outcome.result.Accessible() {
+					report.InaccessibleLinks = append(report.InaccessibleLinks, domain.InaccessibleLink{
+						URL:        outcome.link.URL,
+						StatusCode: outcome.result.StatusCode,
+						Error:      outcome.result.Error,
+						Scope:      outcome.link.Type,
+						Cached:     outcome.result.Cached,
+					})
+				}
 			}
 		})
 	}
 
 	wg.Wait()
 
-	return inaccessibleLinks
+	return report
 }
 
-func (lc *LinkChecker) checkSingleLink(ctx context.Context, link domain.Link) *domain.InaccessibleLink {
-	startTime := time.Now()
+// groupLinks groups the links by the document they point at, so that every document is fetched
+// once however many of its fragments are linked.
+func (lc *LinkChecker) groupLinks(links []domain.Link) []linkGroup {
+	groups := make([]linkGroup, 0, len(links))
+	documents := make(map[string]int)
+
+	for _, link := range links {
+		documentURL := link.URL
+		if linkURL, err := url.Parse(link.URL); err == nil && linkURL.Fragment != "" {
+			documentURL = withoutFragment(linkURL)
+		}
+
+		i, ok := documents[documentURL]
+		if !ok {
+			i = len(groups)
+			documents[documentURL] = i
+			groups = append(groups, linkGroup{document: domain.Link{URL: documentURL, Type: link.Type}})
+		}
+
+		groups[i].links = append(groups[i].links, link)
+		groups[i].fragments = groups[i].fragments || lc.fragmentToCheck(link) != ""
+	}
+
+	return groups
+}
+
+// checkGroup applies robots.txt to the document of a group before checking it. Links robots.txt
+// disallows are not requested, and a host asking for a Crawl-delay is paced by it. A document
+// checked only because the analysis may ignore robots.txt is checked on its own, bypassing the
+// shared checks and the cache, so analyses honouring robots.txt never see what it found and the
+// reverse.
+func (lc *LinkChecker) checkGroup(ctx context.Context, group linkGroup, semaphore chan struct{}, limiter *rate.Limiter) []checkedLink {
+	delay := lc.config.PerHostDelay
+	overridden := false
+
+	if lc.robots != nil {
+		verdict := lc.robots.Evaluate(ctx, group.document.URL)
+		if !verdict.Allowed {
+			return group.derive(domain.LinkCheckResult{URL: group.document.URL, RobotsDisallowed: true, CheckedAt: time.Now()}, nil)
+		}
+
+		delay = max(delay, verdict.Wait)
+		overridden = verdict.Overridden
+	}
+
+	check := func(ctx context.Context, anchors *documentAnchors) (domain.LinkCheckResult, bool) {
+		return lc.acquireAndCheck(ctx, group.document, anchors, delay, semaphore, limiter)
+	}
+
+	switch {
+	case overridden && group.fragments:
+		anchors := &documentAnchors{}
+		result, _ := check(ctx, anchors)
+
+		return group.derive(result, anchors.targets)
 
-	result, err := lc.circuitBreaker.Execute(func() (any, error) {
-		return lc.performLinkCheck(ctx, link.URL)
+	case overridden:
+		result, _ := check(ctx, nil)
+
+		return group.derive(result, nil)
+
+	case group.fragments:
+		return lc.checkFragments(ctx, group, check)
+
+	default:
+		result := lc.checkShared(ctx, group.document, func(ctx context.Context) (domain.LinkCheckResult, bool) {
+			return check(ctx, nil)
+		})
+
+		return group.derive(result, nil)
+	}
+}
+
+// checkFragments takes the links of a group from the cache where it can. The document is fetched
+// once for the others, shared with the other analyses fetching it at the same time, and looked up
+// for all their fragments.
+func (lc *LinkChecker) checkFragments(ctx context.Context, group linkGroup, check func(ctx context.Context, anchors *documentAnchors) (domain.LinkCheckResult, bool)) []checkedLink {
+	checked := make([]checkedLink, 0, len(group.links))
+	uncached := linkGroup{document: group.document, fragments: true}
+
+	for _, link := range group.links {
+		if cachedResult, ok := lc.cachedLinkCheck(ctx, link.URL); ok {
+			checked = append(checked, checkedLink{link: link, result: *cachedResult})
+
+			continue
+		}
+
+		uncached.links = append(uncached.links, link)
+	}
+
+	if len(uncached.links) == 0 {
+		return checked
+	}
+
+	// Documents fetched for their fragments are not shared with the plain checks of their URL.
+	shared, err := lc.runShared(ctx, "fragments "+group.document.URL, func(ctx context.Context) any {
+		anchors := &documentAnchors{}
+		result, conclusive := check(ctx, anchors)
+
+		outcome := sharedDocumentCheck{result: result, anchors: anchors.targets}
+
+		if conclusive {
+			for _, derived := range uncached.derive(result, anchors.targets) {
+				lc.saveLinkCheck(ctx, derived.result)
+			}
+		}
+
+		return outcome
+	})
+	if err != nil {
+		return append(checked, uncached.derive(domain.LinkCheckResult{URL: group.document.URL, Error: err.Error()}, nil)...)
+	}
+
+	outcome := shared.(sharedDocumentCheck)
+
+	return append(checked, uncached.derive(outcome.result, outcome.anchors)...)
+}
+
+// acquireAndCheck checks a link once a concurrency slot, its host and the limiter let it. The turn
+// of the host is only reserved once the slot is taken, so that waiting for a slot does not use up
+// the pacing of the host.
+func (lc *LinkChecker) acquireAndCheck(ctx context.Context, link domain.Link, anchors *documentAnchors, delay time.Duration, semaphore chan struct{}, limiter *rate.Limiter) (domain.LinkCheckResult, bool) {
+	semaphore <- struct{}{}        // Acquire semaphore
+	defer func() { <-semaphore }() // Release semaphore
+
+	host, err := lc.hosts.acquire(ctx, linkHostname(link.URL), delay)
+	if err != nil {
+		return domain.LinkCheckResult{URL: link.URL, Error: err.Error()}, false
+	}
+	defer lc.hosts.release(host)
+
+	return lc.waitAndCheck(ctx, link, anchors, host, limiter)
+}
+
+// checkShared returns the cached result of a link when there is one. Otherwise it runs check once
+// for all callers asking for the same URL at the same time, including those of other analyses, and
+// caches the result when check reports it as conclusive.
+func (lc *LinkChecker) checkShared(ctx context.Context, link domain.Link, check func(ctx context.Context) (domain.LinkCheckResult, bool)) domain.LinkCheckResult {
+	shared, err := lc.runShared(ctx, link.URL, func(ctx context.Context) any {
+		if cachedResult, ok := lc.cachedLinkCheck(ctx, link.URL); ok {
+			return *cachedResult
+		}
+
+		result, conclusive := check(ctx)
+		if conclusive {
+			lc.saveLinkCheck(ctx, result)
+		}
+
+		return result
 	})
+	if err != nil {
+		return domain.LinkCheckResult{URL: link.URL, Error: err.Error()}
+	}
+
+	return shared.(domain.LinkCheckResult)
+}
+
+// runShared runs fn once for all callers passing the same key at the same time. As the callers
+// come from different analyses, fn runs on a context of its own that none of them can cancel,
+// bounded by sharedCheckTimeout; a caller whose context is done stops waiting for it.
+func (lc *LinkChecker) runShared(ctx context.Context, key string, fn func(ctx context.Context) any) (any, error) {
+	shared := lc.inflight.DoChan(key, func() (any, error) {
+		sharedCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), lc.sharedCheckTimeout())
+		defer cancel()
+
+		return fn(sharedCtx), nil
+	})
+
+	select {
+	case outcome := <-shared:
+		return outcome.Val, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// sharedCheckTimeout bounds a shared check: every attempt of the request may take the request
+// timeout, and the host may ask for one pause of up to MaxRetryAfter.
+func (lc *LinkChecker) sharedCheckTimeout() time.Duration {
+	return lc.config.Timeout*time.Duration(lc.config.Retries+1) + lc.config.MaxRetryAfter
+}
+
+// cachedLinkCheck returns the cached result of a link, marked as cached.
+func (lc *LinkChecker) cachedLinkCheck(ctx context.Context, linkURL string) (*domain.LinkCheckResult, bool) {
+	if lc.cache == nil {
+		return nil, false
+	}
+
+	cachedResult, err := lc.cache.FindLinkCheck(ctx, linkURL)
+	lc.metrics.RecordLinkCheckCache(ctx, err == nil)
+
+	if err != nil {
+		return nil, false
+	}
+
+	cachedResult.Cached = true
+
+	return cachedResult, true
+}
+
+func (lc *LinkChecker) saveLinkCheck(ctx context.Context, result domain.LinkCheckResult) {
+	if lc.cache == nil {
+		return
+	}
+
+	if err := lc.cache.SaveLinkCheck(ctx, result); err != nil {
+		lc.logger.Warn().
+			Err(err).
+			Str("url", result.URL).
+			Msg("Failed to cache link check result")
+	}
+}
+
+func (lc *LinkChecker) waitAndCheck(ctx context.Context, link domain.Link, anchors *documentAnchors, host *hostState, limiter *rate.Limiter) (domain.LinkCheckResult, bool) {
+	if limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			return domain.LinkCheckResult{URL: link.URL, Error: err.Error()}, false
+		}
+	}
+
+	return lc.checkSingleLink(ctx, link, anchors, host)
+}
+
+// checkSingleLink checks a link and reports whether the result says something about the link
+// itself, rather than about an open circuit breaker, throttling or a cancelled analysis. The
+// fragments of the document are collected into anchors when they are given.
+func (lc *LinkChecker) checkSingleLink(ctx context.Context, link domain.Link, anchors *documentAnchors, host *hostState) (domain.LinkCheckResult, bool) {
+	startTime := time.Now()
+
+	checkResult, err := lc.checkThroughBreaker(ctx, link, anchors, host)
 
 	duration := time.Since(startTime)
 
@@ -175,66 +533,294 @@ func (lc *LinkChecker) checkSingleLink(ctx context.Context, link domain.Link) *d
 		lc.metrics.RecordLinkCheck(ctx, false, string(link.Type))
 
 		if errors.Is(err, gobreaker.ErrOpenState) {
-			return &domain.InaccessibleLink{
+			return domain.LinkCheckResult{
 				URL:        link.URL,
 				StatusCode: 503,
 				Error:      "Service temporarily unavailable (circuit breaker open)",
-			}
+				CheckedAt:  startTime,
+			}, false
 		}
 
-		return &domain.InaccessibleLink{
-			URL:        link.URL,
-			StatusCode: 0,
-			Error:      err.Error(),
-		}
+		return domain.LinkCheckResult{
+			URL:       link.URL,
+			Error:     err.Error(),
+			CheckedAt: startTime,
+		}, ctx.Err() == nil
 	}
 
-	checkResult := result.(*linkCheckResult)
-
 	lc.logger.Debug().
 		Str("url", link.URL).
 		Int("status_code", checkResult.StatusCode).
 		Int64("duration_ms", duration.Milliseconds()).
 		Msg("link check completed")
 
-	if checkResult.StatusCode >= 400 {
-		lc.metrics.RecordLinkCheck(ctx, false, string(link.Type))
+	result := domain.LinkCheckResult{
+		URL:           link.URL,
+		StatusCode:    checkResult.StatusCode,
+		Error:         checkResult.Error,
+		Redirects:     checkResult.Redirects,
+		RedirectLoop:  checkResult.RedirectLoop,
+		Soft404Reason: checkResult.Soft404Reason,
+		CheckedAt:     startTime,
+	}
+
+	lc.metrics.RecordLinkCheck(ctx, result.Accessible(), string(link.Type))
 
-		return &domain.InaccessibleLink{
-			URL:        link.URL,
-			StatusCode: checkResult.StatusCode,
-			Error:      checkResult.Error,
+	return result, checkResult.StatusCode != http.StatusTooManyRequests && checkResult.RetryAfter == 0
+}
+
+// checkThroughBreaker checks a link through the circuit breaker of its host. A 429 or 503 with a
+// Retry-After pauses the host for at most MaxRetryAfter, and the link is checked once more when the
+// server asked for no longer than that.
+func (lc *LinkChecker) checkThroughBreaker(ctx context.Context, link domain.Link, anchors *documentAnchors, host *hostState) (*linkCheckResult, error) {
+	for attempt := 0; ; attempt++ {
+		result, err := host.breaker.Execute(func() (any, error) {
+			return lc.performLinkCheck(ctx, link, anchors)
+		})
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	lc.metrics.RecordLinkCheck(ctx, true, string(link.Type))
+		checkResult := result.(*linkCheckResult)
+		if checkResult.RetryAfter <= 0 {
+			return checkResult, nil
+		}
 
-	return nil
+		host.pause(min(checkResult.RetryAfter, lc.config.MaxRetryAfter))
+
+		if attempt > 0 || checkResult.RetryAfter > lc.config.MaxRetryAfter {
+			return checkResult, nil
+		}
+
+		lc.logger.Debug().
+			Str("url", link.URL).
+			Int("status_code", checkResult.StatusCode).
+			Dur("retry_after", checkResult.RetryAfter).
+			Msg("Link check throttled, waiting for Retry-After")
+
+		if err := host.waitTurn(ctx, lc.config.PerHostDelay); err != nil {
+			return nil, err
+		}
+	}
 }
 
-func (lc *LinkChecker) performLinkCheck(ctx context.Context, linkURL string) (*linkCheckResult, error) {
-	// Use HEAD request first for efficiency
-	resp, err := lc.client.R().
-		SetContext(ctx).
-		Head(linkURL)
+// performLinkCheck requests a link. A link whose content is needed, to spot a soft 404 or to
+// collect the fragments of the document into anchors, is fetched with a single capped GET request
+// rather than a HEAD request followed by a GET.
+func (lc *LinkChecker) performLinkCheck(ctx context.Context, link domain.Link, anchors *documentAnchors) (*linkCheckResult, error) {
+	var (
+		resp         *resty.Response
+		body         []byte
+		bodyComplete bool
+		err          error
+	)
+
+	fetchContent := anchors != nil || lc.config.DetectSoft404
+
+	redirects := &redirectRecorder{}
+	if !fetchContent {
+		// Use HEAD request first for efficiency
+		resp, err = lc.client.R().
+			SetContext(withRedirectRecorder(ctx, redirects)).
+			Head(link.URL)
+	}
 
-	if err != nil {
+	if fetchContent || err != nil {
 		// If HEAD fails, try the GET request
-		resp, err = lc.client.R().
-			SetContext(ctx).
-			Get(linkURL)
+		limit := lc.config.Soft404MaxBodyBytes
+		if anchors != nil {
+			limit = lc.config.FragmentMaxBodyBytes
+		}
+
+		redirects = &redirectRecorder{}
+		resp, body, bodyComplete, err = lc.fetchPage(withRedirectRecorder(ctx, redirects), link.URL, limit)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	finalURL := link.URL
+	if resp.RawResponse != nil && resp.RawResponse.Request != nil {
+		finalURL = resp.RawResponse.Request.URL.String()
+	}
+
 	result := &linkCheckResult{
-		StatusCode: resp.StatusCode(),
+		StatusCode:   resp.StatusCode(),
+		Redirects:    redirects.chain(finalURL),
+		RedirectLoop: redirects.loop,
 	}
 
-	if resp.StatusCode() >= http.StatusBadRequest {
+	switch {
+	case redirects.loop:
+		result.Error = "redirect loop"
+	case redirects.exceeded:
+		result.Error = fmt.Sprintf("stopped after %d redirects", lc.config.MaxRedirects)
+	case resp.StatusCode() >= http.StatusBadRequest:
 		result.Error = resp.Status()
 	}
 
+	if resp.StatusCode() == http.StatusTooManyRequests || resp.StatusCode() == http.StatusServiceUnavailable {
+		if retryAfter, ok := parseRetryAfter(resp.Header().Get("Retry-After"), time.Now()); ok {
+			result.RetryAfter = retryAfter
+		}
+	}
+
+	if resp.StatusCode() != http.StatusOK || !isHTMLContentType(resp.Header().Get("Content-Type")) {
+		return result, nil
+	}
+
+	if lc.config.DetectSoft404 {
+		result.Soft404Reason = soft404Reason(finalURL, body)
+	}
+
+	// A fragment missing from a cut off document may well be further down.
+	if anchors != nil && bodyComplete {
+		if doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body)); err == nil {
+			anchors.targets = documentAnchorTargets(doc)
+		}
+	}
+
 	return result, nil
 }
+
+// fetchPage gets a page and reads at most limit bytes of its body, reporting whether that was the
+// whole body.
+func (lc *LinkChecker) fetchPage(ctx context.Context, linkURL string, limit int64) (*resty.Response, []byte, bool, error) {
+	resp, err := lc.client.R().
+		SetContext(ctx).
+		SetDoNotParseResponse(true).
+		Get(linkURL)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	rawBody := resp.RawBody()
+	defer rawBody.Close()
+
+	limit = max(limit, 0)
+
+	body, err := io.ReadAll(io.LimitReader(rawBody, limit+1))
+	if err != nil {
+		return resp, nil, false, nil
+	}
+
+	if int64(len(body)) > limit {
+		return resp, body[:limit], false, nil
+	}
+
+	return resp, body, true, nil
+}
+
+// fragmentToCheck returns the fragment of an internal link that should match an element of the
+// target document, or an empty string when the link is not checked for one.
+func (lc *LinkChecker) fragmentToCheck(link domain.Link) string {
+	if !lc.config.CheckFragments || link.Type != domain.LinkTypeInternal {
+		return ""
+	}
+
+	linkURL, err := url.Parse(link.URL)
+	if err != nil {
+		return ""
+	}
+
+	return checkableFragment(linkURL)
+}
+
+// derive returns the result of every link of the group from the result of its document. A link
+// whose fragment is checked misses it when the document was read whole without an element the
+// fragment matches; anchors are only collected for groups whose fragments are checked.
+func (g linkGroup) derive(result domain.LinkCheckResult, anchors anchorTargets) []checkedLink {
+	checked := make([]checkedLink, 0, len(g.links))
+
+	for _, link := range g.links {
+		linkResult := result
+		linkResult.URL = link.URL
+
+		if linkURL, err := url.Parse(link.URL); err == nil && anchors != nil {
+			if fragment := checkableFragment(linkURL); fragment != "" {
+				linkResult.MissingFragment = !anchors[fragment]
+			}
+		}
+
+		checked = append(checked, checkedLink{link: link, result: linkResult})
+	}
+
+	return checked
+}
+
+// linkIssues reports a link that redirects in a loop, from HTTPS to HTTP or through a long chain,
+// that looks like a not found page while answering 200, whose fragment the target document lacks
+// or that robots.txt kept from being checked.
+func (lc *LinkChecker) linkIssues(link domain.Link, result domain.LinkCheckResult) []domain.LinkIssue {
+	var issues []domain.LinkIssue
+
+	newIssue := func(code string, severity domain.Severity, message string) domain.LinkIssue {
+		return domain.LinkIssue{
+			URL:       link.URL,
+			Code:      code,
+			Severity:  severity,
+			Message:   message,
+			Scope:     link.Type,
+			Text:      link.Text,
+			Redirects: result.Redirects,
+		}
+	}
+
+	if chain := result.Redirects; chain != nil {
+		if result.RedirectLoop {
+			issues = append(issues, newIssue(domain.LinkIssueRedirectLoop, domain.SeverityError,
+				fmt.Sprintf("redirects in a loop back to %s", chain.FinalURL)))
+		} else if lc.config.LongRedirectChain > 0 && chain.HopCount > lc.config.LongRedirectChain {
+			issues = append(issues, newIssue(domain.LinkIssueLongRedirectChain, domain.SeverityWarning,
+				fmt.Sprintf("takes %d redirects to reach %s", chain.HopCount, chain.FinalURL)))
+		}
+
+		for _, hop := range chain.Hops {
+			if strings.HasPrefix(hop.URL, "https://") && strings.HasPrefix(hop.Location, "http://") {
+				issues = append(issues, newIssue(domain.LinkIssueHTTPSDowngrade, domain.SeverityWarning,
+					fmt.Sprintf("redirects from HTTPS to HTTP at %s", hop.URL)))
+
+				break
+			}
+		}
+	}
+
+	if result.Soft404Reason != "" {
+		issues = append(issues, newIssue(domain.LinkIssueSoft404, domain.SeverityWarning,
+			"answers 200 but looks like a not found page: "+result.Soft404Reason))
+	}
+
+	if result.MissingFragment {
+		if linkURL, err := url.Parse(link.URL); err == nil {
+			issue := danglingAnchorIssue(link, linkURL.Fragment, withoutFragment(linkURL))
+			issue.Redirects = result.Redirects
+
+			issues = append(issues, issue)
+		}
+	}
+
+	if result.RobotsDisallowed {
+		issues = append(issues, newIssue(domain.LinkIssueRobotsDisallowed, domain.SeverityInfo,
+			"not checked because robots.txt disallows it"))
+	}
+
+	return issues
+}
+
+func newLinkCheckReport() domain.LinkCheckReport {
+	return domain.LinkCheckReport{
+		InaccessibleLinks: []domain.InaccessibleLink{},
+		Issues:            []domain.LinkIssue{},
+		Results:           []domain.LinkCheckResult{},
+	}
+}
+
+// linkHostname is the lower cased host a link points at, which its checks are paced by.
+func linkHostname(linkURL string) string {
+	parsedURL, err := url.Parse(linkURL)
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToLower(parsedURL.Hostname())
+}
@@ -0,0 +1,184 @@
+package adapters
+
+import (
+	"container/list"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sony/gobreaker"
+)
+
+type (
+	// hostRegistry keeps the state of the most recently checked hosts, evicting the least recently
+	// used host that has no check in flight once there are more than capacity.
+	hostRegistry struct {
+		mu       sync.Mutex
+		capacity int
+		hosts    map[string]*list.Element
+		recency  *list.List
+		newHost  func(name string) *hostState
+		onEvict  func(host *hostState)
+	}
+
+	// hostState is the circuit breaker, concurrency slots and request pacing of one host.
+	hostState struct {
+		name    string
+		breaker *gobreaker.CircuitBreaker
+		slots   chan struct{}
+
+		mu          sync.Mutex
+		nextStart   time.Time
+		pausedUntil time.Time
+
+		// inFlight is guarded by hostRegistry.mu.
+		inFlight int
+	}
+)
+
+func newHostRegistry(capacity int, newHost func(name string) *hostState, onEvict func(host *hostState)) *hostRegistry {
+	return &hostRegistry{
+		capacity: max(capacity, 1),
+		hosts:    make(map[string]*list.Element),
+		recency:  list.New(),
+		newHost:  newHost,
+		onEvict:  onEvict,
+	}
+}
+
+// acquire takes a concurrency slot of the host and waits for its turn, which comes delay after
+// the previous request to the host started and not before a pause the host asked for is over.
+func (r *hostRegistry) acquire(ctx context.Context, name string, delay time.Duration) (*hostState, error) {
+	host := r.checkout(name)
+
+	select {
+	case host.slots <- struct{}{}:
+	case <-ctx.Done():
+		r.checkin(host)
+
+		return nil, ctx.Err()
+	}
+
+	if err := host.waitTurn(ctx, delay); err != nil {
+		r.release(host)
+
+		return nil, err
+	}
+
+	return host, nil
+}
+
+// release gives back the concurrency slot taken by acquire.
+func (r *hostRegistry) release(host *hostState) {
+	<-host.slots
+	r.checkin(host)
+}
+
+func (r *hostRegistry) checkout(name string) *hostState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	element, ok := r.hosts[name]
+	if ok {
+		r.recency.MoveToFront(element)
+	} else {
+		element = r.recency.PushFront(r.newHost(name))
+		r.hosts[name] = element
+	}
+
+	host := element.Value.(*hostState)
+	host.inFlight++
+
+	r.evict()
+
+	return host
+}
+
+func (r *hostRegistry) checkin(host *hostState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	host.inFlight--
+
+	r.evict()
+}
+
+func (r *hostRegistry) evict() {
+	for element := r.recency.Back(); element != nil && r.recency.Len() > r.capacity; {
+		previous := element.Prev()
+
+		if host := element.Value.(*hostState); host.inFlight == 0 {
+			r.recency.Remove(element)
+			delete(r.hosts, host.name)
+
+			if r.onEvict != nil {
+				r.onEvict(host)
+			}
+		}
+
+		element = previous
+	}
+}
+
+func (h *hostState) waitTurn(ctx context.Context, delay time.Duration) error {
+	h.mu.Lock()
+
+	start := time.Now()
+	if h.nextStart.After(start) {
+		start = h.nextStart
+	}
+
+	if h.pausedUntil.After(start) {
+		start = h.pausedUntil
+	}
+
+	h.nextStart = start.Add(delay)
+	h.mu.Unlock()
+
+	wait := time.Until(start)
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// pause holds back the requests to the host that have not been scheduled yet for d.
+func (h *hostState) pause(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(h.pausedUntil) {
+		h.pausedUntil = until
+	}
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(date.Sub(now), 0), true
+}
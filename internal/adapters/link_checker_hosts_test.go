@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostRegistry_EvictsLeastRecentlyUsedIdleHosts(t *testing.T) {
+	t.Parallel()
+
+	var evicted []string
+
+	registry := newHostRegistry(2, func(name string) *hostState {
+		return &hostState{
+			name:    name,
+			breaker: gobreaker.NewCircuitBreaker(gobreaker.Settings{Name: name}),
+			slots:   make(chan struct{}, 1),
+		}
+	}, func(host *hostState) {
+		evicted = append(evicted, host.name)
+	})
+
+	busy, err := registry.acquire(t.Context(), "busy.example.com", 0)
+	require.NoError(t, err)
+
+	idle, err := registry.acquire(t.Context(), "idle.example.com", 0)
+	require.NoError(t, err)
+	registry.release(idle)
+
+	newest, err := registry.acquire(t.Context(), "new.example.com", 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"idle.example.com"}, evicted, "Should evict the idle host, not the one with a check in flight")
+
+	registry.release(newest)
+	registry.release(busy)
+
+	again, err := registry.acquire(t.Context(), "busy.example.com", 0)
+	require.NoError(t, err)
+	registry.release(again)
+
+	assert.Same(t, busy, again, "Should keep the state of a tracked host")
+	assert.Len(t, registry.hosts, 2)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 16, 9, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{name: "Seconds", value: "30", expected: 30 * time.Second, ok: true},
+		{name: "HTTP date", value: now.Add(90 * time.Second).Format(http.TimeFormat), expected: 90 * time.Second, ok: true},
+		{name: "Date in the past", value: now.Add(-time.Minute).Format(http.TimeFormat), expected: 0, ok: true},
+		{name: "Negative seconds", value: "-5", expected: 0, ok: true},
+		{name: "Empty", value: "", ok: false},
+		{name: "Garbage", value: "soon", ok: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			retryAfter, ok := parseRetryAfter(tc.value, now)
+
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, retryAfter)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/go-resty/resty/v2"
+)
+
+// soft404TextLimit is the length of page text below which a not found phrase in the text marks
+// the page as a soft 404. Longer pages mention such phrases in passing too often.
+const soft404TextLimit = 2000
+
+var (
+	// notFoundTitlePattern matches the titles and main headings of not found pages in common
+	// languages.
+	notFoundTitlePattern = regexp.MustCompile(`(?i)\b404\b|not found|page (?:does not|doesn't) exist|no longer (?:exists|available)|nicht gefunden|introuvable|no encontrad[ao]|non trovat[ao]|niet gevonden|não encontrad[ao]`)
+
+	// notFoundTextPattern matches the phrases not found pages explain themselves with.
+	notFoundTextPattern = regexp.MustCompile(`(?i)\b(?:page|file|content|article|product|post|resource)\b[^.!?]{0,40}\b(?:not (?:be )?found|does ?n[o']t exist|no longer exists)|\berror 404\b|\b404 not found\b`)
+
+	// notFoundPathPattern matches the URLs sites redirect missing pages to.
+	notFoundPathPattern = regexp.MustCompile(`(?i)/(?:404|not[-_]?found|page[-_]?not[-_]?found)(?:\.[a-z]+)?/?$`)
+)
+
+type (
+	redirectRecorderKey struct{}
+
+	// redirectRecorder collects the redirects of one request.
+	redirectRecorder struct {
+		hops     []domain.RedirectHop
+		loop     bool
+		exceeded bool
+	}
+)
+
+func withRedirectRecorder(ctx context.Context, recorder *redirectRecorder) context.Context {
+	return context.WithValue(ctx, redirectRecorderKey{}, recorder)
+}
+
+// resetRedirectRecorder runs before every attempt of a request, so that the redirects an attempt
+// followed before it failed and was retried are not recorded twice.
+func resetRedirectRecorder(_ *resty.Client, req *resty.Request) error {
+	if recorder, ok := req.Context().Value(redirectRecorderKey{}).(*redirectRecorder); ok {
+		*recorder = redirectRecorder{}
+	}
+
+	return nil
+}
+
+// recordRedirect is the redirect policy of the link checker. It records every hop on the recorder
+// of the request, and stops at a URL that was visited before or after maxRedirects hops, keeping
+// the last redirect response as the outcome.
+func recordRedirect(req *http.Request, via []*http.Request, maxRedirects int) error {
+	recorder, ok := req.Context().Value(redirectRecorderKey{}).(*redirectRecorder)
+	if !ok {
+		if len(via) > maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+
+		return nil
+	}
+
+	hop := domain.RedirectHop{
+		URL:      via[len(via)-1].URL.String(),
+		Location: req.URL.String(),
+	}
+	if req.Response != nil {
+		hop.StatusCode = req.Response.StatusCode
+	}
+
+	recorder.hops = append(recorder.hops, hop)
+
+	for _, visited := range via {
+		if visited.URL.String() == req.URL.String() {
+			recorder.loop = true
+
+			return http.ErrUseLastResponse
+		}
+	}
+
+	if len(via) > maxRedirects {
+		recorder.exceeded = true
+
+		return http.ErrUseLastResponse
+	}
+
+	return nil
+}
+
+// chain returns the recorded redirects, or nil when the request was not redirected. A request
+// stopped at a loop or the redirect limit ends on the target of its last hop.
+func (r *redirectRecorder) chain(finalURL string) *domain.RedirectChain {
+	if len(r.hops) == 0 {
+		return nil
+	}
+
+	if r.loop || r.exceeded {
+		finalURL = r.hops[len(r.hops)-1].Location
+	}
+
+	return &domain.RedirectChain{
+		Hops:     r.hops,
+		HopCount: len(r.hops),
+		FinalURL: finalURL,
+	}
+}
+
+func isHTMLContentType(contentType string) bool {
+	if contentType == "" {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
+
+// soft404Reason explains why a page that answered 200 looks like a not found page: the URL it
+// ended on, its title or main heading, or the short text of the page. It returns an empty string
+// when the page looks like a real one.
+func soft404Reason(finalURL string, body []byte) string {
+	if parsedURL, err := url.Parse(finalURL); err == nil && notFoundPathPattern.MatchString(parsedURL.Path) {
+		return fmt.Sprintf("ends on %s", finalURL)
+	}
+
+	if len(body) == 0 {
+		return ""
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	if title := normalizeText(doc.Find("title").First().Text()); notFoundTitlePattern.MatchString(title) {
+		return fmt.Sprintf("title %q", title)
+	}
+
+	if heading := normalizeText(doc.Find("h1").First().Text()); notFoundTitlePattern.MatchString(heading) {
+		return fmt.Sprintf("heading %q", heading)
+	}
+
+	doc.Find("script, style, noscript, template").Remove()
+
+	text := normalizeText(doc.Find("body").Text())
+	if len(text) <= soft404TextLimit {
+		if phrase := notFoundTextPattern.FindString(text); phrase != "" {
+			return fmt.Sprintf("text %q", normalizeText(phrase))
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,85 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSoft404Reason(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		finalURL string
+		body     string
+		expected string
+	}{
+		{
+			name:     "Regular page",
+			finalURL: "https://example.com/products",
+			body:     "<html><head><title>Products</title></head><body><h1>Products</h1><p>Browse our catalogue.</p></body></html>",
+		},
+		{
+			name:     "Redirected to a not found path",
+			finalURL: "https://example.com/errors/page-not-found/?from=%2Fold",
+			expected: "ends on https://example.com/errors/page-not-found/?from=%2Fold",
+		},
+		{
+			name:     "Not found title",
+			finalURL: "https://example.com/old",
+			body:     "<html><head><title>404 - Example</title></head><body></body></html>",
+			expected: `title "404 - Example"`,
+		},
+		{
+			name:     "Localised heading",
+			finalURL: "https://example.de/alt",
+			body:     "<html><head><title>Example</title></head><body><h1>Seite nicht gefunden</h1></body></html>",
+			expected: `heading "Seite nicht gefunden"`,
+		},
+		{
+			name:     "Short text explaining the page is missing",
+			finalURL: "https://example.com/item/7",
+			body:     "<html><head><title>Shop</title></head><body><p>Sorry, the product you asked for could not be found.</p></body></html>",
+			expected: `text "product you asked for could not be found"`,
+		},
+		{
+			name:     "Phrase in a long article",
+			finalURL: "https://example.com/blog/errors",
+			body: "<html><head><title>Handling errors</title></head><body><p>" +
+				repeatText("Our guide explains how to deal with failing requests. ", 50) +
+				"When the page is not found the server answers 404.</p></body></html>",
+		},
+		{
+			name:     "Phrase in a script",
+			finalURL: "https://example.com/app",
+			body:     `<html><head><title>App</title></head><body><script>var m = "page not found";</script></body></html>`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, soft404Reason(tc.finalURL, []byte(tc.body)))
+		})
+	}
+}
+
+func TestIsHTMLContentType(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isHTMLContentType("text/html; charset=utf-8"))
+	assert.True(t, isHTMLContentType("application/xhtml+xml"))
+	assert.False(t, isHTMLContentType("text/plain; charset=utf-8"))
+	assert.False(t, isHTMLContentType(""))
+}
+
+func repeatText(text string, count int) string {
+	var repeated string
+	for range count {
+		repeated += text
+	}
+
+	return repeated
+}
@@ -2,12 +2,16 @@ package adapters
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -19,6 +23,37 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// memoryLinkCheckCache keeps link check results in memory for the tests.
+type memoryLinkCheckCache struct {
+	mu      sync.Mutex
+	results map[string]domain.LinkCheckResult
+}
+
+func newMemoryLinkCheckCache() *memoryLinkCheckCache {
+	return &memoryLinkCheckCache{results: make(map[string]domain.LinkCheckResult)}
+}
+
+func (c *memoryLinkCheckCache) FindLinkCheck(_ context.Context, linkURL string) (*domain.LinkCheckResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result, ok := c.results[linkURL]
+	if !ok {
+		return nil, domain.ErrCacheUnavailable
+	}
+
+	return &result, nil
+}
+
+func (c *memoryLinkCheckCache) SaveLinkCheck(_ context.Context, result domain.LinkCheckResult) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.results[result.URL] = result
+
+	return nil
+}
+
 type LinkCheckerTestSuite struct {
 	linkChecker *LinkChecker
 	logger      infrastructure.Logger
@@ -41,6 +76,15 @@ func newLinkCheckerTestSuite(t *testing.T) *LinkCheckerTestSuite {
 			Interval:    5 * time.Second,
 			Timeout:     30 * time.Second,
 		},
+		MaxTrackedHosts:            100,
+		MaxConcurrentChecksPerHost: 10,
+		MaxRetryAfter:              2 * time.Second,
+		MaxRedirects:               5,
+		LongRedirectChain:          3,
+		DetectSoft404:              true,
+		Soft404MaxBodyBytes:        64 * 1024,
+		CheckFragments:             true,
+		FragmentMaxBodyBytes:       1024 * 1024,
 	}
 
 	return &LinkCheckerTestSuite{
@@ -52,7 +96,7 @@ func newLinkCheckerTestSuite(t *testing.T) *LinkCheckerTestSuite {
 }
 
 func (suite *LinkCheckerTestSuite) SetupTest() {
-	suite.linkChecker = NewLinkChecker(suite.config, suite.logger, suite.metrics)
+	suite.linkChecker = NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, nil, nil, suite.logger, suite.metrics)
 	suite.testServers = make([]*httptest.Server, 0)
 }
 
@@ -77,7 +121,7 @@ func (suite *LinkCheckerTestSuite) createTestServer(handler http.HandlerFunc) *h
 func (suite *LinkCheckerTestSuite) TestNewLinkChecker() {
 	require.NotNil(suite.t, suite.linkChecker)
 	require.NotNil(suite.t, suite.linkChecker.client)
-	require.NotNil(suite.t, suite.linkChecker.circuitBreaker)
+	require.NotNil(suite.t, suite.linkChecker.hosts)
 	require.NotNil(suite.t, suite.linkChecker.logger)
 	assert.Equal(suite.t, suite.config, suite.linkChecker.config)
 }
@@ -153,7 +197,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_SuccessfulLinks() {
 			ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
 			defer cancel()
 
-			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links)
+			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 
 			if tc.expectError {
 				require.Len(t, inaccessibleLinks, 1)
@@ -260,7 +304,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_ErrorResponses() {
 			ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
 			defer cancel()
 
-			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links)
+			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 
 			if tc.expectError {
 				require.Len(t, inaccessibleLinks, 1)
@@ -327,7 +371,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_NetworkErrors() {
 			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
 			defer cancel()
 
-			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links)
+			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 
 			if tc.expectError {
 				require.Len(t, inaccessibleLinks, 1)
@@ -438,7 +482,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_LinkFiltering() {
 			ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
 			defer cancel()
 
-			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links)
+			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 
 			assert.Len(t, inaccessibleLinks, tc.expectedCount, tc.description)
 		})
@@ -490,7 +534,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_InaccessibleURLs() {
 			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
 			defer cancel()
 
-			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links)
+			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 
 			require.Len(t, inaccessibleLinks, tc.expectedCount, tc.description)
 			if len(inaccessibleLinks) > 0 {
@@ -505,7 +549,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_InaccessibleURLs() {
 func (suite *LinkCheckerTestSuite) TestCheckAccessibility_ConcurrencyLimits() {
 	suite.config.MaxConcurrentChecks = 2 // Limit to 2 concurrent checks
 	// Recreate linkChecker with updated config
-	suite.linkChecker = NewLinkChecker(suite.config, suite.logger, suite.metrics)
+	suite.linkChecker = NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, nil, nil, suite.logger, suite.metrics)
 
 	var activeConnections int32
 	var maxActiveConnections int32
@@ -542,7 +586,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_ConcurrencyLimits() {
 	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
 	defer cancel()
 
-	inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links)
+	inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 
 	assert.Len(suite.t, inaccessibleLinks, 0, "All links should be accessible")
 
@@ -558,7 +602,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_ConcurrencyLimits() {
 func (suite *LinkCheckerTestSuite) TestCheckAccessibility_MaxLinksLimit() {
 	suite.config.MaxLinksToCheck = 3 // Limit to 3 links
 	// Recreate linkChecker with updated config
-	suite.linkChecker = NewLinkChecker(suite.config, suite.logger, suite.metrics)
+	suite.linkChecker = NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, nil, nil, suite.logger, suite.metrics)
 
 	var requestCount int32
 	var mu sync.Mutex
@@ -584,7 +628,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_MaxLinksLimit() {
 	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
 	defer cancel()
 
-	inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links)
+	inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 
 	assert.Len(suite.t, inaccessibleLinks, 0, "All checked links should be accessible")
 
@@ -595,6 +639,118 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_MaxLinksLimit() {
 	assert.LessOrEqual(suite.t, int(actualRequests), 3, "Should not check more than max links limit")
 }
 
+// TestCheckAccessibility_LinkScopes tests which link types each scope checks
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_LinkScopes() {
+	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	links := []domain.Link{
+		{URL: server.URL + "/internal", Type: domain.LinkTypeInternal},
+		{URL: server.URL + "/external", Type: domain.LinkTypeExternal},
+	}
+
+	cases := []struct {
+		name     string
+		scope    domain.LinkScope
+		expected map[string]domain.LinkType
+	}{
+		{
+			name:     "Empty scope checks external links",
+			expected: map[string]domain.LinkType{server.URL + "/external": domain.LinkTypeExternal},
+		},
+		{
+			name:     "External scope",
+			scope:    domain.LinkScopeExternal,
+			expected: map[string]domain.LinkType{server.URL + "/external": domain.LinkTypeExternal},
+		},
+		{
+			name:     "Internal scope",
+			scope:    domain.LinkScopeInternal,
+			expected: map[string]domain.LinkType{server.URL + "/internal": domain.LinkTypeInternal},
+		},
+		{
+			name:  "Both scopes",
+			scope: domain.LinkScopeAll,
+			expected: map[string]domain.LinkType{
+				server.URL + "/internal": domain.LinkTypeInternal,
+				server.URL + "/external": domain.LinkTypeExternal,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+			defer cancel()
+
+			inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links, tc.scope).InaccessibleLinks
+
+			scopes := make(map[string]domain.LinkType)
+			for _, link := range inaccessibleLinks {
+				assert.Equal(t, http.StatusNotFound, link.StatusCode)
+				scopes[link.URL] = link.Scope
+			}
+
+			assert.Equal(t, tc.expected, scopes)
+		})
+	}
+}
+
+// TestCheckAccessibility_InternalLimits tests that internal links get their own concurrency and rate limit
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_InternalLimits() {
+	suite.config.MaxConcurrentChecks = 10
+	suite.config.InternalMaxConcurrentChecks = 1
+	suite.config.InternalRequestsPerSecond = 20
+	suite.linkChecker = NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, nil, nil, suite.logger, suite.metrics)
+
+	var (
+		activeConnections    int32
+		maxActiveConnections int32
+		mu                   sync.Mutex
+	)
+
+	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		activeConnections++
+		maxActiveConnections = max(maxActiveConnections, activeConnections)
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		activeConnections--
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	links := make([]domain.Link, 5)
+	for i := range links {
+		links[i] = domain.Link{
+			URL:  fmt.Sprintf("%s/page%d", server.URL, i),
+			Type: domain.LinkTypeInternal,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeInternal).InaccessibleLinks
+	elapsed := time.Since(start)
+
+	assert.Empty(suite.t, inaccessibleLinks, "All links should be accessible")
+
+	mu.Lock()
+	maxActive := maxActiveConnections
+	mu.Unlock()
+
+	assert.Equal(suite.t, int32(1), maxActive, "Should check internal links one at a time")
+	// The limiter lets the first request through at once and spaces the other four by 50ms.
+	assert.GreaterOrEqual(suite.t, elapsed, 200*time.Millisecond, "Should pace internal links")
+}
+
 // TestCheckAccessibility_CircuitBreaker tests circuit breaker functionality
 func (suite *LinkCheckerTestSuite) TestCheckAccessibility_CircuitBreaker() {
 	// Configure circuit breaker to open quickly for testing
@@ -604,7 +760,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_CircuitBreaker() {
 	// Middleware a very short timeout to trigger network errors
 	suite.config.Timeout = 50 * time.Millisecond
 	// Recreate linkChecker with updated config
-	suite.linkChecker = NewLinkChecker(suite.config, suite.logger, suite.metrics)
+	suite.linkChecker = NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, nil, nil, suite.logger, suite.metrics)
 
 	// Create a server that responds very slowly to trigger timeout errors
 	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -625,7 +781,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_CircuitBreaker() {
 	// to trigger the open state. Make enough timeout requests to trigger it.
 	var lastResult []domain.InaccessibleLink
 	for i := 0; i < 8; i++ { // Increase attempts to ensure circuit breaker opens
-		inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links)
+		inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 		lastResult = inaccessibleLinks
 		require.Len(suite.t, inaccessibleLinks, 1)
 		assert.Equal(suite.t, server.URL, inaccessibleLinks[0].URL)
@@ -665,6 +821,847 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_CircuitBreaker() {
 	}
 }
 
+// TestCheckAccessibility_PerHostCircuitBreakers tests that an open breaker only affects its own host
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_PerHostCircuitBreakers() {
+	suite.config.Timeout = 50 * time.Millisecond
+	suite.config.Retries = 0
+	suite.linkChecker = NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, nil, nil, suite.logger, suite.metrics)
+
+	slowServer := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	healthyServer := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	// Both servers listen on 127.0.0.1, so the healthy one is addressed by another host name.
+	slowLink := domain.Link{URL: slowServer.URL, Type: domain.LinkTypeExternal}
+	healthyLink := domain.Link{URL: strings.Replace(healthyServer.URL, "127.0.0.1", "localhost", 1), Type: domain.LinkTypeExternal}
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 5*time.Second)
+	defer cancel()
+
+	for range 5 {
+		inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, []domain.Link{slowLink}, domain.LinkScopeExternal).InaccessibleLinks
+		require.Len(suite.t, inaccessibleLinks, 1)
+		assert.Equal(suite.t, 0, inaccessibleLinks[0].StatusCode)
+	}
+
+	inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, []domain.Link{slowLink, healthyLink}, domain.LinkScopeExternal).InaccessibleLinks
+
+	require.Len(suite.t, inaccessibleLinks, 1, "Only the link of the failing host should be reported")
+	assert.Equal(suite.t, slowLink.URL, inaccessibleLinks[0].URL)
+	assert.Equal(suite.t, http.StatusServiceUnavailable, inaccessibleLinks[0].StatusCode)
+	assert.Contains(suite.t, inaccessibleLinks[0].Error, "circuit breaker open")
+}
+
+// TestCheckAccessibility_PerHostLimits tests the per-host concurrency and politeness delay
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_PerHostLimits() {
+	suite.config.MaxConcurrentChecks = 10
+	suite.config.MaxConcurrentChecksPerHost = 1
+	suite.config.PerHostDelay = 50 * time.Millisecond
+	suite.linkChecker = NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, nil, nil, suite.logger, suite.metrics)
+
+	var (
+		activeConnections    int32
+		maxActiveConnections int32
+		mu                   sync.Mutex
+	)
+
+	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		activeConnections++
+		maxActiveConnections = max(maxActiveConnections, activeConnections)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		activeConnections--
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	links := make([]domain.Link, 5)
+	for i := range links {
+		links[i] = domain.Link{
+			URL:  fmt.Sprintf("%s/page%d", server.URL, i),
+			Type: domain.LinkTypeExternal,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
+	elapsed := time.Since(start)
+
+	assert.Empty(suite.t, inaccessibleLinks, "All links should be accessible")
+
+	mu.Lock()
+	maxActive := maxActiveConnections
+	mu.Unlock()
+
+	assert.Equal(suite.t, int32(1), maxActive, "Should check the links of one host one at a time")
+	// The first request starts at once and the other four follow 50ms apart.
+	assert.GreaterOrEqual(suite.t, elapsed, 200*time.Millisecond, "Should space requests to one host")
+}
+
+// TestCheckAccessibility_PerHostDelayWhileQueued tests that the links of a host are spaced by its
+// delay even when they waited for a concurrency slot behind the links of another host
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_PerHostDelayWhileQueued() {
+	suite.config.MaxConcurrentChecks = 1
+	suite.config.PerHostDelay = 100 * time.Millisecond
+	suite.linkChecker = NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, nil, nil, suite.logger, suite.metrics)
+
+	var (
+		starts []time.Time
+		mu     sync.Mutex
+	)
+
+	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.Host, "localhost") {
+			time.Sleep(300 * time.Millisecond)
+		} else {
+			mu.Lock()
+			starts = append(starts, time.Now())
+			mu.Unlock()
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	slowServerURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+
+	links := []domain.Link{
+		{URL: slowServerURL + "/slow", Type: domain.LinkTypeExternal},
+	}
+	for i := range 3 {
+		links = append(links, domain.Link{URL: fmt.Sprintf("%s/page%d", server.URL, i), Type: domain.LinkTypeExternal})
+	}
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
+
+	assert.Empty(suite.t, inaccessibleLinks, "All links should be accessible")
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	require.Len(suite.t, starts, 3)
+
+	for i := 1; i < len(starts); i++ {
+		assert.GreaterOrEqual(suite.t, starts[i].Sub(starts[i-1]), 90*time.Millisecond, "Should space requests to one host")
+	}
+}
+
+// TestCheckAccessibility_RetryAfter tests that a Retry-After on 429 and 503 responses is honoured
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_RetryAfter() {
+	cases := []struct {
+		name               string
+		statusCode         int
+		retryAfter         func() string
+		expectedStatusCode int
+		minElapsed         time.Duration
+		maxElapsed         time.Duration
+	}{
+		{
+			name:       "Too many requests with a short Retry-After in seconds",
+			statusCode: http.StatusTooManyRequests,
+			retryAfter: func() string { return "1" },
+			minElapsed: time.Second,
+			maxElapsed: 2 * time.Second,
+		},
+		{
+			name:       "Service unavailable with a Retry-After date",
+			statusCode: http.StatusServiceUnavailable,
+			retryAfter: func() string { return time.Now().Add(2 * time.Second).UTC().Format(http.TimeFormat) },
+			minElapsed: 500 * time.Millisecond,
+			maxElapsed: 3 * time.Second,
+		},
+		{
+			name:               "Retry-After longer than the checker waits",
+			statusCode:         http.StatusTooManyRequests,
+			retryAfter:         func() string { return "120" },
+			expectedStatusCode: http.StatusTooManyRequests,
+			maxElapsed:         time.Second,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				requests int
+				mu       sync.Mutex
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				requests++
+				first := requests == 1
+				mu.Unlock()
+
+				if first {
+					w.Header().Set("Retry-After", tc.retryAfter())
+					w.WriteHeader(tc.statusCode)
+
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			linkChecker := NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, nil, nil, suite.logger, suite.metrics)
+			links := []domain.Link{{URL: server.URL, Type: domain.LinkTypeExternal}}
+
+			start := time.Now()
+			inaccessibleLinks := linkChecker.CheckAccessibility(t.Context(), links, domain.LinkScopeExternal).InaccessibleLinks
+			elapsed := time.Since(start)
+
+			if tc.expectedStatusCode == 0 {
+				assert.Empty(t, inaccessibleLinks)
+			} else {
+				require.Len(t, inaccessibleLinks, 1)
+				assert.Equal(t, tc.expectedStatusCode, inaccessibleLinks[0].StatusCode)
+			}
+
+			assert.GreaterOrEqual(t, elapsed, tc.minElapsed)
+			assert.Less(t, elapsed, tc.maxElapsed)
+		})
+	}
+}
+
+// TestCheckAccessibility_Cache tests that link check results are shared through the cache
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_Cache() {
+	var (
+		requests int
+		mu       sync.Mutex
+	)
+
+	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/throttled":
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+
+	links := []domain.Link{
+		{URL: server.URL + "/ok", Type: domain.LinkTypeExternal},
+		{URL: server.URL + "/missing", Type: domain.LinkTypeExternal},
+		{URL: server.URL + "/throttled", Type: domain.LinkTypeExternal},
+	}
+
+	cache := newMemoryLinkCheckCache()
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	inaccessibleLinks := NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, cache, nil, suite.logger, suite.metrics).
+		CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
+
+	require.Len(suite.t, inaccessibleLinks, 2)
+	for _, link := range inaccessibleLinks {
+		assert.False(suite.t, link.Cached, "Should not report fresh checks as cached")
+	}
+
+	assert.Len(suite.t, cache.results, 2, "Should not cache throttled links")
+	assert.Equal(suite.t, http.StatusNotFound, cache.results[server.URL+"/missing"].StatusCode)
+	assert.Equal(suite.t, http.StatusOK, cache.results[server.URL+"/ok"].StatusCode)
+
+	mu.Lock()
+	requests = 0
+	mu.Unlock()
+
+	// Another analysis with its own checker shares the cache.
+	report := NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, cache, nil, suite.logger, suite.metrics).
+		CheckAccessibility(ctx, links, domain.LinkScopeExternal)
+	inaccessibleLinks = report.InaccessibleLinks
+
+	require.Len(suite.t, inaccessibleLinks, 2)
+
+	resultCached := make(map[string]bool)
+	for _, result := range report.Results {
+		resultCached[result.URL] = result.Cached
+	}
+
+	assert.Equal(suite.t, map[string]bool{
+		server.URL + "/ok":        true,
+		server.URL + "/missing":   true,
+		server.URL + "/throttled": false,
+	}, resultCached, "Should mark the results taken from the cache")
+
+	cachedByURL := make(map[string]bool)
+	for _, link := range inaccessibleLinks {
+		cachedByURL[link.URL] = link.Cached
+	}
+
+	assert.Equal(suite.t, map[string]bool{
+		server.URL + "/missing":   true,
+		server.URL + "/throttled": false,
+	}, cachedByURL)
+
+	mu.Lock()
+	assert.Equal(suite.t, 1, requests, "Should only check the link that was not cached")
+	mu.Unlock()
+}
+
+// TestCheckAccessibility_SharesInFlightChecks tests that parallel checks of one URL make one request
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_SharesInFlightChecks() {
+	var (
+		requests int
+		mu       sync.Mutex
+	)
+
+	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	links := []domain.Link{{URL: server.URL, Type: domain.LinkTypeExternal}}
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	results := make([][]domain.InaccessibleLink, 3)
+
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Go(func() {
+			results[i] = suite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
+		})
+	}
+
+	wg.Wait()
+
+	for _, inaccessibleLinks := range results {
+		require.Len(suite.t, inaccessibleLinks, 1)
+		assert.Equal(suite.t, http.StatusNotFound, inaccessibleLinks[0].StatusCode)
+		assert.False(suite.t, inaccessibleLinks[0].Cached)
+	}
+
+	mu.Lock()
+	assert.Equal(suite.t, 1, requests, "Should share the in-flight check")
+	mu.Unlock()
+}
+
+// TestCheckAccessibility_RedirectIssues tests redirect chains, loops, downgrades and soft 404s
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_RedirectIssues() {
+	var plainServer *httptest.Server
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hop/{n}", func(w http.ResponseWriter, r *http.Request) {
+		next := r.PathValue("n")
+		if next == "0" {
+			http.Redirect(w, r, "/page", http.StatusFound)
+
+			return
+		}
+
+		n := int(next[0] - '0')
+		http.Redirect(w, r, fmt.Sprintf("/hop/%d", n-1), http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/loop/a", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop/b", http.StatusFound)
+	})
+	mux.HandleFunc("/loop/b", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop/a", http.StatusFound)
+	})
+	mux.HandleFunc("/page", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<html><head><title>Products</title></head><body><h1>Our products</h1></body></html>"))
+	})
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<html><head><title>Page not found | Shop</title></head><body></body></html>"))
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/404.html", http.StatusFound)
+	})
+	mux.HandleFunc("/404.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html><body>Sorry</body></html>"))
+	})
+	mux.HandleFunc("/downgrade", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, plainServer.URL+"/page", http.StatusMovedPermanently)
+	})
+
+	plainServer = suite.createTestServer(mux.ServeHTTP)
+	tlsServer := httptest.NewTLSServer(mux)
+	suite.testServers = append(suite.testServers, tlsServer)
+
+	certificates := x509.NewCertPool()
+	certificates.AddCert(tlsServer.Certificate())
+	suite.linkChecker.client.SetTLSClientConfig(&tls.Config{RootCAs: certificates})
+
+	links := []domain.Link{
+		{URL: plainServer.URL + "/hop/1", Type: domain.LinkTypeExternal},
+		{URL: plainServer.URL + "/hop/4", Type: domain.LinkTypeExternal},
+		{URL: plainServer.URL + "/loop/a", Type: domain.LinkTypeExternal},
+		{URL: plainServer.URL + "/gone", Type: domain.LinkTypeExternal},
+		{URL: plainServer.URL + "/moved", Type: domain.LinkTypeExternal},
+		{URL: tlsServer.URL + "/downgrade", Type: domain.LinkTypeExternal},
+	}
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	report := suite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal)
+
+	results := make(map[string]domain.LinkCheckResult)
+	for _, result := range report.Results {
+		results[result.URL] = result
+	}
+
+	require.Len(suite.t, results, len(links))
+
+	assert.Equal(suite.t, &domain.RedirectChain{
+		Hops: []domain.RedirectHop{
+			{URL: plainServer.URL + "/hop/1", StatusCode: http.StatusMovedPermanently, Location: plainServer.URL + "/hop/0"},
+			{URL: plainServer.URL + "/hop/0", StatusCode: http.StatusFound, Location: plainServer.URL + "/page"},
+		},
+		HopCount: 2,
+		FinalURL: plainServer.URL + "/page",
+	}, results[plainServer.URL+"/hop/1"].Redirects)
+	assert.Equal(suite.t, 5, results[plainServer.URL+"/hop/4"].Redirects.HopCount)
+	assert.Nil(suite.t, results[plainServer.URL+"/gone"].Redirects)
+
+	require.Len(suite.t, report.InaccessibleLinks, 1, "Only the redirect loop should be inaccessible")
+	assert.Equal(suite.t, plainServer.URL+"/loop/a", report.InaccessibleLinks[0].URL)
+	assert.Equal(suite.t, http.StatusFound, report.InaccessibleLinks[0].StatusCode)
+	assert.Equal(suite.t, "redirect loop", report.InaccessibleLinks[0].Error)
+
+	issueCodes := make(map[string]string)
+	for _, issue := range report.Issues {
+		issueCodes[issue.URL] = issue.Code
+	}
+
+	assert.Equal(suite.t, map[string]string{
+		plainServer.URL + "/hop/4":   domain.LinkIssueLongRedirectChain,
+		plainServer.URL + "/loop/a":  domain.LinkIssueRedirectLoop,
+		plainServer.URL + "/gone":    domain.LinkIssueSoft404,
+		plainServer.URL + "/moved":   domain.LinkIssueSoft404,
+		tlsServer.URL + "/downgrade": domain.LinkIssueHTTPSDowngrade,
+	}, issueCodes)
+	assert.Len(suite.t, report.Issues, 5)
+}
+
+// TestCheckAccessibility_RedirectsOfRetriedRequest tests that the redirects of an attempt that
+// failed and was retried are not recorded again
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_RedirectsOfRetriedRequest() {
+	var attempts atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/new", func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			conn, buf, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				buf.WriteString("not an HTTP response\r\n\r\n")
+				buf.Flush()
+				conn.Close()
+			}
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<html><head><title>Products</title></head><body><h1>Our products</h1></body></html>"))
+	})
+
+	server := suite.createTestServer(mux.ServeHTTP)
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	report := suite.linkChecker.CheckAccessibility(ctx, []domain.Link{
+		{URL: server.URL + "/old", Type: domain.LinkTypeExternal},
+	}, domain.LinkScopeExternal)
+
+	require.Len(suite.t, report.Results, 1)
+	assert.Equal(suite.t, int32(2), attempts.Load(), "Should retry the request once")
+	assert.Equal(suite.t, &domain.RedirectChain{
+		Hops: []domain.RedirectHop{
+			{URL: server.URL + "/old", StatusCode: http.StatusMovedPermanently, Location: server.URL + "/new"},
+		},
+		HopCount: 1,
+		FinalURL: server.URL + "/new",
+	}, report.Results[0].Redirects)
+	assert.Empty(suite.t, report.InaccessibleLinks)
+}
+
+// TestCheckAccessibility_Soft404SingleRequest tests that links are fetched with a single GET
+// request when soft 404s are detected, and with a HEAD request otherwise
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_Soft404SingleRequest() {
+	cases := []struct {
+		name          string
+		detectSoft404 bool
+		expected      []string
+	}{
+		{name: "Soft 404 detection", detectSoft404: true, expected: []string{http.MethodGet}},
+		{name: "No soft 404 detection", detectSoft404: false, expected: []string{http.MethodHead}},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			var (
+				methods []string
+				mu      sync.Mutex
+			)
+
+			server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				methods = append(methods, r.Method)
+				mu.Unlock()
+
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				w.Write([]byte("<html><head><title>Page not found</title></head><body></body></html>"))
+			}))
+
+			cfg := suite.config
+			cfg.DetectSoft404 = tc.detectSoft404
+			linkChecker := NewLinkChecker(cfg, "WebAnalyzer/1.0", nil, nil, nil, suite.logger, suite.metrics)
+
+			ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+			defer cancel()
+
+			report := linkChecker.CheckAccessibility(ctx, []domain.Link{{URL: server.URL + "/gone", Type: domain.LinkTypeExternal}}, domain.LinkScopeExternal)
+
+			assert.Equal(t, tc.expected, methods)
+			require.Len(t, report.Results, 1)
+			assert.Equal(t, tc.detectSoft404, report.Results[0].Soft404Reason != "")
+		})
+	}
+}
+
+// TestCheckAccessibility_Fragments tests that internal links with a fragment are checked against
+// the target document
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_Fragments() {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/docs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(`<html><body><h2 id="install">Install</h2><a name="usage"></a><h2>Usage</h2></body></html>`))
+	})
+	mux.HandleFunc("/guide", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/docs", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/long", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<html><body>" + strings.Repeat("<p>Filler</p>", 1024) + `<h2 id="end">End</h2></body></html>`))
+	})
+
+	server := suite.createTestServer(mux.ServeHTTP)
+	suite.linkChecker.config.FragmentMaxBodyBytes = 4096
+
+	links := []domain.Link{
+		{URL: server.URL + "/docs#install", Type: domain.LinkTypeInternal, Text: "Installation"},
+		{URL: server.URL + "/docs#usage", Type: domain.LinkTypeInternal, Text: "Usage"},
+		{URL: server.URL + "/docs#configuration", Type: domain.LinkTypeInternal, Text: "Configuration"},
+		{URL: server.URL + "/guide#faq", Type: domain.LinkTypeInternal, Text: "FAQ"},
+		{URL: server.URL + "/docs#!/app", Type: domain.LinkTypeInternal, Text: "App"},
+		{URL: server.URL + "/long#missing", Type: domain.LinkTypeInternal, Text: "Cut off"},
+		{URL: server.URL + "/docs#external", Type: domain.LinkTypeExternal, Text: "External"},
+	}
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	report := suite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeAll)
+
+	assert.Empty(suite.t, report.InaccessibleLinks, "Missing fragments should not make links inaccessible")
+
+	issues := make(map[string]domain.LinkIssue)
+	for _, issue := range report.Issues {
+		issues[issue.URL] = issue
+	}
+
+	require.Len(suite.t, issues, 2, "Only the fragments missing from complete internal documents should be reported")
+
+	configuration := issues[server.URL+"/docs#configuration"]
+	assert.Equal(suite.t, domain.LinkIssueDanglingAnchor, configuration.Code)
+	assert.Equal(suite.t, domain.SeverityWarning, configuration.Severity)
+	assert.Equal(suite.t, "Configuration", configuration.Text)
+	assert.Equal(suite.t, fmt.Sprintf("no element with id or name %q in %s", "configuration", server.URL+"/docs"), configuration.Message)
+
+	faq := issues[server.URL+"/guide#faq"]
+	assert.Equal(suite.t, domain.LinkIssueDanglingAnchor, faq.Code)
+	require.NotNil(suite.t, faq.Redirects, "Should check the document the link redirects to")
+	assert.Equal(suite.t, 1, faq.Redirects.HopCount)
+}
+
+// TestCheckAccessibility_FragmentsShareDocument tests that the links into a document are checked
+// with a single request, however many of its fragments they point at
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_FragmentsShareDocument() {
+	var requests atomic.Int32
+
+	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(`<html><body><h2 id="a">A</h2><h2 id="b">B</h2></body></html>`))
+	}))
+
+	links := []domain.Link{
+		{URL: server.URL + "/docs/page#a", Type: domain.LinkTypeInternal},
+		{URL: server.URL + "/docs/page#b", Type: domain.LinkTypeInternal},
+		{URL: server.URL + "/docs/page#missing", Type: domain.LinkTypeInternal},
+		{URL: server.URL + "/docs/page#!/app", Type: domain.LinkTypeInternal},
+		{URL: server.URL + "/docs/page", Type: domain.LinkTypeInternal},
+	}
+
+	cache := newMemoryLinkCheckCache()
+	linkChecker := NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, cache, nil, suite.logger, suite.metrics)
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	report := linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeInternal)
+
+	assert.Equal(suite.t, int32(1), requests.Load(), "Should fetch the document once for all the links into it")
+	require.Len(suite.t, report.Results, len(links))
+
+	for _, result := range report.Results {
+		assert.Equal(suite.t, http.StatusOK, result.StatusCode, result.URL)
+		assert.Equal(suite.t, result.URL == server.URL+"/docs/page#missing", result.MissingFragment, result.URL)
+	}
+
+	require.Len(suite.t, report.Issues, 1)
+	assert.Equal(suite.t, domain.LinkIssueDanglingAnchor, report.Issues[0].Code)
+	assert.Equal(suite.t, server.URL+"/docs/page#missing", report.Issues[0].URL)
+
+	// The links into the document are answered from the cache afterwards.
+	report = linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeInternal)
+
+	assert.Equal(suite.t, int32(1), requests.Load(), "Should answer the links from the cache")
+	require.Len(suite.t, report.Issues, 1)
+	assert.Equal(suite.t, server.URL+"/docs/page#missing", report.Issues[0].URL)
+}
+
+// TestCheckAccessibility_SharedCheckOutlivesCaller tests that a check shared between analyses
+// keeps running when the analysis that started it gives up
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_SharedCheckOutlivesCaller() {
+	var requests atomic.Int32
+
+	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		time.Sleep(300 * time.Millisecond)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	links := []domain.Link{{URL: server.URL + "/slow", Type: domain.LinkTypeExternal}}
+
+	cache := newMemoryLinkCheckCache()
+	linkChecker := NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, cache, nil, suite.logger, suite.metrics)
+
+	var (
+		cancelled, waiting domain.LinkCheckReport
+		wg                 sync.WaitGroup
+	)
+
+	wg.Go(func() {
+		ctx, cancel := context.WithTimeout(suite.t.Context(), 100*time.Millisecond)
+		defer cancel()
+
+		cancelled = linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal)
+	})
+
+	wg.Go(func() {
+		time.Sleep(20 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+		defer cancel()
+
+		waiting = linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal)
+	})
+
+	wg.Wait()
+
+	require.Len(suite.t, cancelled.Results, 1)
+	assert.Equal(suite.t, context.DeadlineExceeded.Error(), cancelled.Results[0].Error, "Should stop waiting when its analysis is done")
+
+	require.Len(suite.t, waiting.Results, 1)
+	assert.Equal(suite.t, http.StatusOK, waiting.Results[0].StatusCode, "Should not fail with the analysis that started the check")
+	assert.Empty(suite.t, waiting.Results[0].Error)
+
+	assert.Equal(suite.t, int32(1), requests.Load())
+	assert.Equal(suite.t, http.StatusOK, cache.results[server.URL+"/slow"].StatusCode)
+}
+
+// TestCheckAccessibility_Robots tests that links robots.txt disallows are reported rather than checked
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_Robots() {
+	var (
+		checked []string
+		agents  = make(map[string]struct{})
+		mu      sync.Mutex
+	)
+
+	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		agents[r.UserAgent()] = struct{}{}
+		mu.Unlock()
+
+		if r.URL.Path == "/robots.txt" {
+			w.Write([]byte("User-agent: *\nDisallow: /private\n"))
+
+			return
+		}
+
+		mu.Lock()
+		checked = append(checked, r.URL.Path)
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	links := []domain.Link{
+		{URL: server.URL + "/public", Type: domain.LinkTypeExternal},
+		{URL: server.URL + "/private/report", Type: domain.LinkTypeExternal, Text: "Report"},
+	}
+
+	cache := newMemoryLinkCheckCache()
+	linkChecker := NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, cache, newTestRobotsPolicy(newMemoryRobotsTxtCache()), suite.logger, suite.metrics)
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	report := linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal)
+
+	assert.Empty(suite.t, report.InaccessibleLinks, "Disallowed links should not be reported as inaccessible")
+	require.Len(suite.t, report.Issues, 1)
+	assert.Equal(suite.t, domain.LinkIssue{
+		URL:      server.URL + "/private/report",
+		Code:     domain.LinkIssueRobotsDisallowed,
+		Severity: domain.SeverityInfo,
+		Message:  "not checked because robots.txt disallows it",
+		Scope:    domain.LinkTypeExternal,
+		Text:     "Report",
+	}, report.Issues[0])
+
+	mu.Lock()
+	assert.Equal(suite.t, []string{"/public"}, checked, "Should not request disallowed links")
+	assert.Equal(suite.t, map[string]struct{}{"WebAnalyzer/1.0": {}}, agents,
+		"Should send the agent robots.txt is evaluated for")
+	mu.Unlock()
+
+	assert.NotContains(suite.t, cache.results, server.URL+"/private/report", "Should not cache disallowed links")
+
+	// An analysis allowed to ignore robots.txt checks the link without sharing the result.
+	report = linkChecker.CheckAccessibility(domain.WithRobotsTxtIgnored(ctx), links[1:], domain.LinkScopeExternal)
+
+	assert.Empty(suite.t, report.Issues)
+	require.Len(suite.t, report.Results, 1)
+	assert.Equal(suite.t, http.StatusOK, report.Results[0].StatusCode)
+	assert.NotContains(suite.t, cache.results, server.URL+"/private/report")
+}
+
+// TestCheckAccessibility_RobotsOverrideNotShared tests that concurrent checks with and without a robots.txt override stay apart
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_RobotsOverrideNotShared() {
+	var requests atomic.Int32
+
+	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			w.Write([]byte("User-agent: *\nDisallow: /private\n"))
+
+			return
+		}
+
+		requests.Add(1)
+		time.Sleep(300 * time.Millisecond)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	links := []domain.Link{{URL: server.URL + "/private/report", Type: domain.LinkTypeExternal}}
+
+	cache := newMemoryLinkCheckCache()
+	linkChecker := NewLinkChecker(suite.config, "WebAnalyzer/1.0", nil, cache, newTestRobotsPolicy(newMemoryRobotsTxtCache()), suite.logger, suite.metrics)
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	var (
+		overridden, honoured domain.LinkCheckReport
+		wg                   sync.WaitGroup
+	)
+
+	wg.Go(func() {
+		overridden = linkChecker.CheckAccessibility(domain.WithRobotsTxtIgnored(ctx), links, domain.LinkScopeExternal)
+	})
+
+	// Ask while the overridden check is still in flight.
+	time.Sleep(100 * time.Millisecond)
+	wg.Go(func() {
+		honoured = linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal)
+	})
+
+	wg.Wait()
+
+	require.Len(suite.t, overridden.InaccessibleLinks, 1)
+	assert.Equal(suite.t, http.StatusNotFound, overridden.InaccessibleLinks[0].StatusCode)
+
+	assert.Empty(suite.t, honoured.InaccessibleLinks, "Should not see what the override found")
+	require.Len(suite.t, honoured.Results, 1)
+	assert.True(suite.t, honoured.Results[0].RobotsDisallowed)
+
+	assert.Equal(suite.t, int32(1), requests.Load())
+	assert.NotContains(suite.t, cache.results, links[0].URL, "Should not cache what the override found")
+}
+
+// TestCheckAccessibility_NetworkGuard tests that links resolving to non public addresses are not requested
+func (suite *LinkCheckerTestSuite) TestCheckAccessibility_NetworkGuard() {
+	var requests atomic.Int32
+
+	server := suite.createTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(suite.t, err)
+
+	links := []domain.Link{
+		{URL: server.URL + "/address", Type: domain.LinkTypeExternal},
+		{URL: "http://localhost:" + serverURL.Port() + "/name", Type: domain.LinkTypeExternal},
+	}
+
+	guard, err := NewNetworkGuard(config.EgressConfig{})
+	require.NoError(suite.t, err)
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 10*time.Second)
+	defer cancel()
+
+	report := NewLinkChecker(suite.config, "WebAnalyzer/1.0", guard, nil, nil, suite.logger, suite.metrics).
+		CheckAccessibility(ctx, links, domain.LinkScopeExternal)
+
+	require.Len(suite.t, report.InaccessibleLinks, 2)
+	for _, link := range report.InaccessibleLinks {
+		assert.Contains(suite.t, link.Error, domain.ErrAddressNotAllowed.Error())
+	}
+
+	assert.Zero(suite.t, requests.Load(), "Should not connect to non public addresses")
+}
+
 // TestCheckAccessibility_Redirects tests redirect handling
 func (suite *LinkCheckerTestSuite) TestCheckAccessibility_Redirects() {
 	cases := []struct {
@@ -728,7 +1725,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_Redirects() {
 			ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
 			defer cancel()
 
-			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links)
+			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 
 			if tc.expectError {
 				require.Len(t, inaccessibleLinks, 1, tc.description)
@@ -739,8 +1736,13 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_Redirects() {
 	}
 }
 
-// TestCheckAccessibility_HeadVsGet tests HEAD vs GET request handling
+// TestCheckAccessibility_HeadVsGet tests HEAD vs GET request handling when soft 404s are not
+// detected, as links are fetched with a GET request otherwise
 func (suite *LinkCheckerTestSuite) TestCheckAccessibility_HeadVsGet() {
+	cfg := suite.config
+	cfg.DetectSoft404 = false
+	linkChecker := NewLinkChecker(cfg, "WebAnalyzer/1.0", nil, nil, nil, suite.logger, suite.metrics)
+
 	cases := []struct {
 		name             string
 		headResponse     int
@@ -803,7 +1805,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_HeadVsGet() {
 			ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
 			defer cancel()
 
-			inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links)
+			inaccessibleLinks := linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 
 			if tc.expectError {
 				require.Len(t, inaccessibleLinks, 1, tc.description)
@@ -903,7 +1905,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_EdgeCases() {
 			ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
 			defer cancel()
 
-			inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links)
+			inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 
 			if tc.expectError {
 				require.Greater(t, len(inaccessibleLinks), 0, tc.description)
@@ -932,7 +1934,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_ContextCancellation()
 	defer cancel()
 
 	start := time.Now()
-	inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links)
+	inaccessibleLinks := suite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 	duration := time.Since(start)
 
 	// Should complete relatively quickly due to context timeout
@@ -970,7 +1972,7 @@ func (suite *LinkCheckerTestSuite) TestCheckAccessibility_ConcurrentExecution()
 			ctx, cancel := context.WithTimeout(subSuite.t.Context(), 5*time.Second)
 			defer cancel()
 
-			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links)
+			inaccessibleLinks := subSuite.linkChecker.CheckAccessibility(ctx, links, domain.LinkScopeExternal).InaccessibleLinks
 
 			if len(inaccessibleLinks) != 0 {
 				results <- fmt.Errorf("goroutine %d: expected 0 inaccessible links, got %d", id, len(inaccessibleLinks))
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,6 +17,9 @@ import (
 	"github.com/getkin/kin-openapi/openapi3filter"
 )
 
+// claimsContextKey is the request context key the claims of a validated token are stored under.
+const claimsContextKey = "paseto_claims"
+
 type (
 	PasetoTokenClaims struct {
 		Issuer    string   `json:"iss"`
@@ -35,6 +39,18 @@ type (
 	}
 )
 
+// ClaimsFromContext returns the claims of the token the request was authenticated with.
+func ClaimsFromContext(ctx context.Context) (*PasetoTokenClaims, bool) {
+	claims, ok := ctx.Value(claimsContextKey).(*PasetoTokenClaims)
+
+	return claims, ok && claims != nil
+}
+
+// HasScope reports whether the token was granted scope.
+func (c *PasetoTokenClaims) HasScope(scope string) bool {
+	return scope != "" && slices.Contains(c.Scopes, scope)
+}
+
 func NewPasetoAuthMiddleware(
 	config config.AuthConfig,
 	logger infrastructure.Logger,
@@ -73,7 +89,7 @@ func (m *PasetoAuthMiddleware) Middleware(next http.Handler) http.Handler {
 		}
 
 		// Add the claims to request context
-		ctx := context.WithValue(r.Context(), "paseto_claims", claims)
+		ctx := context.WithValue(r.Context(), claimsContextKey, claims)
 		r = r.WithContext(ctx)
 
 		m.logger.Debug().
@@ -273,7 +289,7 @@ func NewPasetoAuthenticationFunc(
 		}
 
 		// Add the claims to request context for downstream handlers
-		newCtx := context.WithValue(ctx, "paseto_claims", claims)
+		newCtx := context.WithValue(ctx, claimsContextKey, claims)
 		*r = *r.WithContext(newCtx)
 
 		logger.Debug().
@@ -38,6 +38,12 @@ func (m *mockMetrics) RecordOutboxEvent(ctx context.Context, success bool, prior
 func (m *mockMetrics) RecordLinkCheck(ctx context.Context, success bool, linkType string) {
 }
 
+func (m *mockMetrics) RecordLinkCheckerBreakerState(ctx context.Context, host, state string) {
+}
+
+func (m *mockMetrics) RecordLinkCheckCache(ctx context.Context, hit bool) {
+}
+
 func (m *mockMetrics) RecordFetchTime(ctx context.Context, duration time.Duration) {
 }
 
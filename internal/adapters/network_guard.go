@@ -0,0 +1,204 @@
+package adapters
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+// nonPublicPrefixes are the networks requests on behalf of an analysis never reach unless they are
+// allowed explicitly: loopback, private, shared (CGNAT), link local (including cloud metadata
+// endpoints), multicast and reserved ranges of IPv4 and IPv6.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("224.0.0.0/4"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("::/128"),
+	netip.MustParsePrefix("::1/128"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("fc00::/7"),
+	netip.MustParsePrefix("fe80::/10"),
+	netip.MustParsePrefix("ff00::/8"),
+}
+
+var (
+	// sixToFourPrefix holds 6to4 addresses, which embed an IPv4 address in their second to fifth
+	// bytes.
+	sixToFourPrefix = netip.MustParsePrefix("2002::/16")
+
+	// teredoPrefix holds Teredo addresses, which embed the inverted IPv4 address of the client in
+	// their last four bytes.
+	teredoPrefix = netip.MustParsePrefix("2001::/32")
+
+	// nat64Prefix holds the well known NAT64 addresses, which embed an IPv4 address in their last
+	// four bytes.
+	nat64Prefix = netip.MustParsePrefix("64:ff9b::/96")
+)
+
+// NetworkGuard keeps the requests made on behalf of analyses on the public internet. It checks
+// the address of every connection when it is dialed, after the host name was resolved, so host
+// names resolving to internal addresses, redirects to them and DNS rebinding are all caught.
+type NetworkGuard struct {
+	allowed []netip.Prefix
+	denied  []netip.Prefix
+}
+
+// NewNetworkGuard creates a guard refusing non public addresses and the denied networks of cfg,
+// except for its allowed networks.
+func NewNetworkGuard(cfg config.EgressConfig) (*NetworkGuard, error) {
+	allowed, err := parsePrefixes(cfg.AllowedCIDRs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowed CIDR: %w", err)
+	}
+
+	denied, err := parsePrefixes(cfg.DeniedCIDRs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid denied CIDR: %w", err)
+	}
+
+	return &NetworkGuard{allowed: allowed, denied: denied}, nil
+}
+
+// Allows reports whether addr may be connected to. A denied network wins over an allowed one,
+// and an allowed one over the non public ranges. An IPv6 address embedding an IPv4 one is only
+// allowed when the IPv4 address is too. A nil guard only refuses non public addresses.
+func (g *NetworkGuard) Allows(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if embedded, ok := embeddedIPv4(addr); ok && !g.Allows(embedded) {
+		return false
+	}
+
+	if g != nil {
+		if prefixesContain(g.denied, addr) {
+			return false
+		}
+
+		if prefixesContain(g.allowed, addr) {
+			return true
+		}
+	}
+
+	return !isNonPublicAddress(addr)
+}
+
+// Transport returns an HTTP transport whose connections are checked by the guard. It ignores
+// proxies from the environment, which would resolve host names out of the guard's sight.
+func (g *NetworkGuard) Transport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   g.control,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return transport
+}
+
+// control runs after the dialer resolved the address and before it connects to it.
+func (g *NetworkGuard) control(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrAddressNotAllowed, address)
+	}
+
+	if !g.Allows(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", domain.ErrAddressNotAllowed, addrPort.Addr().Unmap())
+	}
+
+	return nil
+}
+
+// allowsHost reports whether the host of a URL may be asked for before it is resolved: IP
+// literals are checked like connections are, names of the local machine are refused.
+func (g *NetworkGuard) allowsHost(host string) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return !isPrivateOrLocalURL(host)
+	}
+
+	return g.Allows(addr)
+}
+
+func isNonPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if embedded, ok := embeddedIPv4(addr); ok && isNonPublicAddress(embedded) {
+		return true
+	}
+
+	return prefixesContain(nonPublicPrefixes, addr)
+}
+
+// embeddedIPv4 returns the IPv4 address a 6to4, Teredo or NAT64 address tunnels or translates
+// to.
+func embeddedIPv4(addr netip.Addr) (netip.Addr, bool) {
+	bytes := addr.As16()
+
+	switch {
+	case !addr.Is6():
+		return netip.Addr{}, false
+	case sixToFourPrefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(bytes[2:6])), true
+	case teredoPrefix.Contains(addr):
+		return netip.AddrFrom4([4]byte{^bytes[12], ^bytes[13], ^bytes[14], ^bytes[15]}), true
+	case nat64Prefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(bytes[12:16])), true
+	}
+
+	return netip.Addr{}, false
+}
+
+func prefixesContain(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parsePrefixes(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, err
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
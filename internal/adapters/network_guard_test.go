@@ -0,0 +1,170 @@
+package adapters
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkGuard_Allows(t *testing.T) {
+	t.Parallel()
+
+	guard, err := NewNetworkGuard(config.EgressConfig{
+		AllowedCIDRs: []string{"10.20.0.0/16", " fd00:1::/32 "},
+		DeniedCIDRs:  []string{"10.20.30.0/24", "93.184.216.0/24"},
+	})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		addr     string
+		expected bool
+	}{
+		{name: "Public IPv4", addr: "8.8.8.8", expected: true},
+		{name: "Public IPv6", addr: "2606:4700:4700::1111", expected: true},
+		{name: "Loopback", addr: "127.0.0.1", expected: false},
+		{name: "Other loopback", addr: "127.53.0.1", expected: false},
+		{name: "Unspecified", addr: "0.0.0.0", expected: false},
+		{name: "Private", addr: "192.168.1.10", expected: false},
+		{name: "Shared address space", addr: "100.64.12.1", expected: false},
+		{name: "Cloud metadata endpoint", addr: "169.254.169.254", expected: false},
+		{name: "IPv6 loopback", addr: "::1", expected: false},
+		{name: "IPv6 unique local", addr: "fd12:3456::1", expected: false},
+		{name: "IPv6 link local", addr: "fe80::1", expected: false},
+		{name: "IPv4 mapped loopback", addr: "::ffff:127.0.0.1", expected: false},
+		{name: "NAT64 embedded address", addr: "64:ff9b::a9fe:a9fe", expected: false},
+		{name: "NAT64 embedded loopback", addr: "64:ff9b::127.0.0.1", expected: false},
+		{name: "6to4 embedded loopback", addr: "2002:7f00:1::", expected: false},
+		{name: "6to4 embedded private address", addr: "2002:c0a8:10a::1", expected: false},
+		{name: "6to4 embedded denied address", addr: "2002:5db8:d822::1", expected: false},
+		{name: "6to4 embedded public address", addr: "2002:808:808::1", expected: true},
+		{name: "Teredo embedded loopback", addr: "2001:0:4136:e378:8000:63bf:80ff:fffe", expected: false},
+		{name: "Teredo embedded private address", addr: "2001:0:4136:e378:8000:63bf:3f57:fef5", expected: false},
+		{name: "Teredo embedded public address", addr: "2001:0:4136:e378:8000:63bf:f7f7:f7f7", expected: true},
+		{name: "Allowed private network", addr: "10.20.1.1", expected: true},
+		{name: "Allowed IPv6 network", addr: "fd00:1::10", expected: true},
+		{name: "Denied within an allowed network", addr: "10.20.30.40", expected: false},
+		{name: "Denied public network", addr: "93.184.216.34", expected: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, guard.Allows(netip.MustParseAddr(tc.addr)))
+		})
+	}
+}
+
+func TestNewNetworkGuard_InvalidCIDR(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewNetworkGuard(config.EgressConfig{AllowedCIDRs: []string{"10.0.0.0/33"}})
+	require.Error(t, err)
+
+	_, err = NewNetworkGuard(config.EgressConfig{DeniedCIDRs: []string{"not-a-network"}})
+	require.Error(t, err)
+}
+
+func TestNetworkGuard_Transport(t *testing.T) {
+	t.Parallel()
+
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(internal.Close)
+
+	_, port, err := net.SplitHostPort(internal.Listener.Addr().String())
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		egress  config.EgressConfig
+		url     string
+		allowed bool
+	}{
+		{
+			name: "Host name resolving to loopback",
+			url:  "http://localhost:" + port,
+		},
+		{
+			name: "Loopback address",
+			url:  internal.URL,
+		},
+		{
+			name:    "Allowed loopback address",
+			egress:  config.EgressConfig{AllowedCIDRs: []string{"127.0.0.0/8", "::1/128"}},
+			url:     internal.URL,
+			allowed: true,
+		},
+		{
+			name:   "Denied within an allowed network",
+			egress: config.EgressConfig{AllowedCIDRs: []string{"127.0.0.0/8"}, DeniedCIDRs: []string{"127.0.0.1/32"}},
+			url:    internal.URL,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			guard, err := NewNetworkGuard(tc.egress)
+			require.NoError(t, err)
+
+			client := &http.Client{Transport: guard.Transport()}
+
+			resp, err := client.Get(tc.url)
+			if tc.allowed {
+				require.NoError(t, err)
+				resp.Body.Close()
+
+				assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, domain.ErrAddressNotAllowed)
+		})
+	}
+}
+
+func TestNetworkGuard_Redirects(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.2:0")
+	if err != nil {
+		t.Skipf("127.0.0.2 is not available: %v", err)
+	}
+
+	internal := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	internal.Listener.Close()
+	internal.Listener = listener
+	internal.Start()
+	defer internal.Close()
+
+	public := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusFound)
+	}))
+	defer public.Close()
+
+	// The redirecting server stands in for a public host.
+	guard, err := NewNetworkGuard(config.EgressConfig{AllowedCIDRs: []string{"127.0.0.1/32"}})
+	require.NoError(t, err)
+
+	client := &http.Client{Transport: guard.Transport()}
+
+	_, err = client.Get(public.URL)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrAddressNotAllowed)
+	assert.Contains(t, err.Error(), "127.0.0.2")
+}
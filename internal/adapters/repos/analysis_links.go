@@ -0,0 +1,297 @@
+package repos
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const (
+	analysisLinksTable = "analysis_links"
+
+	// linkInsertBatchSize keeps a batch well below the 65535 bind parameters PostgreSQL accepts.
+	linkInsertBatchSize = 1000
+)
+
+var analysisLinkColumns = []string{"position", "url", "host", "type", "region", "text", "rel", "target", "status_code", "error", "redirects", "cached"}
+
+type analysisLinkRow struct {
+	Position   int            `db:"position"`
+	URL        string         `db:"url"`
+	Host       string         `db:"host"`
+	Type       string         `db:"type"`
+	Region     string         `db:"region"`
+	Text       string         `db:"text"`
+	Rel        pq.StringArray `db:"rel"`
+	Target     string         `db:"target"`
+	StatusCode sql.NullInt32  `db:"status_code"`
+	Error      sql.NullString `db:"error"`
+	Redirects  sql.NullString `db:"redirects"`
+	Cached     bool           `db:"cached"`
+}
+
+// SaveLinks replaces the stored links of an analysis, keeping their document order.
+func (r *AnalysisRepository) SaveLinks(ctx context.Context, analysisID string, links []domain.Link) error {
+	return r.replaceLinks(ctx, analysisID, func(tx *sqlx.Tx) error {
+		for start := 0; start < len(links); start += linkInsertBatchSize {
+			end := min(start+linkInsertBatchSize, len(links))
+
+			insertBuilder := psql.Insert(analysisLinksTable).
+				Columns(append([]string{"analysis_id"}, analysisLinkColumns...)...)
+
+			for position := start; position < end; position++ {
+				link := links[position]
+
+				redirects, err := nullableRedirects(link)
+				if err != nil {
+					return err
+				}
+
+				insertBuilder = insertBuilder.Values(
+					analysisID, position, link.URL, linkHost(link.URL), link.Type, link.Region, link.Text,
+					pq.StringArray(append([]string{}, link.Rel...)), link.Target, nullableStatusCode(link), nullableError(link),
+					redirects, link.Cached,
+				)
+			}
+
+			query, args, err := insertBuilder.ToSql()
+			if err != nil {
+				return fmt.Errorf("failed to build insert query: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				return fmt.Errorf("failed to save analysis links: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// CopyLinks replaces the stored links of an analysis with those of another analysis of the same
+// content.
+func (r *AnalysisRepository) CopyLinks(ctx context.Context, analysisID, sourceAnalysisID string) error {
+	return r.replaceLinks(ctx, analysisID, func(tx *sqlx.Tx) error {
+		query, args, err := psql.Insert(analysisLinksTable).
+			Columns(append([]string{"analysis_id"}, analysisLinkColumns...)...).
+			Select(
+				sq.Select().
+					Column("?::uuid", analysisID).
+					Columns(analysisLinkColumns...).
+					From(analysisLinksTable).
+					Where(sq.Eq{"analysis_id": sourceAnalysisID}),
+			).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build copy query: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to copy analysis links: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// FindLinks returns up to limit stored links of an analysis matching the filter, starting after
+// the position encoded in cursor.
+func (r *AnalysisRepository) FindLinks(
+	ctx context.Context,
+	analysisID string,
+	filter domain.LinkFilter,
+	cursor string,
+	limit int,
+) (*domain.LinkPage, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("%w: %d", domain.ErrInvalidPageLimit, limit)
+	}
+
+	after, err := decodeLinkCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria := sq.And{sq.Eq{"analysis_id": analysisID}}
+
+	if filter.Type != "" {
+		criteria = append(criteria, sq.Eq{"type": filter.Type})
+	}
+
+	if filter.Region != "" {
+		criteria = append(criteria, sq.Eq{"region": filter.Region})
+	}
+
+	if filter.StatusCode != 0 {
+		criteria = append(criteria, sq.Eq{"status_code": filter.StatusCode})
+	}
+
+	if filter.Host != "" {
+		criteria = append(criteria, sq.Eq{"host": strings.ToLower(filter.Host)})
+	}
+
+	countQuery, countArgs, err := psql.Select("COUNT(*)").
+		From(analysisLinksTable).
+		Where(criteria).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var totalCount int
+	if err := r.conn.GetContext(ctx, &totalCount, countQuery, countArgs...); err != nil {
+		return nil, fmt.Errorf("failed to count analysis links: %w", err)
+	}
+
+	queryBuilder := psql.Select(analysisLinkColumns...).
+		From(analysisLinksTable).
+		Where(criteria).
+		OrderBy("position").
+		Limit(uint64(limit + 1))
+
+	if after >= 0 {
+		queryBuilder = queryBuilder.Where(sq.Gt{"position": after})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	var rows []analysisLinkRow
+	if err := r.conn.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to query analysis links: %w", err)
+	}
+
+	page := &domain.LinkPage{
+		Links: make([]domain.Link, 0, min(len(rows), limit)),
+		Pagination: domain.CursorPagination{
+			Limit:      limit,
+			TotalCount: totalCount,
+			HasNext:    len(rows) > limit,
+		},
+	}
+
+	if page.Pagination.HasNext {
+		rows = rows[:limit]
+		page.Pagination.NextCursor = encodeLinkCursor(rows[len(rows)-1].Position)
+	}
+
+	for _, row := range rows {
+		link := domain.Link{
+			URL:        row.URL,
+			Type:       domain.LinkType(row.Type),
+			Region:     domain.LinkRegion(row.Region),
+			Text:       row.Text,
+			Rel:        []string(row.Rel),
+			Target:     row.Target,
+			StatusCode: int(row.StatusCode.Int32),
+			Error:      row.Error.String,
+			Cached:     row.Cached,
+		}
+
+		if row.Redirects.Valid {
+			if err := json.Unmarshal([]byte(row.Redirects.String), &link.Redirects); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal link redirects: %w", err)
+			}
+		}
+
+		page.Links = append(page.Links, link)
+	}
+
+	return page, nil
+}
+
+// replaceLinks deletes the stored links of an analysis and runs insert in the same transaction.
+func (r *AnalysisRepository) replaceLinks(ctx context.Context, analysisID string, insert func(tx *sqlx.Tx) error) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query, args, err := psql.Delete(analysisLinksTable).
+		Where(sq.Eq{"analysis_id": analysisID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete analysis links: %w", err)
+	}
+
+	if err := insert(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// encodeLinkCursor turns the position of the last link of a page into an opaque cursor.
+func encodeLinkCursor(position int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(position)))
+}
+
+// decodeLinkCursor returns the position a cursor points after, or -1 for the first page.
+func decodeLinkCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return -1, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, domain.ErrInvalidCursor
+	}
+
+	position, err := strconv.Atoi(string(decoded))
+	if err != nil || position < 0 {
+		return 0, domain.ErrInvalidCursor
+	}
+
+	return position, nil
+}
+
+func linkHost(rawURL string) string {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToLower(parsedURL.Hostname())
+}
+
+func nullableStatusCode(link domain.Link) sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(link.StatusCode), Valid: link.StatusCode != 0 || link.Error != ""}
+}
+
+func nullableError(link domain.Link) sql.NullString {
+	return sql.NullString{String: link.Error, Valid: link.Error != ""}
+}
+
+func nullableRedirects(link domain.Link) (sql.NullString, error) {
+	if link.Redirects == nil {
+		return sql.NullString{}, nil
+	}
+
+	redirectsJSON, err := json.Marshal(link.Redirects)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to marshal link redirects: %w", err)
+	}
+
+	return sql.NullString{String: string(redirectsJSON), Valid: true}, nil
+}
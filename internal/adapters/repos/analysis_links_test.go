@@ -0,0 +1,113 @@
+package repos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+func TestLinkCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Empty cursor starts at the first link", func(t *testing.T) {
+		t.Parallel()
+
+		position, err := decodeLinkCursor("")
+
+		require.NoError(t, err)
+		assert.Equal(t, -1, position)
+	})
+
+	t.Run("Encoded cursor round trips", func(t *testing.T) {
+		t.Parallel()
+
+		for _, expected := range []int{0, 49, 123456} {
+			position, err := decodeLinkCursor(encodeLinkCursor(expected))
+
+			require.NoError(t, err)
+			assert.Equal(t, expected, position)
+		}
+	})
+
+	t.Run("Malformed cursors are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		for _, cursor := range []string{"not base64!", "YWJj", "LTE"} {
+			_, err := decodeLinkCursor(cursor)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidCursor, cursor)
+		}
+	})
+}
+
+func TestFindLinks_NonPositiveLimit(t *testing.T) {
+	t.Parallel()
+
+	repo := NewAnalysisRepository(nil)
+
+	for _, limit := range []int{0, -1} {
+		page, err := repo.FindLinks(t.Context(), "analysis-id", domain.LinkFilter{}, "", limit)
+
+		require.ErrorIs(t, err, domain.ErrInvalidPageLimit)
+		assert.Nil(t, page)
+	}
+}
+
+func TestLinkHost(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{url: "https://Example.COM/path", expected: "example.com"},
+		{url: "http://example.com:8080/", expected: "example.com"},
+		{url: "https://[::1]:443/", expected: "::1"},
+		{url: "://broken", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, linkHost(tt.url))
+		})
+	}
+}
+
+func TestNullableRedirects(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Links without redirects store NULL", func(t *testing.T) {
+		t.Parallel()
+
+		redirects, err := nullableRedirects(domain.Link{URL: "https://example.com/"})
+
+		require.NoError(t, err)
+		assert.False(t, redirects.Valid)
+	})
+
+	t.Run("Redirect chains store JSON", func(t *testing.T) {
+		t.Parallel()
+
+		redirects, err := nullableRedirects(domain.Link{
+			URL: "http://example.com/",
+			Redirects: &domain.RedirectChain{
+				Hops:     []domain.RedirectHop{{URL: "http://example.com/", StatusCode: 301, Location: "https://example.com/"}},
+				HopCount: 1,
+				FinalURL: "https://example.com/",
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, redirects.Valid)
+		assert.JSONEq(t, `{
+			"hops": [{"url": "http://example.com/", "status_code": 301, "location": "https://example.com/"}],
+			"hop_count": 1,
+			"final_url": "https://example.com/"
+		}`, redirects.String)
+	})
+}
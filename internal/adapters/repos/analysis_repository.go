@@ -51,19 +51,30 @@ func NewAnalysisRepository(db *sqlx.DB) *AnalysisRepository {
 }
 
 func (r *AnalysisRepository) Find(ctx context.Context, analysisID string) (*domain.Analysis, error) {
-	return r.findByCriteria(
+	analysis, err := r.findByCriteria(
 		ctx,
 		sq.Eq{"id": analysisID},
 		"",
-		fmt.Sprintf("analysis with ID %s not found", analysisID),
+		sql.ErrNoRows.Error(),
 	)
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			return nil, fmt.Errorf("%w: no analysis with ID %s", domain.ErrAnalysisNotFound, analysisID)
+		}
+
+		return nil, err
+	}
+
+	return analysis, nil
 }
 
-func (r *AnalysisRepository) FindByContentHash(ctx context.Context, contentHash string) (*domain.Analysis, error) {
+// FindByReuseKey finds the latest completed analysis whose results can be reused by an analysis
+// with the given reuse key.
+func (r *AnalysisRepository) FindByReuseKey(ctx context.Context, reuseKey string) (*domain.Analysis, error) {
 	analysis, err := r.findByCriteria(
 		ctx,
 		sq.And{
-			sq.Eq{"content_hash": contentHash},
+			sq.Eq{"reuse_key": reuseKey},
 			sq.Eq{"status": domain.StatusCompleted},
 			sq.NotEq{"results": nil},
 		},
@@ -180,7 +191,7 @@ func (r *AnalysisRepository) SaveInTx(
 	return analysis, nil
 }
 
-func (r *AnalysisRepository) Update(ctx context.Context, analysisID, contentHash string, contentSize int64, results *domain.AnalysisData) error {
+func (r *AnalysisRepository) Update(ctx context.Context, analysisID, contentHash, reuseKey string, contentSize int64, results *domain.AnalysisData) error {
 	resultsJSON, err := json.Marshal(results)
 	if err != nil {
 		return fmt.Errorf("failed to marshal results: %w", err)
@@ -190,6 +201,7 @@ func (r *AnalysisRepository) Update(ctx context.Context, analysisID, contentHash
 		ctx,
 		psql.Update(analysisTable).
 			Set("content_hash", contentHash).
+			Set("reuse_key", reuseKey).
 			Set("content_size", contentSize).
 			Set("status", domain.StatusCompleted).
 			Set("results", resultsJSON).
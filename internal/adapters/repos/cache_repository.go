@@ -90,11 +90,15 @@ func (r *CacheRepository) Delete(ctx context.Context, analysisID string) error {
 
 // generateAnalysisKey creates a unique cache key based on URL and analysis options
 func (r *CacheRepository) generateAnalysisKey(url string, options domain.AnalysisOptions) string {
-	data := fmt.Sprintf("%s:%t:%t:%t:%s",
+	data := fmt.Sprintf("%s:%t:%t:%t:%t:%t:%t:%q:%s",
 		url,
 		options.IncludeHeadings,
 		options.CheckLinks,
 		options.DetectForms,
+		options.IncludeMeta,
+		options.Accessibility,
+		options.CheckResources,
+		options.Analyzers,
 		options.Timeout.String(),
 	)
 
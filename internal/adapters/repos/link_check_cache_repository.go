@@ -0,0 +1,71 @@
+package repos
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+)
+
+const linkCheckKeyPrefix = keyPrefix + "link-check:"
+
+type LinkCheckCacheRepository struct {
+	client *infrastructure.KeydbClient
+	config config.CacheConfig
+	logger infrastructure.Logger
+}
+
+func NewLinkCheckCacheRepository(client *infrastructure.KeydbClient, cfg config.CacheConfig, logger infrastructure.Logger) *LinkCheckCacheRepository {
+	return &LinkCheckCacheRepository{
+		client: client,
+		config: cfg,
+		logger: logger,
+	}
+}
+
+func (r *LinkCheckCacheRepository) FindLinkCheck(ctx context.Context, linkURL string) (*domain.LinkCheckResult, error) {
+	data, err := r.client.Get(ctx, linkCheckKey(linkURL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get link check from cache: %w", err)
+	}
+
+	var result domain.LinkCheckResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cached link check: %w", err)
+	}
+
+	return &result, nil
+}
+
+func (r *LinkCheckCacheRepository) SaveLinkCheck(ctx context.Context, result domain.LinkCheckResult) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal link check: %w", err)
+	}
+
+	if err := r.client.Set(ctx, linkCheckKey(result.URL), data, r.expiry(result)); err != nil {
+		return fmt.Errorf("failed to save link check to cache: %w", err)
+	}
+
+	return nil
+}
+
+// expiry keeps failures for a shorter time than successes.
+func (r *LinkCheckCacheRepository) expiry(result domain.LinkCheckResult) time.Duration {
+	if result.Accessible() {
+		return r.config.LinkCheckSuccessTTL
+	}
+
+	return r.config.LinkCheckFailureTTL
+}
+
+func linkCheckKey(linkURL string) string {
+	hash := sha1.Sum([]byte(linkURL))
+
+	return linkCheckKeyPrefix + fmt.Sprintf("%x", hash)
+}
@@ -0,0 +1,66 @@
+package repos
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+)
+
+func TestLinkCheckCacheRepository_Expiry(t *testing.T) {
+	t.Parallel()
+
+	repo := NewLinkCheckCacheRepository(nil, config.CacheConfig{
+		LinkCheckSuccessTTL: 6 * time.Hour,
+		LinkCheckFailureTTL: 15 * time.Minute,
+	}, infrastructure.Logger{Logger: zerolog.Nop()})
+
+	cases := []struct {
+		name     string
+		result   domain.LinkCheckResult
+		expected time.Duration
+	}{
+		{
+			name:     "Success",
+			result:   domain.LinkCheckResult{URL: "https://example.com", StatusCode: http.StatusOK},
+			expected: 6 * time.Hour,
+		},
+		{
+			name:     "Redirect",
+			result:   domain.LinkCheckResult{URL: "https://example.com", StatusCode: http.StatusMovedPermanently},
+			expected: 6 * time.Hour,
+		},
+		{
+			name:     "Error status",
+			result:   domain.LinkCheckResult{URL: "https://example.com", StatusCode: http.StatusNotFound, Error: "404 Not Found"},
+			expected: 15 * time.Minute,
+		},
+		{
+			name:     "Unreachable",
+			result:   domain.LinkCheckResult{URL: "https://example.com", Error: "no such host"},
+			expected: 15 * time.Minute,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, repo.expiry(tc.result))
+		})
+	}
+}
+
+func TestLinkCheckKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, linkCheckKey("https://example.com/a"), linkCheckKey("https://example.com/a"))
+	assert.NotEqual(t, linkCheckKey("https://example.com/a"), linkCheckKey("https://example.com/b"))
+	assert.Contains(t, linkCheckKey("https://example.com/a"), "svc-web-analyzer:link-check:")
+}
@@ -0,0 +1,73 @@
+package repos
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+)
+
+const robotsTxtKeyPrefix = keyPrefix + "robots-txt:"
+
+type RobotsTxtCacheRepository struct {
+	client *infrastructure.KeydbClient
+	config config.CacheConfig
+	logger infrastructure.Logger
+}
+
+func NewRobotsTxtCacheRepository(client *infrastructure.KeydbClient, cfg config.CacheConfig, logger infrastructure.Logger) *RobotsTxtCacheRepository {
+	return &RobotsTxtCacheRepository{
+		client: client,
+		config: cfg,
+		logger: logger,
+	}
+}
+
+func (r *RobotsTxtCacheRepository) FindRobotsTxt(ctx context.Context, origin string) (*domain.RobotsTxt, error) {
+	data, err := r.client.Get(ctx, robotsTxtKey(origin))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get robots.txt from cache: %w", err)
+	}
+
+	var robotsTxt domain.RobotsTxt
+	if err := json.Unmarshal(data, &robotsTxt); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cached robots.txt: %w", err)
+	}
+
+	return &robotsTxt, nil
+}
+
+func (r *RobotsTxtCacheRepository) SaveRobotsTxt(ctx context.Context, robotsTxt domain.RobotsTxt) error {
+	data, err := json.Marshal(robotsTxt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal robots.txt: %w", err)
+	}
+
+	if err := r.client.Set(ctx, robotsTxtKey(robotsTxt.Origin), data, r.expiry(robotsTxt)); err != nil {
+		return fmt.Errorf("failed to save robots.txt to cache: %w", err)
+	}
+
+	return nil
+}
+
+// expiry keeps a robots.txt that answered with a server error, and so disallows everything, for a
+// shorter time.
+func (r *RobotsTxtCacheRepository) expiry(robotsTxt domain.RobotsTxt) time.Duration {
+	if robotsTxt.StatusCode >= http.StatusInternalServerError {
+		return r.config.RobotsTxtErrorTTL
+	}
+
+	return r.config.RobotsTxtTTL
+}
+
+func robotsTxtKey(origin string) string {
+	hash := sha1.Sum([]byte(origin))
+
+	return robotsTxtKeyPrefix + fmt.Sprintf("%x", hash)
+}
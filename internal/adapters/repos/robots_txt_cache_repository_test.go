@@ -0,0 +1,51 @@
+package repos
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+)
+
+func TestRobotsTxtCacheRepository_Expiry(t *testing.T) {
+	t.Parallel()
+
+	repo := NewRobotsTxtCacheRepository(nil, config.CacheConfig{
+		RobotsTxtTTL:      24 * time.Hour,
+		RobotsTxtErrorTTL: 10 * time.Minute,
+	}, infrastructure.Logger{Logger: zerolog.Nop()})
+
+	cases := []struct {
+		name       string
+		statusCode int
+		expected   time.Duration
+	}{
+		{name: "Found", statusCode: http.StatusOK, expected: 24 * time.Hour},
+		{name: "Missing", statusCode: http.StatusNotFound, expected: 24 * time.Hour},
+		{name: "Server error", statusCode: http.StatusServiceUnavailable, expected: 10 * time.Minute},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			robotsTxt := domain.RobotsTxt{Origin: "https://example.com", StatusCode: tc.statusCode}
+
+			assert.Equal(t, tc.expected, repo.expiry(robotsTxt))
+		})
+	}
+}
+
+func TestRobotsTxtKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, robotsTxtKey("https://example.com"), robotsTxtKey("https://example.com"))
+	assert.NotEqual(t, robotsTxtKey("https://example.com"), robotsTxtKey("http://example.com"))
+	assert.Contains(t, robotsTxtKey("https://example.com"), "svc-web-analyzer:robots-txt:")
+}
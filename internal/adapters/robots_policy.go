@@ -0,0 +1,178 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+	"github.com/architeacher/svc-web-analyzer/internal/ports"
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/sync/singleflight"
+)
+
+// robotsTxtMaxRedirects is the number of redirects followed to reach a robots.txt, as RFC 9309
+// asks for.
+const robotsTxtMaxRedirects = 5
+
+// RobotsPolicy decides whether URLs may be fetched according to the robots.txt of their origin. A
+// robots.txt is fetched once for all callers asking for it at the same time and shared between
+// analyses through the cache.
+type RobotsPolicy struct {
+	client   *resty.Client
+	cache    ports.RobotsTxtCacheRepository
+	inflight singleflight.Group
+	agent    string
+	logger   infrastructure.Logger
+	config   config.RobotsConfig
+}
+
+// NewRobotsPolicy creates a robots.txt policy evaluating the rules for userAgent. A nil guard
+// lets robots.txt files be fetched from any address and a nil cache fetches the robots.txt on
+// every evaluation.
+func NewRobotsPolicy(
+	config config.RobotsConfig,
+	userAgent string,
+	guard *NetworkGuard,
+	cache ports.RobotsTxtCacheRepository,
+	logger infrastructure.Logger,
+) *RobotsPolicy {
+	client := resty.New()
+
+	if guard != nil {
+		client.SetTransport(guard.Transport())
+	}
+
+	client.SetTimeout(config.Timeout).
+		SetRedirectPolicy(resty.FlexibleRedirectPolicy(robotsTxtMaxRedirects)).
+		SetHeader("User-Agent", userAgent)
+
+	return &RobotsPolicy{
+		client: client,
+		cache:  cache,
+		agent:  robotsAgentToken(userAgent),
+		logger: logger,
+		config: config,
+	}
+}
+
+// Evaluate applies the robots.txt of the URL's origin to the URL. A robots.txt that is missing or
+// cannot be reached allows everything, one answering with a server error disallows everything
+// until it recovers. A URL disallowed for an analysis that may ignore robots.txt is reported as
+// allowed and overridden.
+func (p *RobotsPolicy) Evaluate(ctx context.Context, targetURL string) domain.RobotsVerdict {
+	verdict := domain.RobotsVerdict{Allowed: true, Sitemaps: []string{}}
+
+	parsedURL, err := url.Parse(targetURL)
+	if err != nil || parsedURL.Host == "" {
+		return verdict
+	}
+
+	robotsTxt := p.robotsTxt(ctx, parsedURL.Scheme+"://"+parsedURL.Host)
+
+	switch {
+	case robotsTxt.StatusCode >= http.StatusInternalServerError:
+		verdict.Allowed = false
+		verdict.Rule = fmt.Sprintf("robots.txt answered %d", robotsTxt.StatusCode)
+
+	case robotsTxt.StatusCode >= http.StatusOK && robotsTxt.StatusCode < http.StatusMultipleChoices:
+		rules := parseRobotsTxt(robotsTxt.Content)
+
+		path := parsedURL.EscapedPath()
+		if path == "" {
+			path = "/"
+		}
+
+		if parsedURL.RawQuery != "" {
+			path += "?" + parsedURL.RawQuery
+		}
+
+		verdict.Allowed, verdict.Rule, verdict.CrawlDelay = rules.evaluate(p.agent, path)
+
+		// The robots.txt itself may always be fetched.
+		if path == "/robots.txt" {
+			verdict.Allowed, verdict.Rule = true, ""
+		}
+
+		verdict.Sitemaps = append(verdict.Sitemaps, rules.sitemaps...)
+		verdict.Wait = min(time.Duration(verdict.CrawlDelay*float64(time.Second)), p.config.MaxCrawlDelay)
+	}
+
+	if !verdict.Allowed && domain.RobotsTxtIgnored(ctx) {
+		verdict.Allowed = true
+		verdict.Overridden = true
+	}
+
+	return verdict
+}
+
+func (p *RobotsPolicy) robotsTxt(ctx context.Context, origin string) domain.RobotsTxt {
+	shared, _, _ := p.inflight.Do(origin, func() (any, error) {
+		if p.cache != nil {
+			if cached, err := p.cache.FindRobotsTxt(ctx, origin); err == nil {
+				return *cached, nil
+			}
+		}
+
+		robotsTxt := p.fetch(ctx, origin)
+
+		// An unreachable robots.txt is asked for again by the next analysis.
+		if p.cache != nil && robotsTxt.StatusCode != 0 {
+			if err := p.cache.SaveRobotsTxt(ctx, robotsTxt); err != nil {
+				p.logger.Warn().
+					Err(err).
+					Str("origin", origin).
+					Msg("Failed to cache robots.txt")
+			}
+		}
+
+		return robotsTxt, nil
+	})
+
+	return shared.(domain.RobotsTxt)
+}
+
+// fetch gets the robots.txt of an origin, reading at most MaxBodyBytes of it.
+func (p *RobotsPolicy) fetch(ctx context.Context, origin string) domain.RobotsTxt {
+	robotsTxt := domain.RobotsTxt{Origin: origin, FetchedAt: time.Now()}
+
+	resp, err := p.client.R().
+		SetContext(ctx).
+		SetDoNotParseResponse(true).
+		Get(origin + "/robots.txt")
+	if err != nil {
+		p.logger.Debug().
+			Err(err).
+			Str("origin", origin).
+			Msg("Failed to fetch robots.txt")
+
+		return robotsTxt
+	}
+
+	rawBody := resp.RawBody()
+	defer rawBody.Close()
+
+	robotsTxt.StatusCode = resp.StatusCode()
+	if resp.StatusCode() < http.StatusOK || resp.StatusCode() >= http.StatusMultipleChoices {
+		return robotsTxt
+	}
+
+	content, err := io.ReadAll(io.LimitReader(rawBody, max(p.config.MaxBodyBytes, 0)))
+	if err != nil {
+		p.logger.Debug().
+			Err(err).
+			Str("origin", origin).
+			Msg("Failed to read robots.txt")
+
+		return domain.RobotsTxt{Origin: origin, FetchedAt: robotsTxt.FetchedAt}
+	}
+
+	robotsTxt.Content = string(content)
+
+	return robotsTxt
+}
@@ -0,0 +1,152 @@
+package adapters
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRobotsTxtCache keeps robots.txt files in memory for the tests.
+type memoryRobotsTxtCache struct {
+	mu    sync.Mutex
+	files map[string]domain.RobotsTxt
+}
+
+func newMemoryRobotsTxtCache() *memoryRobotsTxtCache {
+	return &memoryRobotsTxtCache{files: make(map[string]domain.RobotsTxt)}
+}
+
+func (c *memoryRobotsTxtCache) FindRobotsTxt(_ context.Context, origin string) (*domain.RobotsTxt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	robotsTxt, ok := c.files[origin]
+	if !ok {
+		return nil, domain.ErrCacheUnavailable
+	}
+
+	return &robotsTxt, nil
+}
+
+func (c *memoryRobotsTxtCache) SaveRobotsTxt(_ context.Context, robotsTxt domain.RobotsTxt) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.files[robotsTxt.Origin] = robotsTxt
+
+	return nil
+}
+
+func newTestRobotsPolicy(cache *memoryRobotsTxtCache) *RobotsPolicy {
+	return NewRobotsPolicy(config.RobotsConfig{
+		Enabled:       true,
+		Timeout:       2 * time.Second,
+		MaxBodyBytes:  64 * 1024,
+		MaxCrawlDelay: time.Second,
+	}, "WebAnalyzer/1.0", nil, cache, infrastructure.Logger{Logger: zerolog.Nop()})
+}
+
+func TestRobotsPolicy_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/robots.txt" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		requests.Add(1)
+		assert.Equal(t, "WebAnalyzer/1.0", r.Header.Get("User-Agent"))
+
+		w.Write([]byte("User-agent: webanalyzer\nDisallow: /private\nCrawl-delay: 5\n\nSitemap: https://example.com/sitemap.xml\n"))
+	}))
+	defer server.Close()
+
+	cache := newMemoryRobotsTxtCache()
+	policy := newTestRobotsPolicy(cache)
+
+	verdict := policy.Evaluate(t.Context(), server.URL+"/private/page?x=1")
+	assert.False(t, verdict.Allowed)
+	assert.Equal(t, "Disallow: /private", verdict.Rule)
+	assert.InDelta(t, 5, verdict.CrawlDelay, 0.001)
+	assert.Equal(t, time.Second, verdict.Wait, "Should cap the crawl delay")
+	assert.Equal(t, []string{"https://example.com/sitemap.xml"}, verdict.Sitemaps)
+
+	verdict = policy.Evaluate(t.Context(), server.URL+"/public")
+	assert.True(t, verdict.Allowed)
+	assert.Empty(t, verdict.Rule)
+
+	verdict = policy.Evaluate(domain.WithRobotsTxtIgnored(t.Context()), server.URL+"/private")
+	assert.True(t, verdict.Allowed)
+	assert.True(t, verdict.Overridden)
+	assert.Equal(t, "Disallow: /private", verdict.Rule)
+
+	assert.Equal(t, int32(1), requests.Load(), "Should fetch the robots.txt once")
+	require.Contains(t, cache.files, server.URL)
+	assert.Equal(t, http.StatusOK, cache.files[server.URL].StatusCode)
+
+	// Another policy shares the cached robots.txt.
+	verdict = newTestRobotsPolicy(cache).Evaluate(t.Context(), server.URL+"/private")
+	assert.False(t, verdict.Allowed)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestRobotsPolicy_StatusCodes(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		statusCode int
+		allowed    bool
+		rule       string
+	}{
+		{name: "Missing robots.txt allows everything", statusCode: http.StatusNotFound, allowed: true},
+		{name: "Forbidden robots.txt allows everything", statusCode: http.StatusForbidden, allowed: true},
+		{name: "Server error disallows everything", statusCode: http.StatusServiceUnavailable, allowed: false, rule: "robots.txt answered 503"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statusCode)
+			}))
+			defer server.Close()
+
+			verdict := newTestRobotsPolicy(newMemoryRobotsTxtCache()).Evaluate(t.Context(), server.URL+"/page")
+
+			assert.Equal(t, tc.allowed, verdict.Allowed)
+			assert.Equal(t, tc.rule, verdict.Rule)
+		})
+	}
+}
+
+func TestRobotsPolicy_UnreachableRobotsTxt(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	serverURL := server.URL
+	server.Close()
+
+	cache := newMemoryRobotsTxtCache()
+
+	verdict := newTestRobotsPolicy(cache).Evaluate(t.Context(), serverURL+"/page")
+
+	assert.True(t, verdict.Allowed)
+	assert.Empty(t, cache.files, "Should not cache an unreachable robots.txt")
+}
@@ -0,0 +1,192 @@
+package adapters
+
+import (
+	"bufio"
+	"strconv"
+	"strings"
+)
+
+type (
+	// robotsTxt holds the groups and sitemaps of a parsed robots.txt as described by RFC 9309.
+	robotsTxt struct {
+		groups   []robotsGroup
+		sitemaps []string
+	}
+
+	// robotsGroup holds the rules that apply to the user agents named at its start.
+	robotsGroup struct {
+		agents     []string
+		rules      []robotsRule
+		crawlDelay float64
+	}
+
+	robotsRule struct {
+		allow   bool
+		pattern string
+	}
+)
+
+// parseRobotsTxt parses the groups, rules, crawl delays and sitemaps of a robots.txt, skipping
+// lines it does not understand.
+func parseRobotsTxt(content string) robotsTxt {
+	var (
+		parsed robotsTxt
+		group  *robotsGroup
+		// Consecutive user-agent lines open a single group.
+		collectingAgents bool
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(content)+1)
+
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+
+		field, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+
+		field = strings.ToLower(strings.TrimSpace(field))
+		value = strings.TrimSpace(value)
+
+		switch field {
+		case "user-agent":
+			if !collectingAgents {
+				parsed.groups = append(parsed.groups, robotsGroup{})
+				group = &parsed.groups[len(parsed.groups)-1]
+			}
+
+			group.agents = append(group.agents, robotsAgentToken(value))
+			collectingAgents = true
+
+		case "allow", "disallow":
+			collectingAgents = false
+
+			// Rules before the first group and empty rules match nothing.
+			if group == nil || value == "" {
+				continue
+			}
+
+			group.rules = append(group.rules, robotsRule{allow: field == "allow", pattern: value})
+
+		case "crawl-delay":
+			collectingAgents = false
+
+			if delay, err := strconv.ParseFloat(value, 64); group != nil && err == nil && delay > 0 {
+				group.crawlDelay = delay
+			}
+
+		case "sitemap":
+			if value != "" {
+				parsed.sitemaps = append(parsed.sitemaps, value)
+			}
+		}
+	}
+
+	return parsed
+}
+
+// evaluate decides whether the user agent whose product token is agent may fetch path, a URL
+// path with its query. The groups naming the agent apply, or the "*" groups when none does. The
+// longest matching rule wins and allow wins a tie. It returns the deciding rule, if any, and the
+// crawl delay in seconds the groups ask for.
+func (r robotsTxt) evaluate(agent, path string) (bool, string, float64) {
+	groups := r.groupsFor(agent)
+	if groups == nil {
+		groups = r.groupsFor("*")
+	}
+
+	var (
+		decision   *robotsRule
+		crawlDelay float64
+	)
+
+	for _, group := range groups {
+		crawlDelay = max(crawlDelay, group.crawlDelay)
+
+		for i, rule := range group.rules {
+			if !matchRobotsPattern(rule.pattern, path) {
+				continue
+			}
+
+			if decision == nil ||
+				len(rule.pattern) > len(decision.pattern) ||
+				(len(rule.pattern) == len(decision.pattern) && rule.allow && !decision.allow) {
+				decision = &group.rules[i]
+			}
+		}
+	}
+
+	if decision == nil {
+		return true, "", crawlDelay
+	}
+
+	directive := "Disallow"
+	if decision.allow {
+		directive = "Allow"
+	}
+
+	return decision.allow, directive + ": " + decision.pattern, crawlDelay
+}
+
+func (r robotsTxt) groupsFor(agent string) []robotsGroup {
+	var groups []robotsGroup
+
+	for _, group := range r.groups {
+		for _, groupAgent := range group.agents {
+			if groupAgent == agent {
+				groups = append(groups, group)
+
+				break
+			}
+		}
+	}
+
+	return groups
+}
+
+// robotsAgentToken returns the lower cased product token of a user agent, the part robots.txt
+// groups are matched by: "WebAnalyzer" for "WebAnalyzer/1.0 (+https://example.com)".
+func robotsAgentToken(userAgent string) string {
+	token := strings.TrimSpace(userAgent)
+
+	if end := strings.IndexFunc(token, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '*')
+	}); end >= 0 {
+		token = token[:end]
+	}
+
+	return strings.ToLower(token)
+}
+
+// matchRobotsPattern reports whether a rule pattern matches path. A "*" matches any sequence of
+// characters and a trailing "$" anchors the pattern at the end of the path.
+func matchRobotsPattern(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	if anchored {
+		pattern = strings.TrimSuffix(pattern, "$")
+	}
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+
+	position := len(parts[0])
+
+	for i, part := range parts[1:] {
+		if anchored && i == len(parts)-2 {
+			return len(path)-len(part) >= position && strings.HasSuffix(path, part)
+		}
+
+		index := strings.Index(path[position:], part)
+		if index < 0 {
+			return false
+		}
+
+		position += index + len(part)
+	}
+
+	return !anchored || position == len(path)
+}
@@ -0,0 +1,124 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRobotsTxt_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	content := `# Rules for everyone
+User-agent: *
+Disallow: /private/
+Allow: /private/public-*
+Disallow: /*.pdf$
+Crawl-delay: 2
+
+User-agent: WebAnalyzer
+User-agent: OtherBot
+Disallow: /admin # but not the rest
+Allow: /admin/help
+Crawl-delay: 0.5
+
+Sitemap: https://example.com/sitemap.xml
+Sitemap: https://example.com/news-sitemap.xml
+`
+
+	robots := parseRobotsTxt(content)
+
+	assert.Equal(t, []string{"https://example.com/sitemap.xml", "https://example.com/news-sitemap.xml"}, robots.sitemaps)
+
+	cases := []struct {
+		name       string
+		agent      string
+		path       string
+		allowed    bool
+		rule       string
+		crawlDelay float64
+	}{
+		{name: "No matching rule", agent: "somebot", path: "/docs", allowed: true, crawlDelay: 2},
+		{name: "Disallowed prefix", agent: "somebot", path: "/private/notes", allowed: false, rule: "Disallow: /private/", crawlDelay: 2},
+		{name: "Longer allow wins", agent: "somebot", path: "/private/public-report", allowed: true, rule: "Allow: /private/public-*", crawlDelay: 2},
+		{name: "Anchored wildcard", agent: "somebot", path: "/files/report.pdf", allowed: false, rule: "Disallow: /*.pdf$", crawlDelay: 2},
+		{name: "Anchored wildcard with query", agent: "somebot", path: "/files/report.pdf?download=1", allowed: true, crawlDelay: 2},
+		{name: "Own group replaces the wildcard group", agent: "webanalyzer", path: "/private/notes", allowed: true, crawlDelay: 0.5},
+		{name: "Own group disallows", agent: "webanalyzer", path: "/admin/users", allowed: false, rule: "Disallow: /admin", crawlDelay: 0.5},
+		{name: "Own group allows", agent: "webanalyzer", path: "/admin/help", allowed: true, rule: "Allow: /admin/help", crawlDelay: 0.5},
+		{name: "Agent sharing the group", agent: "otherbot", path: "/admin", allowed: false, rule: "Disallow: /admin", crawlDelay: 0.5},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			allowed, rule, crawlDelay := robots.evaluate(tc.agent, tc.path)
+
+			assert.Equal(t, tc.allowed, allowed)
+			assert.Equal(t, tc.rule, rule)
+			assert.InDelta(t, tc.crawlDelay, crawlDelay, 0.001)
+		})
+	}
+}
+
+func TestRobotsTxt_EmptyDisallow(t *testing.T) {
+	t.Parallel()
+
+	allowed, rule, _ := parseRobotsTxt("User-agent: *\nDisallow:\n").evaluate("webanalyzer", "/anything")
+
+	assert.True(t, allowed)
+	assert.Empty(t, rule)
+}
+
+func TestRobotsAgentToken(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		userAgent string
+		expected  string
+	}{
+		{userAgent: "WebAnalyzer/1.0", expected: "webanalyzer"},
+		{userAgent: "WebAnalyzer-WebCrawler/1.0 (+https://example.com/bot)", expected: "webanalyzer-webcrawler"},
+		{userAgent: " Googlebot ", expected: "googlebot"},
+		{userAgent: "*", expected: "*"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.userAgent, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, robotsAgentToken(tc.userAgent))
+		})
+	}
+}
+
+func TestMatchRobotsPattern(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{pattern: "/", path: "/anything", expected: true},
+		{pattern: "/fish", path: "/fish.html", expected: true},
+		{pattern: "/fish", path: "/Fish", expected: false},
+		{pattern: "/fish*", path: "/fishheads/yummy.html", expected: true},
+		{pattern: "/*.php", path: "/folder/index.php?x=1", expected: true},
+		{pattern: "/*.php$", path: "/folder/index.php?x=1", expected: false},
+		{pattern: "/*.php$", path: "/index.php", expected: true},
+		{pattern: "/fish$", path: "/fish", expected: true},
+		{pattern: "/fish$", path: "/fish/", expected: false},
+		{pattern: "/a*b*c", path: "/a-b-c-d", expected: true},
+		{pattern: "/a*b*c", path: "/a-c-b", expected: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.pattern+" "+tc.path, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, matchRobotsPattern(tc.pattern, tc.path))
+		})
+	}
+}
@@ -0,0 +1,643 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+const (
+	SecurityHeadersAnalyzerName    = "security_headers"
+	securityHeadersAnalyzerVersion = "1.0.0"
+
+	// hstsRecommendedMaxAge is one year, the minimum accepted by the HSTS preload list.
+	hstsRecommendedMaxAge = 31536000
+)
+
+var (
+	gradePoints = map[domain.Grade]int{
+		domain.GradeA: 4,
+		domain.GradeB: 3,
+		domain.GradeC: 2,
+		domain.GradeD: 1,
+		domain.GradeF: 0,
+	}
+
+	gradesByPoints = []domain.Grade{domain.GradeF, domain.GradeD, domain.GradeC, domain.GradeB, domain.GradeA}
+
+	referrerPolicyIssues = map[string]string{
+		"no-referrer":                     "",
+		"same-origin":                     "",
+		"strict-origin":                   "",
+		"strict-origin-when-cross-origin": "",
+		"origin":                          domain.SecurityIssueReferrerLeaksOrigin,
+		"origin-when-cross-origin":        domain.SecurityIssueReferrerLeaksOrigin,
+		"no-referrer-when-downgrade":      domain.SecurityIssueReferrerLeaksURL,
+		"unsafe-url":                      domain.SecurityIssueReferrerLeaksURL,
+	}
+
+	// sensitivePermissions are the features that should never be delegated to every origin.
+	sensitivePermissions = []string{"camera", "microphone", "geolocation", "payment", "usb", "display-capture"}
+)
+
+// SecurityHeadersAnalyzer grades the security relevant response headers of the analyzed page:
+// HSTS, Content-Security-Policy, framing protection, Referrer-Policy, Permissions-Policy,
+// COOP/COEP and the flags of the cookies it sets.
+type SecurityHeadersAnalyzer struct{}
+
+func NewSecurityHeadersAnalyzer() *SecurityHeadersAnalyzer {
+	return &SecurityHeadersAnalyzer{}
+}
+
+func (a *SecurityHeadersAnalyzer) Name() string {
+	return SecurityHeadersAnalyzerName
+}
+
+func (a *SecurityHeadersAnalyzer) Version() string {
+	return securityHeadersAnalyzerVersion
+}
+
+func (a *SecurityHeadersAnalyzer) Dependencies() []string {
+	return nil
+}
+
+func (a *SecurityHeadersAnalyzer) Run(ctx context.Context, doc *domain.Document) (any, error) {
+	pageURL, err := url.Parse(doc.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse page URL: %w", err)
+	}
+
+	isHTTPS := strings.EqualFold(pageURL.Scheme, "https")
+	headers := doc.Headers
+
+	audit := domain.SecurityHeaderAudit{}
+
+	hstsCheck, hsts := checkHSTS(headers, isHTTPS)
+	audit.HSTS = hsts
+
+	cspCheck, policies := checkContentSecurityPolicy(headers)
+	audit.ContentSecurityPolicies = policies
+
+	permissionsCheck, permissions := checkPermissionsPolicy(headers)
+	audit.PermissionsPolicy = permissions
+
+	audit.Checks = []domain.SecurityHeaderCheck{
+		hstsCheck,
+		cspCheck,
+		checkFraming(headers, policies),
+		checkReferrerPolicy(headers),
+		permissionsCheck,
+		checkCrossOriginPolicy(headers, domain.SecurityCheckCrossOriginOpenerPolicy, "Cross-Origin-Opener-Policy",
+			[]string{"same-origin", "same-origin-allow-popups", "noopener-allow-popups"}),
+		checkCrossOriginPolicy(headers, domain.SecurityCheckCrossOriginEmbedderPolicy, "Cross-Origin-Embedder-Policy",
+			[]string{"require-corp", "credentialless"}),
+	}
+
+	if cookiesCheck, cookies, ok := checkCookies(headers, isHTTPS); ok {
+		audit.Checks = append(audit.Checks, cookiesCheck)
+		audit.Cookies = cookies
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	audit.Grade = overallGrade(audit.Checks)
+
+	return audit, nil
+}
+
+func checkHSTS(headers http.Header, isHTTPS bool) (domain.SecurityHeaderCheck, *domain.HSTSPolicy) {
+	values := headers.Values("Strict-Transport-Security")
+	if len(values) == 0 {
+		return missingCheck(domain.SecurityCheckHSTS, domain.SeverityError, domain.GradeF,
+			"Strict-Transport-Security is not set, so browsers may connect over plain HTTP"), nil
+	}
+
+	check := presentCheck(domain.SecurityCheckHSTS, values)
+
+	if !isHTTPS {
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueNotHTTPS, domain.SeverityError,
+			"Strict-Transport-Security is ignored on pages served over plain HTTP"))
+		check.Grade = domain.GradeF
+
+		return check, nil
+	}
+
+	// Browsers only honour the first Strict-Transport-Security header.
+	policy, ok := parseHSTS(values[0])
+	if !ok {
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueHeaderInvalid, domain.SeverityError,
+			"Strict-Transport-Security has no valid max-age directive"))
+		check.Grade = domain.GradeF
+
+		return check, nil
+	}
+
+	switch {
+	case policy.MaxAge == 0:
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueHSTSShortMaxAge, domain.SeverityError,
+			"max-age=0 tells browsers to forget the HSTS policy"))
+		check.Grade = domain.GradeF
+
+		return check, &policy
+	case policy.MaxAge < hstsRecommendedMaxAge:
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueHSTSShortMaxAge, domain.SeverityWarning,
+			fmt.Sprintf("max-age=%d is shorter than the recommended %d seconds", policy.MaxAge, hstsRecommendedMaxAge)))
+	}
+
+	if !policy.IncludeSubDomains {
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueHSTSNoIncludeSubDomains, domain.SeverityWarning,
+			"includeSubDomains is not set, so subdomains can still be reached over plain HTTP"))
+	}
+
+	if !policy.Preload {
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueHSTSNotPreloaded, domain.SeverityInfo,
+			"preload is not set, so the first visit is not protected"))
+	}
+
+	check.Grade = gradeFindings(check.Findings)
+
+	return check, &policy
+}
+
+func parseHSTS(value string) (domain.HSTSPolicy, bool) {
+	policy := domain.HSTSPolicy{}
+	hasMaxAge := false
+
+	for _, directive := range strings.Split(value, ";") {
+		name, argument, _ := strings.Cut(strings.TrimSpace(directive), "=")
+
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "max-age":
+			maxAge, err := strconv.ParseInt(strings.Trim(strings.TrimSpace(argument), `"`), 10, 64)
+			if err != nil || maxAge < 0 {
+				return policy, false
+			}
+
+			policy.MaxAge = maxAge
+			hasMaxAge = true
+		case "includesubdomains":
+			policy.IncludeSubDomains = true
+		case "preload":
+			policy.Preload = true
+		}
+	}
+
+	return policy, hasMaxAge
+}
+
+func checkContentSecurityPolicy(headers http.Header) (domain.SecurityHeaderCheck, []domain.CSPPolicy) {
+	enforcedValues := headers.Values("Content-Security-Policy")
+	reportOnlyValues := headers.Values("Content-Security-Policy-Report-Only")
+
+	var enforced, reportOnly []domain.CSPPolicy
+	for _, value := range enforcedValues {
+		enforced = append(enforced, parseCSP(value, false)...)
+	}
+
+	for _, value := range reportOnlyValues {
+		reportOnly = append(reportOnly, parseCSP(value, true)...)
+	}
+
+	policies := append(slices.Clip(enforced), reportOnly...)
+
+	if len(enforced) == 0 {
+		if len(reportOnlyValues) == 0 {
+			return missingCheck(domain.SecurityCheckContentSecurityPolicy, domain.SeverityError, domain.GradeF,
+				"Content-Security-Policy is not set, so injected scripts run unrestricted"), nil
+		}
+
+		check := presentCheck(domain.SecurityCheckContentSecurityPolicy, reportOnlyValues)
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueCSPReportOnly, domain.SeverityWarning,
+			"Content-Security-Policy is only set in report-only mode and is not enforced"))
+		check.Grade = domain.GradeD
+
+		return check, policies
+	}
+
+	check := presentCheck(domain.SecurityCheckContentSecurityPolicy, enforcedValues)
+
+	// Every enforced policy must allow a load, so a weakness only matters when all of them share it.
+	var findings []domain.Finding
+	for i, policy := range enforced {
+		policyFindings := cspFindings(policy)
+		if i == 0 {
+			findings = policyFindings
+
+			continue
+		}
+
+		findings = slices.DeleteFunc(findings, func(finding domain.Finding) bool {
+			return !slices.ContainsFunc(policyFindings, func(other domain.Finding) bool {
+				return other.Code == finding.Code
+			})
+		})
+	}
+
+	check.Findings = append(check.Findings, findings...)
+	check.Grade = gradeFindings(check.Findings)
+
+	return check, policies
+}
+
+// parseCSP splits a header value into policies (comma separated) and their directives. Only the
+// first occurrence of a directive counts, as in browsers.
+func parseCSP(value string, reportOnly bool) []domain.CSPPolicy {
+	var policies []domain.CSPPolicy
+
+	for _, serialized := range strings.Split(value, ",") {
+		policy := domain.CSPPolicy{ReportOnly: reportOnly, Directives: map[string][]string{}}
+
+		for _, directive := range strings.Split(serialized, ";") {
+			fields := strings.Fields(directive)
+			if len(fields) == 0 {
+				continue
+			}
+
+			name := strings.ToLower(fields[0])
+			if _, exists := policy.Directives[name]; exists {
+				continue
+			}
+
+			policy.Directives[name] = fields[1:]
+		}
+
+		if len(policy.Directives) > 0 {
+			policies = append(policies, policy)
+		}
+	}
+
+	return policies
+}
+
+func cspFindings(policy domain.CSPPolicy) []domain.Finding {
+	findings := []domain.Finding{}
+
+	scriptSources, ok := cspSources(policy, "script-src")
+	if !ok {
+		return append(findings, securityFinding(domain.SecurityIssueCSPMissingScriptPolicy, domain.SeverityWarning,
+			"neither script-src nor default-src is set, so scripts may load from anywhere"))
+	}
+
+	// A nonce, a hash or 'strict-dynamic' makes browsers ignore 'unsafe-inline'.
+	neutralised := slices.ContainsFunc(scriptSources, func(source string) bool {
+		source = strings.ToLower(source)
+
+		return source == "'strict-dynamic'" || strings.HasPrefix(source, "'nonce-") ||
+			strings.HasPrefix(source, "'sha256-") || strings.HasPrefix(source, "'sha384-") || strings.HasPrefix(source, "'sha512-")
+	})
+
+	if !neutralised && containsFold(scriptSources, "'unsafe-inline'") {
+		findings = append(findings, securityFinding(domain.SecurityIssueCSPUnsafeInline, domain.SeverityError,
+			"script sources allow 'unsafe-inline', which defeats protection against injected scripts"))
+	}
+
+	if containsFold(scriptSources, "'unsafe-eval'") {
+		findings = append(findings, securityFinding(domain.SecurityIssueCSPUnsafeEval, domain.SeverityWarning,
+			"script sources allow 'unsafe-eval'"))
+	}
+
+	objectSources, _ := cspSources(policy, "object-src")
+	for _, sources := range [][]string{scriptSources, objectSources} {
+		if slices.ContainsFunc(sources, isWildcardSource) {
+			findings = append(findings, securityFinding(domain.SecurityIssueCSPWildcardSource, domain.SeverityWarning,
+				"script or object sources allow any host through a wildcard or bare scheme"))
+
+			break
+		}
+	}
+
+	return findings
+}
+
+// cspSources returns the sources of a fetch directive, falling back to default-src.
+func cspSources(policy domain.CSPPolicy, directive string) ([]string, bool) {
+	if sources, ok := policy.Directives[directive]; ok {
+		return sources, true
+	}
+
+	sources, ok := policy.Directives["default-src"]
+
+	return sources, ok
+}
+
+func isWildcardSource(source string) bool {
+	switch strings.ToLower(source) {
+	case "*", "http:", "https:", "data:", "blob:":
+		return true
+	default:
+		return false
+	}
+}
+
+func checkFraming(headers http.Header, policies []domain.CSPPolicy) domain.SecurityHeaderCheck {
+	// frame-ancestors takes precedence over X-Frame-Options when both are present.
+	for _, policy := range policies {
+		sources, ok := policy.Directives["frame-ancestors"]
+		if !ok || policy.ReportOnly {
+			continue
+		}
+
+		check := presentCheck(domain.SecurityCheckFraming, []string{strings.TrimSpace("frame-ancestors " + strings.Join(sources, " "))})
+		if slices.ContainsFunc(sources, isWildcardSource) {
+			check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueFramingWildcard, domain.SeverityWarning,
+				"frame-ancestors allows the page to be framed by any site"))
+		}
+
+		check.Grade = gradeFindings(check.Findings)
+
+		return check
+	}
+
+	values := headers.Values("X-Frame-Options")
+	if len(values) == 0 {
+		return missingCheck(domain.SecurityCheckFraming, domain.SeverityError, domain.GradeF,
+			"neither X-Frame-Options nor a frame-ancestors directive protects the page against clickjacking")
+	}
+
+	check := presentCheck(domain.SecurityCheckFraming, values)
+
+	switch option := strings.ToUpper(strings.TrimSpace(values[0])); {
+	case option == "DENY" || option == "SAMEORIGIN":
+		check.Grade = domain.GradeA
+	case strings.HasPrefix(option, "ALLOW-FROM"):
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueFramingAllowFrom, domain.SeverityError,
+			"X-Frame-Options ALLOW-FROM is not supported by current browsers; use frame-ancestors instead"))
+		check.Grade = domain.GradeF
+	default:
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueHeaderInvalid, domain.SeverityError,
+			fmt.Sprintf("X-Frame-Options value %q is not recognised", values[0])))
+		check.Grade = domain.GradeF
+	}
+
+	return check
+}
+
+func checkReferrerPolicy(headers http.Header) domain.SecurityHeaderCheck {
+	values := headers.Values("Referrer-Policy")
+	if len(values) == 0 {
+		return missingCheck(domain.SecurityCheckReferrerPolicy, domain.SeverityWarning, domain.GradeC,
+			"Referrer-Policy is not set, so the browser default applies")
+	}
+
+	check := presentCheck(domain.SecurityCheckReferrerPolicy, values)
+
+	// The last policy the browser understands wins, which allows fallbacks for older browsers.
+	policy := ""
+	for _, token := range strings.Split(strings.Join(values, ","), ",") {
+		token = strings.ToLower(strings.TrimSpace(token))
+		if _, known := referrerPolicyIssues[token]; known {
+			policy = token
+		}
+	}
+
+	switch issue := referrerPolicyIssues[policy]; {
+	case policy == "":
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueHeaderInvalid, domain.SeverityError,
+			"Referrer-Policy contains no recognised policy"))
+	case issue == domain.SecurityIssueReferrerLeaksURL:
+		check.Findings = append(check.Findings, securityFinding(issue, domain.SeverityError,
+			fmt.Sprintf("%s sends the full URL, including path and query, to other sites", policy)))
+	case issue == domain.SecurityIssueReferrerLeaksOrigin:
+		check.Findings = append(check.Findings, securityFinding(issue, domain.SeverityWarning,
+			fmt.Sprintf("%s sends the origin to other sites, including over plain HTTP", policy)))
+	}
+
+	check.Grade = gradeFindings(check.Findings)
+
+	return check
+}
+
+func checkPermissionsPolicy(headers http.Header) (domain.SecurityHeaderCheck, map[string][]string) {
+	values := headers.Values("Permissions-Policy")
+	if len(values) == 0 {
+		return missingCheck(domain.SecurityCheckPermissionsPolicy, domain.SeverityWarning, domain.GradeC,
+			"Permissions-Policy is not set, so embedded content may request powerful features"), nil
+	}
+
+	check := presentCheck(domain.SecurityCheckPermissionsPolicy, values)
+
+	permissions, ok := parsePermissionsPolicy(strings.Join(values, ","))
+	if !ok {
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueHeaderInvalid, domain.SeverityError,
+			"Permissions-Policy is not a valid structured header dictionary"))
+	}
+
+	var delegated []string
+	for _, feature := range sensitivePermissions {
+		if slices.Contains(permissions[feature], "*") {
+			delegated = append(delegated, feature)
+		}
+	}
+
+	if len(delegated) > 0 {
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssuePermissionsWildcard, domain.SeverityWarning,
+			fmt.Sprintf("sensitive features are allowed for every origin: %s", strings.Join(delegated, ", "))))
+	}
+
+	check.Grade = gradeFindings(check.Findings)
+
+	return check, permissions
+}
+
+// parsePermissionsPolicy reads the feature=allowlist dictionary, e.g. camera=(), geolocation=(self
+// "https://maps.example.com"), fullscreen=*. Allowlists are returned without quotes or parentheses.
+func parsePermissionsPolicy(value string) (map[string][]string, bool) {
+	permissions := map[string][]string{}
+	valid := true
+
+	for _, member := range strings.Split(value, ",") {
+		member = strings.TrimSpace(member)
+		if member == "" {
+			continue
+		}
+
+		feature, allowlist, found := strings.Cut(member, "=")
+		feature = strings.ToLower(strings.TrimSpace(feature))
+		if !found || feature == "" {
+			valid = false
+
+			continue
+		}
+
+		allowlist = strings.TrimSpace(allowlist)
+		if inner, isList := strings.CutPrefix(allowlist, "("); isList {
+			allowlist = strings.TrimSuffix(inner, ")")
+		}
+
+		origins := []string{}
+		for _, origin := range strings.Fields(allowlist) {
+			origins = append(origins, strings.Trim(origin, `"`))
+		}
+
+		permissions[feature] = origins
+	}
+
+	return permissions, valid
+}
+
+func checkCrossOriginPolicy(headers http.Header, name, header string, accepted []string) domain.SecurityHeaderCheck {
+	values := headers.Values(header)
+	if len(values) == 0 {
+		return missingCheck(name, domain.SeverityWarning, domain.GradeC,
+			fmt.Sprintf("%s is not set, so the page is not isolated from cross-origin documents", header))
+	}
+
+	check := presentCheck(name, values)
+
+	// The value may carry parameters such as report-to, which do not change the policy.
+	policy, _, _ := strings.Cut(values[0], ";")
+	policy = strings.ToLower(strings.TrimSpace(policy))
+
+	switch {
+	case slices.Contains(accepted, policy):
+	case policy == "unsafe-none":
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueCrossOriginUnsafeNone, domain.SeverityError,
+			fmt.Sprintf("%s is explicitly disabled with unsafe-none", header)))
+	default:
+		check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueHeaderInvalid, domain.SeverityError,
+			fmt.Sprintf("%s value %q is not recognised", header, values[0])))
+	}
+
+	check.Grade = gradeFindings(check.Findings)
+
+	return check
+}
+
+// checkCookies audits every Set-Cookie header. It reports ok=false when the page sets no cookies,
+// in which case there is nothing to grade.
+func checkCookies(headers http.Header, isHTTPS bool) (domain.SecurityHeaderCheck, []domain.CookieFlags, bool) {
+	values := headers.Values("Set-Cookie")
+	if len(values) == 0 {
+		return domain.SecurityHeaderCheck{}, nil, false
+	}
+
+	check := presentCheck(domain.SecurityCheckCookies, values)
+	cookies := make([]domain.CookieFlags, 0, len(values))
+
+	for _, value := range values {
+		cookie, err := http.ParseSetCookie(value)
+		if err != nil {
+			check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueHeaderInvalid, domain.SeverityWarning,
+				fmt.Sprintf("Set-Cookie value could not be parsed: %v", err)))
+
+			continue
+		}
+
+		flags := domain.CookieFlags{
+			Name:     cookie.Name,
+			Secure:   cookie.Secure,
+			HTTPOnly: cookie.HttpOnly,
+			SameSite: sameSiteName(cookie.SameSite),
+		}
+		cookies = append(cookies, flags)
+
+		switch {
+		case flags.SameSite == "None" && !flags.Secure:
+			check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueCookieSameSiteNoneInsecure, domain.SeverityError,
+				fmt.Sprintf("cookie %s uses SameSite=None without Secure and is rejected by browsers", flags.Name)))
+		case !flags.Secure && isHTTPS:
+			check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueCookieMissingSecure, domain.SeverityWarning,
+				fmt.Sprintf("cookie %s is not marked Secure and may be sent over plain HTTP", flags.Name)))
+		}
+
+		if !flags.HTTPOnly {
+			check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueCookieMissingHTTPOnly, domain.SeverityInfo,
+				fmt.Sprintf("cookie %s is readable from JavaScript", flags.Name)))
+		}
+
+		if flags.SameSite == "" {
+			check.Findings = append(check.Findings, securityFinding(domain.SecurityIssueCookieMissingSameSite, domain.SeverityInfo,
+				fmt.Sprintf("cookie %s has no SameSite attribute and relies on the browser default", flags.Name)))
+		}
+	}
+
+	check.Grade = gradeFindings(check.Findings)
+
+	return check, cookies, true
+}
+
+func sameSiteName(mode http.SameSite) string {
+	switch mode {
+	case http.SameSiteLaxMode:
+		return "Lax"
+	case http.SameSiteStrictMode:
+		return "Strict"
+	case http.SameSiteNoneMode:
+		return "None"
+	default:
+		return ""
+	}
+}
+
+func missingCheck(name string, severity domain.Severity, grade domain.Grade, message string) domain.SecurityHeaderCheck {
+	return domain.SecurityHeaderCheck{
+		Name:     name,
+		Grade:    grade,
+		Findings: []domain.Finding{securityFinding(domain.SecurityIssueHeaderMissing, severity, message)},
+	}
+}
+
+func presentCheck(name string, values []string) domain.SecurityHeaderCheck {
+	return domain.SecurityHeaderCheck{
+		Name:     name,
+		Present:  true,
+		Values:   values,
+		Findings: []domain.Finding{},
+	}
+}
+
+func securityFinding(code string, severity domain.Severity, message string) domain.Finding {
+	return domain.Finding{Code: code, Severity: severity, Message: message}
+}
+
+// gradeFindings grades a present header: every distinct error costs two grades and every
+// distinct warning one, so repeating the same problem (e.g. on many cookies) is not punished
+// twice. A present header never drops below D; F is reserved for missing or ineffective ones.
+func gradeFindings(findings []domain.Finding) domain.Grade {
+	points := gradePoints[domain.GradeA]
+	seen := make(map[string]bool)
+
+	for _, finding := range findings {
+		if seen[finding.Code] {
+			continue
+		}
+		seen[finding.Code] = true
+
+		switch finding.Severity {
+		case domain.SeverityError:
+			points -= 2
+		case domain.SeverityWarning:
+			points--
+		}
+	}
+
+	return gradesByPoints[max(points, gradePoints[domain.GradeD])]
+}
+
+func overallGrade(checks []domain.SecurityHeaderCheck) domain.Grade {
+	if len(checks) == 0 {
+		return domain.GradeF
+	}
+
+	total := 0
+	for _, check := range checks {
+		total += gradePoints[check.Grade]
+	}
+
+	return gradesByPoints[int(math.Round(float64(total)/float64(len(checks))))]
+}
+
+func containsFold(values []string, target string) bool {
+	return slices.ContainsFunc(values, func(value string) bool {
+		return strings.EqualFold(value, target)
+	})
+}
@@ -0,0 +1,248 @@
+package adapters
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityHeadersAnalyzer_Run(t *testing.T) {
+	t.Parallel()
+
+	analyzer := NewSecurityHeadersAnalyzer()
+
+	cases := []struct {
+		name     string
+		url      string
+		headers  http.Header
+		grade    domain.Grade
+		grades   map[string]domain.Grade
+		findings map[string][]string
+		assert   func(t *testing.T, audit domain.SecurityHeaderAudit)
+	}{
+		{
+			name: "hardened site",
+			url:  "https://secure.example.com/",
+			headers: http.Header{
+				"Strict-Transport-Security":    {"max-age=63072000; includeSubDomains; preload"},
+				"Content-Security-Policy":      {"default-src 'self'; script-src 'self' 'nonce-r4nd0m' 'unsafe-inline'; object-src 'none'; frame-ancestors 'none'"},
+				"Referrer-Policy":              {"no-referrer, strict-origin-when-cross-origin"},
+				"Permissions-Policy":           {`camera=(), geolocation=(self "https://maps.example.com")`},
+				"Cross-Origin-Opener-Policy":   {"same-origin"},
+				"Cross-Origin-Embedder-Policy": {"require-corp; report-to=\"coep\""},
+				"Set-Cookie":                   {"session=abc; Path=/; Secure; HttpOnly; SameSite=Strict"},
+			},
+			grade: domain.GradeA,
+			grades: map[string]domain.Grade{
+				domain.SecurityCheckHSTS:                      domain.GradeA,
+				domain.SecurityCheckContentSecurityPolicy:     domain.GradeA,
+				domain.SecurityCheckFraming:                   domain.GradeA,
+				domain.SecurityCheckReferrerPolicy:            domain.GradeA,
+				domain.SecurityCheckPermissionsPolicy:         domain.GradeA,
+				domain.SecurityCheckCrossOriginOpenerPolicy:   domain.GradeA,
+				domain.SecurityCheckCrossOriginEmbedderPolicy: domain.GradeA,
+				domain.SecurityCheckCookies:                   domain.GradeA,
+			},
+			findings: map[string][]string{},
+			assert: func(t *testing.T, audit domain.SecurityHeaderAudit) {
+				assert.Equal(t, &domain.HSTSPolicy{MaxAge: 63072000, IncludeSubDomains: true, Preload: true}, audit.HSTS)
+				require.Len(t, audit.ContentSecurityPolicies, 1)
+				assert.Equal(t, []string{"'none'"}, audit.ContentSecurityPolicies[0].Directives["frame-ancestors"])
+				assert.Equal(t, map[string][]string{
+					"camera":      {},
+					"geolocation": {"self", "https://maps.example.com"},
+				}, audit.PermissionsPolicy)
+				assert.Equal(t, []domain.CookieFlags{{Name: "session", Secure: true, HTTPOnly: true, SameSite: "Strict"}}, audit.Cookies)
+			},
+		},
+		{
+			name:  "no security headers",
+			url:   "https://bare.example.com/",
+			grade: domain.GradeD,
+			grades: map[string]domain.Grade{
+				domain.SecurityCheckHSTS:                      domain.GradeF,
+				domain.SecurityCheckContentSecurityPolicy:     domain.GradeF,
+				domain.SecurityCheckFraming:                   domain.GradeF,
+				domain.SecurityCheckReferrerPolicy:            domain.GradeC,
+				domain.SecurityCheckPermissionsPolicy:         domain.GradeC,
+				domain.SecurityCheckCrossOriginOpenerPolicy:   domain.GradeC,
+				domain.SecurityCheckCrossOriginEmbedderPolicy: domain.GradeC,
+			},
+			findings: map[string][]string{
+				domain.SecurityCheckHSTS:                      {domain.SecurityIssueHeaderMissing},
+				domain.SecurityCheckContentSecurityPolicy:     {domain.SecurityIssueHeaderMissing},
+				domain.SecurityCheckFraming:                   {domain.SecurityIssueHeaderMissing},
+				domain.SecurityCheckReferrerPolicy:            {domain.SecurityIssueHeaderMissing},
+				domain.SecurityCheckPermissionsPolicy:         {domain.SecurityIssueHeaderMissing},
+				domain.SecurityCheckCrossOriginOpenerPolicy:   {domain.SecurityIssueHeaderMissing},
+				domain.SecurityCheckCrossOriginEmbedderPolicy: {domain.SecurityIssueHeaderMissing},
+			},
+			assert: func(t *testing.T, audit domain.SecurityHeaderAudit) {
+				assert.Nil(t, audit.HSTS)
+				assert.Empty(t, audit.ContentSecurityPolicies)
+				assert.Empty(t, audit.Cookies)
+			},
+		},
+		{
+			name: "weak configuration",
+			url:  "https://weak.example.com/",
+			headers: http.Header{
+				"Strict-Transport-Security":    {"max-age=86400", "max-age=63072000; includeSubDomains"},
+				"Content-Security-Policy":      {"default-src * 'unsafe-inline' 'unsafe-eval', script-src 'self' 'unsafe-inline'"},
+				"X-Frame-Options":              {"ALLOW-FROM https://partner.example.org"},
+				"Referrer-Policy":              {"unsafe-url"},
+				"Permissions-Policy":           {"camera=*, microphone=*, fullscreen=*"},
+				"Cross-Origin-Opener-Policy":   {"unsafe-none"},
+				"Cross-Origin-Embedder-Policy": {"credentialless"},
+				"Set-Cookie": {
+					"tracking=1; SameSite=None",
+					"prefs=dark; Path=/",
+					"cart=42; Secure; HttpOnly; SameSite=Lax",
+				},
+			},
+			grade: domain.GradeC,
+			grades: map[string]domain.Grade{
+				domain.SecurityCheckHSTS:                      domain.GradeC,
+				domain.SecurityCheckContentSecurityPolicy:     domain.GradeC,
+				domain.SecurityCheckFraming:                   domain.GradeF,
+				domain.SecurityCheckReferrerPolicy:            domain.GradeC,
+				domain.SecurityCheckPermissionsPolicy:         domain.GradeB,
+				domain.SecurityCheckCrossOriginOpenerPolicy:   domain.GradeC,
+				domain.SecurityCheckCrossOriginEmbedderPolicy: domain.GradeA,
+				domain.SecurityCheckCookies:                   domain.GradeD,
+			},
+			findings: map[string][]string{
+				domain.SecurityCheckHSTS: {
+					domain.SecurityIssueHSTSShortMaxAge,
+					domain.SecurityIssueHSTSNoIncludeSubDomains,
+					domain.SecurityIssueHSTSNotPreloaded,
+				},
+				domain.SecurityCheckContentSecurityPolicy:   {domain.SecurityIssueCSPUnsafeInline},
+				domain.SecurityCheckFraming:                 {domain.SecurityIssueFramingAllowFrom},
+				domain.SecurityCheckReferrerPolicy:          {domain.SecurityIssueReferrerLeaksURL},
+				domain.SecurityCheckPermissionsPolicy:       {domain.SecurityIssuePermissionsWildcard},
+				domain.SecurityCheckCrossOriginOpenerPolicy: {domain.SecurityIssueCrossOriginUnsafeNone},
+				domain.SecurityCheckCookies: {
+					domain.SecurityIssueCookieSameSiteNoneInsecure,
+					domain.SecurityIssueCookieMissingHTTPOnly,
+					domain.SecurityIssueCookieMissingSecure,
+					domain.SecurityIssueCookieMissingHTTPOnly,
+					domain.SecurityIssueCookieMissingSameSite,
+				},
+			},
+			assert: func(t *testing.T, audit domain.SecurityHeaderAudit) {
+				assert.Equal(t, &domain.HSTSPolicy{MaxAge: 86400}, audit.HSTS)
+				assert.Len(t, audit.ContentSecurityPolicies, 2)
+				assert.Len(t, audit.Cookies, 3)
+
+				for _, check := range audit.Checks {
+					if check.Name == domain.SecurityCheckHSTS {
+						assert.Equal(t, []string{"max-age=86400", "max-age=63072000; includeSubDomains"}, check.Values)
+					}
+				}
+			},
+		},
+		{
+			name: "HSTS over plain HTTP and report-only CSP",
+			url:  "http://plain.example.com/",
+			headers: http.Header{
+				"Strict-Transport-Security":           {"max-age=63072000; includeSubDomains; preload"},
+				"Content-Security-Policy-Report-Only": {"default-src 'self'; frame-ancestors 'none'"},
+				"X-Frame-Options":                     {"SAMEORIGIN"},
+			},
+			grade: domain.GradeC,
+			grades: map[string]domain.Grade{
+				domain.SecurityCheckHSTS:                      domain.GradeF,
+				domain.SecurityCheckContentSecurityPolicy:     domain.GradeD,
+				domain.SecurityCheckFraming:                   domain.GradeA,
+				domain.SecurityCheckReferrerPolicy:            domain.GradeC,
+				domain.SecurityCheckPermissionsPolicy:         domain.GradeC,
+				domain.SecurityCheckCrossOriginOpenerPolicy:   domain.GradeC,
+				domain.SecurityCheckCrossOriginEmbedderPolicy: domain.GradeC,
+			},
+			findings: map[string][]string{
+				domain.SecurityCheckHSTS:                      {domain.SecurityIssueNotHTTPS},
+				domain.SecurityCheckContentSecurityPolicy:     {domain.SecurityIssueCSPReportOnly},
+				domain.SecurityCheckReferrerPolicy:            {domain.SecurityIssueHeaderMissing},
+				domain.SecurityCheckPermissionsPolicy:         {domain.SecurityIssueHeaderMissing},
+				domain.SecurityCheckCrossOriginOpenerPolicy:   {domain.SecurityIssueHeaderMissing},
+				domain.SecurityCheckCrossOriginEmbedderPolicy: {domain.SecurityIssueHeaderMissing},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := domain.NewDocument(&domain.WebPageContent{URL: tc.url, Headers: tc.headers, HTML: "<html></html>"})
+			require.NoError(t, err)
+
+			output, err := analyzer.Run(context.Background(), doc)
+			require.NoError(t, err)
+
+			audit, ok := output.(domain.SecurityHeaderAudit)
+			require.True(t, ok)
+
+			grades := map[string]domain.Grade{}
+			findings := map[string][]string{}
+			for _, check := range audit.Checks {
+				grades[check.Name] = check.Grade
+				for _, finding := range check.Findings {
+					findings[check.Name] = append(findings[check.Name], finding.Code)
+				}
+			}
+
+			assert.Equal(t, tc.grade, audit.Grade)
+			assert.Equal(t, tc.grades, grades)
+			assert.Equal(t, tc.findings, findings)
+
+			if tc.assert != nil {
+				tc.assert(t, audit)
+			}
+		})
+	}
+}
+
+func TestParseCSP(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		value    string
+		expected []domain.CSPPolicy
+	}{
+		{
+			name:  "single policy with repeated directive",
+			value: "default-src 'self'; IMG-SRC * data:; img-src 'none';",
+			expected: []domain.CSPPolicy{{Directives: map[string][]string{
+				"default-src": {"'self'"},
+				"img-src":     {"*", "data:"},
+			}}},
+		},
+		{
+			name:  "comma separated policies and valueless directive",
+			value: "script-src 'self', upgrade-insecure-requests",
+			expected: []domain.CSPPolicy{
+				{Directives: map[string][]string{"script-src": {"'self'"}}},
+				{Directives: map[string][]string{"upgrade-insecure-requests": {}}},
+			},
+		},
+		{
+			name:  "empty value",
+			value: " ; ",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, parseCSP(tc.value, false))
+		})
+	}
+}
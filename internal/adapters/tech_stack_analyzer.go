@@ -0,0 +1,383 @@
+package adapters
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+const (
+	TechStackAnalyzerName    = "tech_stack"
+	techStackAnalyzerVersion = "1.0.0"
+
+	defaultSignatureConfidence = 100
+	maxSignatureConfidence     = 100
+)
+
+// technologySignaturesJSON is the signature database; bump its version field on every change.
+//
+//go:embed signatures/technologies.json
+var technologySignaturesJSON []byte
+
+type (
+	// signaturePattern matches a single input. An empty pattern matches on presence alone, and
+	// Version is a regexp template such as "$1" expanded from the pattern's capture groups.
+	signaturePattern struct {
+		Pattern    string `json:"pattern"`
+		Version    string `json:"version,omitempty"`
+		Confidence int    `json:"confidence,omitempty"`
+
+		regex *regexp.Regexp
+	}
+
+	technologySignature struct {
+		Name     string                       `json:"name"`
+		Category domain.TechCategory          `json:"category"`
+		Headers  map[string]*signaturePattern `json:"headers,omitempty"`
+		Meta     map[string]*signaturePattern `json:"meta,omitempty"`
+		Cookies  map[string]*signaturePattern `json:"cookies,omitempty"`
+		Scripts  []*signaturePattern          `json:"scripts,omitempty"`
+		HTML     []*signaturePattern          `json:"html,omitempty"`
+		Implies  []string                     `json:"implies,omitempty"`
+	}
+
+	signatureDatabase struct {
+		Version      string                `json:"version"`
+		Technologies []technologySignature `json:"technologies"`
+	}
+
+	// fingerprintInputs is everything a signature can match against, gathered once per page.
+	fingerprintInputs struct {
+		headers map[string]string
+		meta    map[string][]string
+		cookies map[string]string
+		scripts []string
+		html    string
+	}
+
+	// TechStackAnalyzer fingerprints the CMS, frameworks, analytics tools, CDNs and
+	// JavaScript libraries a page uses.
+	TechStackAnalyzer struct {
+		signatures *signatureDatabase
+	}
+)
+
+func NewTechStackAnalyzer() (*TechStackAnalyzer, error) {
+	signatures, err := loadSignatureDatabase(technologySignaturesJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TechStackAnalyzer{signatures: signatures}, nil
+}
+
+func (a *TechStackAnalyzer) Name() string {
+	return TechStackAnalyzerName
+}
+
+func (a *TechStackAnalyzer) Version() string {
+	return techStackAnalyzerVersion
+}
+
+func (a *TechStackAnalyzer) Dependencies() []string {
+	return nil
+}
+
+func (a *TechStackAnalyzer) Run(ctx context.Context, doc *domain.Document) (any, error) {
+	inputs := collectFingerprintInputs(doc)
+	detections := make(map[string]*domain.Technology)
+
+	for _, signature := range a.signatures.Technologies {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if technology := signature.match(inputs); technology != nil {
+			detections[technology.Name] = technology
+		}
+	}
+
+	a.addImpliedTechnologies(detections)
+
+	technologies := make([]domain.Technology, 0, len(detections))
+	for _, technology := range detections {
+		technologies = append(technologies, *technology)
+	}
+
+	sort.Slice(technologies, func(i, j int) bool {
+		if technologies[i].Confidence != technologies[j].Confidence {
+			return technologies[i].Confidence > technologies[j].Confidence
+		}
+
+		return technologies[i].Name < technologies[j].Name
+	})
+
+	return domain.TechStack{
+		SignaturesVersion: a.signatures.Version,
+		Technologies:      technologies,
+	}, nil
+}
+
+// addImpliedTechnologies adds the technologies a detection implies, e.g. PHP for WordPress.
+// An implied technology inherits the confidence of the detection implying it.
+func (a *TechStackAnalyzer) addImpliedTechnologies(detections map[string]*domain.Technology) {
+	signatures := make(map[string]technologySignature, len(a.signatures.Technologies))
+	for _, signature := range a.signatures.Technologies {
+		signatures[signature.Name] = signature
+	}
+
+	queue := make([]string, 0, len(detections))
+	for name := range detections {
+		queue = append(queue, name)
+	}
+
+	sort.Strings(queue)
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for _, implied := range signatures[name].Implies {
+			evidence := "implied:" + name
+
+			if existing, ok := detections[implied]; ok {
+				if existing.Confidence < detections[name].Confidence {
+					existing.Confidence = detections[name].Confidence
+					existing.Evidence = append(existing.Evidence, evidence)
+				}
+
+				continue
+			}
+
+			detections[implied] = &domain.Technology{
+				Name:       implied,
+				Category:   signatures[implied].Category,
+				Confidence: detections[name].Confidence,
+				Evidence:   []string{evidence},
+			}
+			queue = append(queue, implied)
+		}
+	}
+}
+
+func (s technologySignature) match(inputs fingerprintInputs) *domain.Technology {
+	technology := &domain.Technology{
+		Name:     s.Name,
+		Category: s.Category,
+		Evidence: []string{},
+	}
+
+	matchKeyed := func(source string, patterns map[string]*signaturePattern, values func(key string) []string) {
+		keys := make([]string, 0, len(patterns))
+		for key := range patterns {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			for _, value := range values(key) {
+				if patterns[key].apply(technology, value) {
+					technology.Evidence = append(technology.Evidence, source+":"+key)
+
+					break
+				}
+			}
+		}
+	}
+
+	matchKeyed("header", s.Headers, func(key string) []string {
+		if value, ok := inputs.headers[key]; ok {
+			return []string{value}
+		}
+
+		return nil
+	})
+	matchKeyed("meta", s.Meta, func(key string) []string {
+		return inputs.meta[key]
+	})
+	matchKeyed("cookie", s.Cookies, func(key string) []string {
+		if value, ok := inputs.cookies[key]; ok {
+			return []string{value}
+		}
+
+		return nil
+	})
+
+	for _, pattern := range s.Scripts {
+		for _, src := range inputs.scripts {
+			if pattern.apply(technology, src) {
+				technology.Evidence = append(technology.Evidence, "script")
+
+				break
+			}
+		}
+	}
+
+	for _, pattern := range s.HTML {
+		if pattern.apply(technology, inputs.html) {
+			technology.Evidence = append(technology.Evidence, "html")
+		}
+	}
+
+	if len(technology.Evidence) == 0 {
+		return nil
+	}
+
+	technology.Evidence = uniqueStrings(technology.Evidence)
+
+	return technology
+}
+
+// apply matches the pattern against value and, on a match, raises the technology's confidence
+// and fills in its version when the pattern can extract one.
+func (p *signaturePattern) apply(technology *domain.Technology, value string) bool {
+	submatches := p.regex.FindStringSubmatchIndex(value)
+	if submatches == nil {
+		return false
+	}
+
+	technology.Confidence = min(technology.Confidence+p.Confidence, maxSignatureConfidence)
+
+	if technology.Version == "" && p.Version != "" {
+		technology.Version = string(p.regex.ExpandString(nil, p.Version, value, submatches))
+	}
+
+	return true
+}
+
+func collectFingerprintInputs(doc *domain.Document) fingerprintInputs {
+	inputs := fingerprintInputs{
+		headers: make(map[string]string, len(doc.Headers)),
+		meta:    make(map[string][]string),
+		cookies: make(map[string]string),
+		html:    doc.HTML,
+	}
+
+	for key, values := range doc.Headers {
+		key = strings.ToLower(key)
+		inputs.headers[key] = strings.Join(values, ", ")
+
+		if key != "set-cookie" {
+			continue
+		}
+
+		for _, value := range values {
+			if cookie, err := http.ParseSetCookie(value); err == nil {
+				inputs.cookies[cookie.Name] = cookie.Value
+			}
+		}
+	}
+
+	if doc.Root == nil {
+		return inputs
+	}
+
+	goquery.NewDocumentFromNode(doc.Root).Find("meta[name], script[src]").Each(func(i int, s *goquery.Selection) {
+		if goquery.NodeName(s) == "script" {
+			inputs.scripts = append(inputs.scripts, strings.TrimSpace(s.AttrOr("src", "")))
+
+			return
+		}
+
+		name := strings.ToLower(strings.TrimSpace(s.AttrOr("name", "")))
+		inputs.meta[name] = append(inputs.meta[name], strings.TrimSpace(s.AttrOr("content", "")))
+	})
+
+	return inputs
+}
+
+func loadSignatureDatabase(data []byte) (*signatureDatabase, error) {
+	var database signatureDatabase
+	if err := json.Unmarshal(data, &database); err != nil {
+		return nil, fmt.Errorf("failed to decode technology signatures: %w", err)
+	}
+
+	if database.Version == "" {
+		return nil, fmt.Errorf("technology signatures have no version")
+	}
+
+	names := make(map[string]bool, len(database.Technologies))
+	for _, technology := range database.Technologies {
+		names[technology.Name] = true
+	}
+
+	for i := range database.Technologies {
+		technology := &database.Technologies[i]
+
+		patterns := make([]*signaturePattern, 0)
+		for _, keyed := range []map[string]*signaturePattern{technology.Headers, technology.Meta, technology.Cookies} {
+			for _, pattern := range keyed {
+				patterns = append(patterns, pattern)
+			}
+		}
+
+		patterns = append(patterns, technology.Scripts...)
+		patterns = append(patterns, technology.HTML...)
+
+		for _, pattern := range patterns {
+			if err := pattern.compile(); err != nil {
+				return nil, fmt.Errorf("invalid signature for %s: %w", technology.Name, err)
+			}
+		}
+
+		if technology.Headers != nil {
+			headers := make(map[string]*signaturePattern, len(technology.Headers))
+			for key, pattern := range technology.Headers {
+				headers[strings.ToLower(key)] = pattern
+			}
+
+			technology.Headers = headers
+		}
+
+		for _, implied := range technology.Implies {
+			if !names[implied] {
+				return nil, fmt.Errorf("signature for %s implies unknown technology %q", technology.Name, implied)
+			}
+		}
+	}
+
+	return &database, nil
+}
+
+func (p *signaturePattern) compile() error {
+	if p == nil {
+		return fmt.Errorf("empty pattern")
+	}
+
+	regex, err := regexp.Compile("(?i)" + p.Pattern)
+	if err != nil {
+		return fmt.Errorf("failed to compile pattern %q: %w", p.Pattern, err)
+	}
+
+	p.regex = regex
+	if p.Confidence == 0 {
+		p.Confidence = defaultSignatureConfidence
+	}
+
+	return nil
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := values[:0]
+
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+
+		seen[value] = true
+		unique = append(unique, value)
+	}
+
+	return unique
+}
@@ -0,0 +1,154 @@
+package adapters
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTechStackAnalyzer_Run(t *testing.T) {
+	t.Parallel()
+
+	analyzer, err := NewTechStackAnalyzer()
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		content  domain.WebPageContent
+		expected []domain.Technology
+	}{
+		{
+			name: "meta generator with version and implied language",
+			content: domain.WebPageContent{
+				HTML: `<html><head>
+					<meta name="generator" content="WordPress 6.4.2">
+					<link rel="stylesheet" href="/wp-content/themes/site/style.css">
+				</head><body></body></html>`,
+			},
+			expected: []domain.Technology{
+				{Name: "PHP", Category: domain.TechCategoryProgrammingLanguage, Confidence: 100, Evidence: []string{"implied:WordPress"}},
+				{Name: "WordPress", Category: domain.TechCategoryCMS, Version: "6.4.2", Confidence: 100, Evidence: []string{"meta:generator", "html"}},
+			},
+		},
+		{
+			name: "headers and cookies",
+			content: domain.WebPageContent{
+				Headers: http.Header{
+					"Server":       {"nginx/1.25.3"},
+					"X-Powered-By": {"PHP/8.2.1"},
+					"Set-Cookie":   {"theme=dark; Path=/", "PHPSESSID=abc123; Path=/; HttpOnly"},
+				},
+				HTML: `<html><body></body></html>`,
+			},
+			expected: []domain.Technology{
+				{Name: "Nginx", Category: domain.TechCategoryWebServer, Version: "1.25.3", Confidence: 100, Evidence: []string{"header:server"}},
+				{Name: "PHP", Category: domain.TechCategoryProgrammingLanguage, Version: "8.2.1", Confidence: 100, Evidence: []string{"header:x-powered-by", "cookie:PHPSESSID"}},
+			},
+		},
+		{
+			name: "script sources",
+			content: domain.WebPageContent{
+				HTML: `<html><head>
+					<script src="https://cdnjs.cloudflare.com/ajax/libs/jquery/3.7.1/jquery.min.js"></script>
+					<script async src="https://www.googletagmanager.com/gtag/js?id=G-XXXX"></script>
+				</head><body></body></html>`,
+			},
+			expected: []domain.Technology{
+				{Name: "Google Analytics", Category: domain.TechCategoryAnalytics, Confidence: 100, Evidence: []string{"script"}},
+				{Name: "cdnjs", Category: domain.TechCategoryCDN, Confidence: 100, Evidence: []string{"script"}},
+				{Name: "jQuery", Category: domain.TechCategoryJavaScriptLibrary, Version: "3.7.1", Confidence: 100, Evidence: []string{"script"}},
+			},
+		},
+		{
+			name: "weak signals add up to a partial confidence",
+			content: domain.WebPageContent{
+				HTML: `<html><body><form><input type="hidden" name="csrfmiddlewaretoken" value="x"></form></body></html>`,
+			},
+			expected: []domain.Technology{
+				{Name: "Django", Category: domain.TechCategoryWebFramework, Confidence: 80, Evidence: []string{"html"}},
+			},
+		},
+		{
+			name: "framework markers",
+			content: domain.WebPageContent{
+				HTML: `<html><body><app-root ng-version="17.0.8"></app-root>
+					<script id="__NEXT_DATA__" type="application/json">{}</script></body></html>`,
+			},
+			expected: []domain.Technology{
+				{Name: "Angular", Category: domain.TechCategoryJavaScriptFramework, Version: "17.0.8", Confidence: 100, Evidence: []string{"html"}},
+				{Name: "Next.js", Category: domain.TechCategoryJavaScriptFramework, Confidence: 100, Evidence: []string{"html"}},
+				{Name: "React", Category: domain.TechCategoryJavaScriptFramework, Confidence: 100, Evidence: []string{"implied:Next.js"}},
+			},
+		},
+		{
+			name: "plain page",
+			content: domain.WebPageContent{
+				HTML: `<html><head><title>Plain</title></head><body><p>Hello</p></body></html>`,
+			},
+			expected: []domain.Technology{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := domain.NewDocument(&tc.content)
+			require.NoError(t, err)
+
+			output, err := analyzer.Run(context.Background(), doc)
+			require.NoError(t, err)
+
+			stack, ok := output.(domain.TechStack)
+			require.True(t, ok)
+
+			assert.NotEmpty(t, stack.SignaturesVersion)
+			assert.Equal(t, tc.expected, stack.Technologies)
+		})
+	}
+}
+
+func TestLoadSignatureDatabase(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name:    "invalid JSON",
+			data:    `{`,
+			wantErr: "failed to decode technology signatures",
+		},
+		{
+			name:    "missing version",
+			data:    `{"technologies": []}`,
+			wantErr: "technology signatures have no version",
+		},
+		{
+			name:    "invalid pattern",
+			data:    `{"version": "1", "technologies": [{"name": "Broken", "html": [{"pattern": "("}]}]}`,
+			wantErr: "invalid signature for Broken",
+		},
+		{
+			name:    "unknown implied technology",
+			data:    `{"version": "1", "technologies": [{"name": "A", "implies": ["B"]}]}`,
+			wantErr: `implies unknown technology "B"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := loadSignatureDatabase([]byte(tc.data))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
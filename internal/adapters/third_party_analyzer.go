@@ -0,0 +1,337 @@
+package adapters
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"golang.org/x/net/publicsuffix"
+)
+
+const (
+	ThirdPartyAnalyzerName    = "third_parties"
+	thirdPartyAnalyzerVersion = "1.0.0"
+)
+
+// trackerListJSON is the tracker and consent manager list; bump its version field on every change.
+//
+//go:embed signatures/trackers.json
+var trackerListJSON []byte
+
+type (
+	trackerEntry struct {
+		Domain     string                   `json:"domain"`
+		Company    string                   `json:"company"`
+		Categories []domain.TrackerCategory `json:"categories"`
+	}
+
+	// consentManagerEntry identifies a consent management platform either by the domains its
+	// script is served from or, for self-hosted ones, by patterns matching the script URL.
+	consentManagerEntry struct {
+		Name    string   `json:"name"`
+		Domains []string `json:"domains,omitempty"`
+		Scripts []string `json:"scripts,omitempty"`
+
+		patterns []*regexp.Regexp
+	}
+
+	trackerList struct {
+		Version         string                `json:"version"`
+		Trackers        []trackerEntry        `json:"trackers"`
+		ConsentManagers []consentManagerEntry `json:"consent_managers"`
+
+		byDomain map[string]trackerEntry
+	}
+
+	// ThirdPartyAnalyzer inventories the third-party origins a page loads resources from and
+	// flags known trackers loaded without a consent management platform.
+	ThirdPartyAnalyzer struct {
+		trackers *trackerList
+	}
+)
+
+func NewThirdPartyAnalyzer() (*ThirdPartyAnalyzer, error) {
+	trackers, err := loadTrackerList(trackerListJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ThirdPartyAnalyzer{trackers: trackers}, nil
+}
+
+func (a *ThirdPartyAnalyzer) Name() string {
+	return ThirdPartyAnalyzerName
+}
+
+func (a *ThirdPartyAnalyzer) Version() string {
+	return thirdPartyAnalyzerVersion
+}
+
+func (a *ThirdPartyAnalyzer) Dependencies() []string {
+	return nil
+}
+
+func (a *ThirdPartyAnalyzer) Run(ctx context.Context, doc *domain.Document) (any, error) {
+	pageURL, err := url.Parse(doc.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse page URL: %w", err)
+	}
+
+	inventory := domain.ThirdPartyInventory{
+		TrackerListVersion: a.trackers.Version,
+		Domains:            []domain.ThirdPartyDomain{},
+		Issues:             []domain.Finding{},
+	}
+
+	if doc.Root == nil {
+		return inventory, nil
+	}
+
+	pageSite := registrableDomain(pageURL.Hostname())
+	groups := make(map[string]*domain.ThirdPartyDomain)
+	seen := make(map[domain.ThirdPartyResource]bool)
+
+	collect := func(ref string, resourceType domain.ThirdPartyResourceType) {
+		resourceURL, err := resolveURL(pageURL, ref)
+		if err != nil || (resourceURL.Scheme != "http" && resourceURL.Scheme != "https") {
+			return
+		}
+
+		if resourceType == domain.ThirdPartyScript && inventory.ConsentManager == "" {
+			inventory.ConsentManager = a.trackers.consentManager(resourceURL)
+		}
+
+		host := strings.ToLower(resourceURL.Hostname())
+		site := registrableDomain(host)
+		if host == "" || site == pageSite {
+			return
+		}
+
+		resource := domain.ThirdPartyResource{URL: resourceURL.String(), Type: resourceType}
+		if seen[resource] {
+			return
+		}
+		seen[resource] = true
+
+		group, ok := groups[site]
+		if !ok {
+			group = &domain.ThirdPartyDomain{
+				Domain:     site,
+				Categories: []domain.TrackerCategory{},
+			}
+			groups[site] = group
+		}
+
+		if !slices.Contains(group.Hosts, host) {
+			group.Hosts = append(group.Hosts, host)
+		}
+
+		group.Resources = append(group.Resources, resource)
+	}
+
+	var visit func(s *goquery.Selection)
+	visit = func(s *goquery.Selection) {
+		s.Find("script[src], iframe[src], img[src], link[href], noscript").Each(func(i int, element *goquery.Selection) {
+			switch goquery.NodeName(element) {
+			case "script":
+				collect(element.AttrOr("src", ""), domain.ThirdPartyScript)
+			case "iframe":
+				collect(element.AttrOr("src", ""), domain.ThirdPartyIframe)
+			case "img":
+				resourceType := domain.ThirdPartyImage
+				if isTrackingPixel(element) {
+					resourceType = domain.ThirdPartyPixel
+				}
+
+				collect(element.AttrOr("src", ""), resourceType)
+			case "link":
+				for _, relation := range strings.Fields(strings.ToLower(element.AttrOr("rel", ""))) {
+					if relation == "preconnect" || relation == "dns-prefetch" {
+						collect(element.AttrOr("href", ""), domain.ThirdPartyPreconnect)
+
+						break
+					}
+				}
+			case "noscript":
+				// With scripting enabled the parser keeps <noscript> content as text, which is
+				// exactly where tracking pixels hide.
+				fallback, err := goquery.NewDocumentFromReader(strings.NewReader(element.Text()))
+				if err == nil {
+					visit(fallback.Selection)
+				}
+			}
+		})
+	}
+
+	visit(goquery.NewDocumentFromNode(doc.Root).Selection)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		a.trackers.classify(group)
+		if group.Tracker {
+			inventory.TrackerCount++
+		}
+
+		sort.Strings(group.Hosts)
+		inventory.Domains = append(inventory.Domains, *group)
+	}
+
+	sort.Slice(inventory.Domains, func(i, j int) bool {
+		return inventory.Domains[i].Domain < inventory.Domains[j].Domain
+	})
+
+	if inventory.TrackerCount > 0 && inventory.ConsentManager == "" {
+		inventory.Issues = append(inventory.Issues, domain.Finding{
+			Code:     domain.PrivacyIssueTrackersWithoutConsent,
+			Severity: domain.SeverityWarning,
+			Message: fmt.Sprintf("page loads %d known tracker domain(s) without a consent management platform",
+				inventory.TrackerCount),
+		})
+	}
+
+	return inventory, nil
+}
+
+// classify matches every host of the group against the tracker list, most specific entry first,
+// so that e.g. bat.bing.com is still attributed when only bing.com is listed.
+func (l *trackerList) classify(group *domain.ThirdPartyDomain) {
+	for _, host := range group.Hosts {
+		entry, ok := l.lookup(host)
+		if !ok {
+			continue
+		}
+
+		group.Tracker = true
+		if group.Company == "" {
+			group.Company = entry.Company
+		}
+
+		for _, category := range entry.Categories {
+			if !slices.Contains(group.Categories, category) {
+				group.Categories = append(group.Categories, category)
+			}
+		}
+	}
+
+	sort.Slice(group.Categories, func(i, j int) bool {
+		return group.Categories[i] < group.Categories[j]
+	})
+}
+
+func (l *trackerList) lookup(host string) (trackerEntry, bool) {
+	for candidate := host; candidate != ""; {
+		if entry, ok := l.byDomain[candidate]; ok {
+			return entry, true
+		}
+
+		_, parent, found := strings.Cut(candidate, ".")
+		if !found {
+			break
+		}
+
+		candidate = parent
+	}
+
+	return trackerEntry{}, false
+}
+
+func (l *trackerList) consentManager(scriptURL *url.URL) string {
+	host := strings.ToLower(scriptURL.Hostname())
+	resource := scriptURL.String()
+
+	for _, manager := range l.ConsentManagers {
+		for _, managerDomain := range manager.Domains {
+			if host == managerDomain || strings.HasSuffix(host, "."+managerDomain) {
+				return manager.Name
+			}
+		}
+
+		for _, pattern := range manager.patterns {
+			if pattern.MatchString(resource) {
+				return manager.Name
+			}
+		}
+	}
+
+	return ""
+}
+
+func loadTrackerList(data []byte) (*trackerList, error) {
+	var list trackerList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode tracker list: %w", err)
+	}
+
+	if list.Version == "" {
+		return nil, fmt.Errorf("tracker list has no version")
+	}
+
+	list.byDomain = make(map[string]trackerEntry, len(list.Trackers))
+	for _, entry := range list.Trackers {
+		entry.Domain = strings.ToLower(entry.Domain)
+		if _, exists := list.byDomain[entry.Domain]; exists {
+			return nil, fmt.Errorf("tracker list contains %q more than once", entry.Domain)
+		}
+
+		list.byDomain[entry.Domain] = entry
+	}
+
+	for i := range list.ConsentManagers {
+		manager := &list.ConsentManagers[i]
+
+		for _, script := range manager.Scripts {
+			pattern, err := regexp.Compile("(?i)" + script)
+			if err != nil {
+				return nil, fmt.Errorf("invalid script pattern for consent manager %s: %w", manager.Name, err)
+			}
+
+			manager.patterns = append(manager.patterns, pattern)
+		}
+	}
+
+	return &list, nil
+}
+
+// registrableDomain returns the eTLD+1 of a host according to the public suffix list, or the
+// host itself for IP addresses and hosts that have no registrable domain such as localhost.
+func registrableDomain(host string) string {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if net.ParseIP(host) != nil {
+		return host
+	}
+
+	site, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+
+	return site
+}
+
+// isTrackingPixel reports whether an image is sized to be invisible, the usual shape of a beacon.
+func isTrackingPixel(s *goquery.Selection) bool {
+	isTiny := func(attr string) bool {
+		value, ok := s.Attr(attr)
+		if !ok {
+			return false
+		}
+
+		value = strings.TrimSuffix(strings.TrimSpace(value), "px")
+
+		return value == "0" || value == "1"
+	}
+
+	return isTiny("width") && isTiny("height")
+}
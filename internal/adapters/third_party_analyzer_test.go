@@ -0,0 +1,175 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThirdPartyAnalyzer_Run(t *testing.T) {
+	t.Parallel()
+
+	analyzer, err := NewThirdPartyAnalyzer()
+	require.NoError(t, err)
+
+	cases := []struct {
+		name           string
+		html           string
+		expected       []domain.ThirdPartyDomain
+		consentManager string
+		issues         []string
+	}{
+		{
+			name: "groups resources by registrable domain and classifies trackers",
+			html: `<html><head>
+				<link rel="preconnect" href="https://fonts.gstatic.com">
+				<script async src="https://www.googletagmanager.com/gtag/js?id=G-1"></script>
+				<script src="//cdn.example.co.uk/lib.js"></script>
+				<script src="/assets/app.js"></script>
+				<script src="https://static.shop.example.com/app.js"></script>
+			</head><body>
+				<img src="https://bat.bing.com/action/0?ti=1" width="1" height="1">
+				<iframe src="https://www.youtube.com/embed/xyz"></iframe>
+				<noscript><img height="1" width="1" src="https://www.facebook.com/tr?id=1&ev=PageView"></noscript>
+			</body></html>`,
+			expected: []domain.ThirdPartyDomain{
+				{
+					Domain:     "bing.com",
+					Company:    "Microsoft",
+					Categories: []domain.TrackerCategory{domain.TrackerCategoryAdvertising},
+					Tracker:    true,
+					Hosts:      []string{"bat.bing.com"},
+					Resources:  []domain.ThirdPartyResource{{URL: "https://bat.bing.com/action/0?ti=1", Type: domain.ThirdPartyPixel}},
+				},
+				{
+					Domain:     "example.co.uk",
+					Categories: []domain.TrackerCategory{},
+					Hosts:      []string{"cdn.example.co.uk"},
+					Resources:  []domain.ThirdPartyResource{{URL: "https://cdn.example.co.uk/lib.js", Type: domain.ThirdPartyScript}},
+				},
+				{
+					Domain:     "facebook.com",
+					Company:    "Meta",
+					Categories: []domain.TrackerCategory{domain.TrackerCategoryAdvertising, domain.TrackerCategorySocial},
+					Tracker:    true,
+					Hosts:      []string{"www.facebook.com"},
+					Resources:  []domain.ThirdPartyResource{{URL: "https://www.facebook.com/tr?id=1&ev=PageView", Type: domain.ThirdPartyPixel}},
+				},
+				{
+					Domain:     "googletagmanager.com",
+					Company:    "Google",
+					Categories: []domain.TrackerCategory{domain.TrackerCategoryAnalytics},
+					Tracker:    true,
+					Hosts:      []string{"www.googletagmanager.com"},
+					Resources:  []domain.ThirdPartyResource{{URL: "https://www.googletagmanager.com/gtag/js?id=G-1", Type: domain.ThirdPartyScript}},
+				},
+				{
+					Domain:     "gstatic.com",
+					Categories: []domain.TrackerCategory{},
+					Hosts:      []string{"fonts.gstatic.com"},
+					Resources:  []domain.ThirdPartyResource{{URL: "https://fonts.gstatic.com", Type: domain.ThirdPartyPreconnect}},
+				},
+				{
+					Domain:     "youtube.com",
+					Categories: []domain.TrackerCategory{},
+					Hosts:      []string{"www.youtube.com"},
+					Resources:  []domain.ThirdPartyResource{{URL: "https://www.youtube.com/embed/xyz", Type: domain.ThirdPartyIframe}},
+				},
+			},
+			issues: []string{domain.PrivacyIssueTrackersWithoutConsent},
+		},
+		{
+			name: "trackers behind a consent manager are not flagged",
+			html: `<html><head>
+				<script src="https://cdn.cookielaw.org/scripttemplates/otSDKStub.js"></script>
+				<script src="https://connect.facebook.net/en_US/fbevents.js"></script>
+			</head><body></body></html>`,
+			expected: []domain.ThirdPartyDomain{
+				{
+					Domain:     "cookielaw.org",
+					Categories: []domain.TrackerCategory{},
+					Hosts:      []string{"cdn.cookielaw.org"},
+					Resources:  []domain.ThirdPartyResource{{URL: "https://cdn.cookielaw.org/scripttemplates/otSDKStub.js", Type: domain.ThirdPartyScript}},
+				},
+				{
+					Domain:     "facebook.net",
+					Company:    "Meta",
+					Categories: []domain.TrackerCategory{domain.TrackerCategoryAdvertising, domain.TrackerCategorySocial},
+					Tracker:    true,
+					Hosts:      []string{"connect.facebook.net"},
+					Resources:  []domain.ThirdPartyResource{{URL: "https://connect.facebook.net/en_US/fbevents.js", Type: domain.ThirdPartyScript}},
+				},
+			},
+			consentManager: "OneTrust",
+			issues:         []string{},
+		},
+		{
+			name: "self-hosted consent manager is recognised by its script path",
+			html: `<html><head>
+				<script src="/wp-content/plugins/complianz-gdpr/cookiebanner/js/complianz.min.js"></script>
+			</head><body></body></html>`,
+			expected:       []domain.ThirdPartyDomain{},
+			consentManager: "Complianz",
+			issues:         []string{},
+		},
+		{
+			name:     "first-party only page",
+			html:     `<html><head><script src="https://cdn.shop.example.com/app.js"></script></head><body><img src="logo.png"></body></html>`,
+			expected: []domain.ThirdPartyDomain{},
+			issues:   []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := domain.NewDocument(&domain.WebPageContent{URL: "https://shop.example.com/page", HTML: tc.html})
+			require.NoError(t, err)
+
+			output, err := analyzer.Run(context.Background(), doc)
+			require.NoError(t, err)
+
+			inventory, ok := output.(domain.ThirdPartyInventory)
+			require.True(t, ok)
+
+			assert.NotEmpty(t, inventory.TrackerListVersion)
+			assert.Equal(t, tc.expected, inventory.Domains)
+			assert.Equal(t, tc.consentManager, inventory.ConsentManager)
+
+			codes := []string{}
+			for _, issue := range inventory.Issues {
+				codes = append(codes, issue.Code)
+			}
+
+			assert.Equal(t, tc.issues, codes)
+		})
+	}
+}
+
+func TestRegistrableDomain(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		host     string
+		expected string
+	}{
+		{host: "www.example.com", expected: "example.com"},
+		{host: "a.b.example.co.uk", expected: "example.co.uk"},
+		{host: "user.github.io", expected: "user.github.io"},
+		{host: "WWW.Example.COM.", expected: "example.com"},
+		{host: "127.0.0.1", expected: "127.0.0.1"},
+		{host: "localhost", expected: "localhost"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.host, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, registrableDomain(tc.host))
+		})
+	}
+}
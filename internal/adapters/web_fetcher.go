@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net"
 	"net/http"
+	"net/netip"
 	"net/url"
 	"strings"
 	"time"
@@ -13,6 +13,7 @@ import (
 	"github.com/architeacher/svc-web-analyzer/internal/config"
 	"github.com/architeacher/svc-web-analyzer/internal/domain"
 	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+	"github.com/architeacher/svc-web-analyzer/internal/ports"
 	"github.com/go-resty/resty/v2"
 	"github.com/sony/gobreaker"
 )
@@ -21,23 +22,48 @@ const (
 	minInputSize   = 3
 	maxInputSize   = 10000
 	defaultTimeout = 30 * time.Second
+
+	// maxPacedHosts is the number of hosts whose Crawl-delay the fetcher keeps track of.
+	maxPacedHosts = 1000
 )
 
 type WebFetcher struct {
 	client         *resty.Client
 	circuitBreaker *gobreaker.CircuitBreaker
+	guard          *NetworkGuard
+	robots         ports.RobotsPolicy
+	hosts          *hostRegistry
 	logger         infrastructure.Logger
 	config         config.WebFetcherConfig
 }
 
-func NewWebFetcher(config config.WebFetcherConfig, logger infrastructure.Logger) *WebFetcher {
+// NewWebFetcher creates a web fetcher. A nil guard lets pages be fetched from any address their
+// host resolves to, and a nil robots policy fetches pages regardless of robots.txt.
+func NewWebFetcher(
+	config config.WebFetcherConfig,
+	guard *NetworkGuard,
+	robots ports.RobotsPolicy,
+	logger infrastructure.Logger,
+) *WebFetcher {
 	client := resty.New()
 
+	if guard != nil {
+		client.SetTransport(guard.Transport())
+	}
+
 	client.SetTimeout(defaultTimeout).
 		SetRetryCount(config.MaxRetries).
 		SetRetryWaitTime(config.RetryWaitTime).
 		SetRetryMaxWaitTime(config.MaxRetryWaitTime).
-		SetRedirectPolicy(resty.FlexibleRedirectPolicy(config.MaxRedirects))
+		SetRedirectPolicy(
+			resty.FlexibleRedirectPolicy(config.MaxRedirects),
+			resty.RedirectPolicyFunc(func(req *http.Request, _ []*http.Request) error {
+				return checkRedirectRobots(req, robots)
+			}),
+		).
+		AddRetryCondition(func(_ *resty.Response, err error) bool {
+			return err != nil && !isRefusal(err)
+		})
 
 	if config.UserAgent != "" {
 		client.SetHeader("User-Agent", config.UserAgent)
@@ -48,7 +74,7 @@ func NewWebFetcher(config config.WebFetcherConfig, logger infrastructure.Logger)
 	client.SetHeaders(map[string]string{
 		"Accept":                    "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
 		"Accept-Language":           "en-US,en;q=0.5",
-		"Accept-Encoding":           "gzip, deflate",
+		"Accept-Encoding":           "gzip, deflate, br, zstd",
 		"DNT":                       "1",
 		"Connection":                "keep-alive",
 		"Upgrade-Insecure-Requests": "1",
@@ -63,6 +89,10 @@ func NewWebFetcher(config config.WebFetcherConfig, logger infrastructure.Logger)
 			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
 			return counts.Requests >= 3 && failureRatio >= 0.6
 		},
+		// Refusing a redirect or an address says nothing about the health of the target.
+		IsSuccessful: func(err error) bool {
+			return err == nil || isRefusal(err)
+		},
 		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
 			logger.Info().
 				Str("name", name).
@@ -74,9 +104,20 @@ func NewWebFetcher(config config.WebFetcherConfig, logger infrastructure.Logger)
 
 	circuitBreaker := gobreaker.NewCircuitBreaker(cbSettings)
 
+	// Pages of a host that asks for a Crawl-delay are fetched one at a time, that far apart.
+	newHost := func(host string) *hostState {
+		return &hostState{
+			name:  host,
+			slots: make(chan struct{}, 1),
+		}
+	}
+
 	return &WebFetcher{
 		client:         client,
 		circuitBreaker: circuitBreaker,
+		guard:          guard,
+		robots:         robots,
+		hosts:          newHostRegistry(maxPacedHosts, newHost, nil),
 		logger:         logger,
 		config:         config,
 	}
@@ -87,6 +128,12 @@ func (f *WebFetcher) Fetch(ctx context.Context, targetURL string, timeout time.D
 		return nil, domain.NewInvalidURLError(targetURL, err)
 	}
 
+	robots, release, err := f.waitForRobots(ctx, targetURL)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	if timeout > 0 {
 		f.client.SetTimeout(timeout)
 	}
@@ -108,7 +155,10 @@ func (f *WebFetcher) Fetch(ctx context.Context, targetURL string, timeout time.D
 		return nil, err
 	}
 
-	return result.(*domain.WebPageContent), nil
+	content := result.(*domain.WebPageContent)
+	content.Robots = robots
+
+	return content, nil
 }
 
 func (f *WebFetcher) fetchWithRetry(ctx context.Context, targetURL string) (*domain.WebPageContent, error) {
@@ -116,9 +166,24 @@ func (f *WebFetcher) fetchWithRetry(ctx context.Context, targetURL string) (*dom
 
 	resp, err := f.client.R().
 		SetContext(ctx).
+		SetDoNotParseResponse(true).
 		Get(targetURL)
 
 	if err != nil {
+		var robotsErr *domain.DomainError
+		if errors.Is(err, domain.ErrRobotsDisallowed) && errors.As(err, &robotsErr) {
+			return nil, robotsErr
+		}
+
+		if errors.Is(err, domain.ErrAddressNotAllowed) {
+			f.logger.Warn().
+				Err(err).
+				Str("url", targetURL).
+				Msg("Refused to connect to a non public address")
+
+			return nil, domain.NewAddressNotAllowedError(targetURL, err)
+		}
+
 		f.logger.Error().
 			Err(err).
 			Str("url", targetURL).
@@ -127,15 +192,8 @@ func (f *WebFetcher) fetchWithRetry(ctx context.Context, targetURL string) (*dom
 		return nil, domain.NewURLNotReachableError(targetURL, 0, err)
 	}
 
-	duration := time.Since(startTime)
-
-	f.logger.Info().
-		Str("url", targetURL).
-		Int("status_code", resp.StatusCode()).
-		Int64("duration_ms", duration.Milliseconds()).
-		Int("size_bytes", len(resp.Body())).
-		Str("content_type", resp.Header().Get("Content-Type")).
-		Msg("HTTP request completed")
+	rawBody := resp.RawBody()
+	defer rawBody.Close()
 
 	if resp.StatusCode() < http.StatusOK || resp.StatusCode() >= http.StatusMultipleChoices {
 		f.logger.Warn().
@@ -150,17 +208,45 @@ func (f *WebFetcher) fetchWithRetry(ctx context.Context, targetURL string) (*dom
 		)
 	}
 
-	if len(resp.Body()) > int(f.config.MaxResponseSizeBytes) {
-		return nil, domain.NewDomainError(
-			"RESPONSE_TOO_LARGE",
+	// A body announcing more than the limit is refused before any of it is read.
+	if contentLength := resp.RawResponse.ContentLength; contentLength > f.config.MaxResponseSizeBytes {
+		return nil, newResponseTooLargeError(
 			fmt.Sprintf("Response size %d bytes exceeds maximum allowed %d bytes",
-				len(resp.Body()), f.config.MaxResponseSizeBytes),
-			http.StatusRequestEntityTooLarge,
-			fmt.Errorf("response is too large"),
+				contentLength, f.config.MaxResponseSizeBytes),
 		)
 	}
 
+	contentEncoding := resp.Header().Get("Content-Encoding")
+
+	body, err := readBody(rawBody, contentEncoding, f.config.MaxResponseSizeBytes)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			return nil, newResponseTooLargeError(
+				fmt.Sprintf("Response size exceeds maximum allowed %d bytes", f.config.MaxResponseSizeBytes),
+			)
+		}
+
+		f.logger.Error().
+			Err(err).
+			Str("url", targetURL).
+			Str("content_encoding", contentEncoding).
+			Msg("failed to read response body")
+
+		return nil, domain.NewURLNotReachableError(targetURL, resp.StatusCode(), err)
+	}
+
+	duration := time.Since(startTime)
 	contentType := resp.Header().Get("Content-Type")
+
+	f.logger.Info().
+		Str("url", targetURL).
+		Int("status_code", resp.StatusCode()).
+		Int64("duration_ms", duration.Milliseconds()).
+		Int64("transferred_bytes", body.transferred).
+		Int("size_bytes", len(body.body)).
+		Str("content_type", contentType).
+		Msg("HTTP request completed")
+
 	if !isHTMLContent(contentType) {
 		f.logger.Warn().
 			Str("url", targetURL).
@@ -168,23 +254,89 @@ func (f *WebFetcher) fetchWithRetry(ctx context.Context, targetURL string) (*dom
 			Msg("Response is not HTML content")
 	}
 
-	headers := make(map[string]string)
-	for key, values := range resp.Header() {
-		if len(values) > 0 {
-			headers[key] = values[0]
-		}
+	page, pageEncoding, err := transcodePage(body.body, contentType)
+	if err != nil {
+		f.logger.Warn().
+			Err(err).
+			Str("url", targetURL).
+			Msg("Failed to transcode page to UTF-8")
+
+		page = string(body.body)
+	}
+
+	if pageEncoding.Mismatch {
+		f.logger.Debug().
+			Str("url", targetURL).
+			Str("detected", pageEncoding.Detected).
+			Str("declared", pageEncoding.Declared).
+			Msg("Page encoding does not match its declaration")
 	}
 
 	return &domain.WebPageContent{
-		URL:           resp.Request.URL,
-		StatusCode:    resp.StatusCode(),
-		HTML:          string(resp.Body()),
-		ContentType:   contentType,
-		Headers:       headers,
-		FetchDuration: duration,
+		URL:              resp.Request.URL,
+		StatusCode:       resp.StatusCode(),
+		HTML:             page,
+		ContentType:      contentType,
+		Headers:          resp.Header().Clone(),
+		FetchDuration:    duration,
+		Encoding:         pageEncoding,
+		TransferredBytes: body.transferred,
+		DecodedBytes:     int64(len(body.body)),
 	}, nil
 }
 
+func newResponseTooLargeError(message string) *domain.DomainError {
+	return domain.NewDomainError(
+		"RESPONSE_TOO_LARGE",
+		message,
+		http.StatusRequestEntityTooLarge,
+		fmt.Errorf("response is too large"),
+	)
+}
+
+// waitForRobots refuses a URL the robots.txt of its origin disallows, and waits for the turn of
+// its host when the robots.txt asks for a Crawl-delay. The returned func releases the turn once
+// the page has been fetched.
+func (f *WebFetcher) waitForRobots(ctx context.Context, targetURL string) (*domain.RobotsVerdict, func(), error) {
+	if f.robots == nil {
+		return nil, func() {}, nil
+	}
+
+	verdict := f.robots.Evaluate(ctx, targetURL)
+	if !verdict.Allowed {
+		f.logger.Info().
+			Str("url", targetURL).
+			Str("rule", verdict.Rule).
+			Msg("Fetching URL is disallowed by robots.txt")
+
+		return nil, nil, domain.NewRobotsDisallowedError(targetURL, verdict.Rule)
+	}
+
+	if verdict.Wait <= 0 {
+		return &verdict, func() {}, nil
+	}
+
+	host, err := f.hosts.acquire(ctx, linkHostname(targetURL), verdict.Wait)
+	if err != nil {
+		return nil, nil, domain.NewURLNotReachableError(targetURL, 0, err)
+	}
+
+	return &verdict, func() { f.hosts.release(host) }, nil
+}
+
+// checkRedirectRobots stops following a redirect to a URL robots.txt disallows.
+func checkRedirectRobots(req *http.Request, robots ports.RobotsPolicy) error {
+	if robots == nil {
+		return nil
+	}
+
+	if verdict := robots.Evaluate(req.Context(), req.URL.String()); !verdict.Allowed {
+		return domain.NewRobotsDisallowedError(req.URL.String(), verdict.Rule)
+	}
+
+	return nil
+}
+
 func (f *WebFetcher) validateURL(targetURL string) error {
 	if targetURL == "" {
 		return fmt.Errorf("URL cannot be empty")
@@ -215,8 +367,9 @@ func (f *WebFetcher) validateURL(targetURL string) error {
 		return fmt.Errorf("URL must include a host")
 	}
 
-	// Prevent access to local/private networks for security
-	if isPrivateOrLocalURL(parsedURL.Hostname()) {
+	// Prevent access to local/private networks for security. Host names are checked once they are
+	// resolved, when the guard dials them.
+	if !f.guard.allowsHost(parsedURL.Hostname()) {
 		return fmt.Errorf("access to private or local networks is not allowed")
 	}
 
@@ -229,39 +382,19 @@ func isHTMLContent(contentType string) bool {
 		strings.Contains(contentType, "application/xhtml")
 }
 
+// isPrivateOrLocalURL reports whether host names the local machine or is a non public address.
 func isPrivateOrLocalURL(host string) bool {
-	privateHosts := []string{
-		"localhost",
-		"127.0.0.1",
-		"::1",
-		"0.0.0.0",
-	}
-
-	hostLower := strings.ToLower(host)
-	for _, privateHost := range privateHosts {
-		if hostLower == privateHost || strings.HasSuffix(hostLower, "."+privateHost) {
-			return true
-		}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return isNonPublicAddress(addr)
 	}
 
-	if i := strings.LastIndexByte(host, ':'); i != -1 {
-		host = host[:i] // strip port if present
-	}
-
-	ip := net.ParseIP(host)
-	if ip == nil {
-		return false
-	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
 
-	ip = ip.To4()
-	if ip == nil {
-		return false
-	}
+	return host == "localhost" || strings.HasSuffix(host, ".localhost")
+}
 
-	// 10.0.0.0/8
-	return ip[0] == 10 ||
-		// 172.16.0.0/12
-		(ip[0] == 172 && ip[1] >= 16 && ip[1] <= 31) ||
-		// 192.168.0.0/16
-		(ip[0] == 192 && ip[1] == 168)
+// isRefusal reports whether a request failed because it was refused before it was sent, by
+// robots.txt or by the network guard.
+func isRefusal(err error) bool {
+	return errors.Is(err, domain.ErrRobotsDisallowed) || errors.Is(err, domain.ErrAddressNotAllowed)
 }
@@ -0,0 +1,173 @@
+package adapters
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+// maxContentEncodings is the number of Content-Encoding layers the fetcher decodes.
+const maxContentEncodings = 3
+
+var (
+	errBodyTooLarge               = errors.New("response body is too large")
+	errUnsupportedContentEncoding = errors.New("unsupported content encoding")
+)
+
+// bodyReadResult is a response body read by readBody.
+type bodyReadResult struct {
+	body        []byte
+	transferred int64
+}
+
+// readBody reads a response body, decoding its Content-Encoding on the fly. Both the bytes
+// transferred and the decoded bytes are capped at maxBytes, so the read stops as soon as either
+// exceeds it instead of buffering a large or highly compressed body first.
+func readBody(body io.Reader, contentEncoding string, maxBytes int64) (*bodyReadResult, error) {
+	counter := &countingReader{reader: io.LimitReader(body, maxBytes+1)}
+	buffered := bufio.NewReader(counter)
+
+	// An empty body has nothing to decode, whatever its Content-Encoding says.
+	if _, err := buffered.Peek(1); errors.Is(err, io.EOF) {
+		return &bodyReadResult{body: []byte{}}, nil
+	}
+
+	decoded, closeDecoders, err := decodeContent(buffered, contentEncoding, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	defer closeDecoders()
+
+	content, err := io.ReadAll(io.LimitReader(decoded, maxBytes+1))
+	if err != nil {
+		// The zstd decoder refuses frames needing more memory than the limit on its own.
+		if counter.read > maxBytes || errors.Is(err, zstd.ErrDecoderSizeExceeded) || errors.Is(err, zstd.ErrWindowSizeExceeded) {
+			return nil, errBodyTooLarge
+		}
+
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if int64(len(content)) > maxBytes || counter.read > maxBytes {
+		return nil, errBodyTooLarge
+	}
+
+	return &bodyReadResult{body: content, transferred: counter.read}, nil
+}
+
+// decodeContent wraps body in a decoder for each coding of a Content-Encoding header, undoing
+// them in the reverse order they were applied.
+func decodeContent(body io.Reader, contentEncoding string, maxBytes int64) (io.Reader, func(), error) {
+	var (
+		codings []string
+		closers []func()
+	)
+
+	for coding := range strings.SplitSeq(contentEncoding, ",") {
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != "" && coding != "identity" {
+			codings = append(codings, coding)
+		}
+	}
+
+	closeDecoders := func() {
+		for _, closeDecoder := range closers {
+			closeDecoder()
+		}
+	}
+
+	if len(codings) > maxContentEncodings {
+		return nil, nil, fmt.Errorf("%w: %s", errUnsupportedContentEncoding, contentEncoding)
+	}
+
+	reader := body
+
+	for i := len(codings) - 1; i >= 0; i-- {
+		switch codings[i] {
+		case "gzip", "x-gzip":
+			gzipReader, err := gzip.NewReader(reader)
+			if err != nil {
+				closeDecoders()
+
+				return nil, nil, fmt.Errorf("invalid gzip content: %w", err)
+			}
+
+			closers = append(closers, func() { gzipReader.Close() })
+			reader = gzipReader
+
+		case "deflate":
+			deflateReader, err := newDeflateReader(reader)
+			if err != nil {
+				closeDecoders()
+
+				return nil, nil, fmt.Errorf("invalid deflate content: %w", err)
+			}
+
+			closers = append(closers, func() { deflateReader.Close() })
+			reader = deflateReader
+
+		case "br":
+			reader = brotli.NewReader(reader)
+
+		case "zstd":
+			zstdReader, err := zstd.NewReader(reader,
+				zstd.WithDecoderConcurrency(1),
+				zstd.WithDecoderMaxMemory(uint64(max(maxBytes, 0))+1),
+			)
+			if err != nil {
+				closeDecoders()
+
+				return nil, nil, fmt.Errorf("invalid zstd content: %w", err)
+			}
+
+			closers = append(closers, zstdReader.Close)
+			reader = zstdReader
+
+		default:
+			closeDecoders()
+
+			return nil, nil, fmt.Errorf("%w: %s", errUnsupportedContentEncoding, codings[i])
+		}
+	}
+
+	return reader, closeDecoders, nil
+}
+
+// newDeflateReader decodes deflate content, which servers send either wrapped in zlib, as the
+// specification asks for, or as a raw deflate stream.
+func newDeflateReader(body io.Reader) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(body)
+
+	header, err := buffered.Peek(2)
+	if err != nil && len(header) < 2 {
+		return nil, err
+	}
+
+	// A zlib header uses the deflate method and its two bytes are a multiple of 31.
+	if header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		return zlib.NewReader(buffered)
+	}
+
+	return flate.NewReader(buffered), nil
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	reader io.Reader
+	read   int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+
+	return n, err
+}
@@ -0,0 +1,203 @@
+package adapters
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compressGzip(t *testing.T, content []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func compressZlib(t *testing.T, content []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	writer := zlib.NewWriter(&buf)
+	_, err := writer.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func compressFlate(t *testing.T, content []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	writer, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	require.NoError(t, err)
+	_, err = writer.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func compressBrotli(t *testing.T, content []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	writer := brotli.NewWriter(&buf)
+	_, err := writer.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func compressZstd(t *testing.T, content []byte) []byte {
+	t.Helper()
+
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	defer encoder.Close()
+
+	return encoder.EncodeAll(content, nil)
+}
+
+func TestReadBody_ContentEncodings(t *testing.T) {
+	t.Parallel()
+
+	html := []byte(strings.Repeat("<p>Hello, World!</p>", 100))
+
+	cases := []struct {
+		name            string
+		contentEncoding string
+		body            func(t *testing.T) []byte
+	}{
+		{name: "No encoding", body: func(*testing.T) []byte { return html }},
+		{name: "Identity", contentEncoding: "identity", body: func(*testing.T) []byte { return html }},
+		{name: "Gzip", contentEncoding: "gzip", body: func(t *testing.T) []byte { return compressGzip(t, html) }},
+		{name: "Legacy gzip name", contentEncoding: "X-Gzip", body: func(t *testing.T) []byte { return compressGzip(t, html) }},
+		{name: "Deflate", contentEncoding: "deflate", body: func(t *testing.T) []byte { return compressZlib(t, html) }},
+		{name: "Raw deflate", contentEncoding: "deflate", body: func(t *testing.T) []byte { return compressFlate(t, html) }},
+		{name: "Brotli", contentEncoding: "br", body: func(t *testing.T) []byte { return compressBrotli(t, html) }},
+		{name: "Zstandard", contentEncoding: "zstd", body: func(t *testing.T) []byte { return compressZstd(t, html) }},
+		{
+			name:            "Several encodings",
+			contentEncoding: "gzip, br",
+			body:            func(t *testing.T) []byte { return compressBrotli(t, compressGzip(t, html)) },
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := tc.body(t)
+
+			result, err := readBody(bytes.NewReader(body), tc.contentEncoding, 1024*1024)
+			require.NoError(t, err)
+
+			assert.Equal(t, html, result.body)
+			assert.Equal(t, int64(len(body)), result.transferred)
+		})
+	}
+}
+
+func TestReadBody_SizeLimit(t *testing.T) {
+	t.Parallel()
+
+	const maxBytes = 64 * 1024
+
+	bomb := bytes.Repeat([]byte{0}, 64*maxBytes)
+
+	cases := []struct {
+		name            string
+		contentEncoding string
+		body            func(t *testing.T) []byte
+		expectedErr     error
+	}{
+		{
+			name: "Body at the limit",
+			body: func(*testing.T) []byte { return bytes.Repeat([]byte("a"), maxBytes) },
+		},
+		{
+			name:        "Body over the limit",
+			body:        func(*testing.T) []byte { return bytes.Repeat([]byte("a"), maxBytes+1) },
+			expectedErr: errBodyTooLarge,
+		},
+		{
+			name:            "Gzip bomb",
+			contentEncoding: "gzip",
+			body:            func(t *testing.T) []byte { return compressGzip(t, bomb) },
+			expectedErr:     errBodyTooLarge,
+		},
+		{
+			name:            "Brotli bomb",
+			contentEncoding: "br",
+			body:            func(t *testing.T) []byte { return compressBrotli(t, bomb) },
+			expectedErr:     errBodyTooLarge,
+		},
+		{
+			name:            "Zstandard bomb",
+			contentEncoding: "zstd",
+			body:            func(t *testing.T) []byte { return compressZstd(t, bomb) },
+			expectedErr:     errBodyTooLarge,
+		},
+		{
+			name:            "Unsupported encoding",
+			contentEncoding: "compress",
+			body:            func(*testing.T) []byte { return []byte("content") },
+			expectedErr:     errUnsupportedContentEncoding,
+		},
+		{
+			name:            "Too many encodings",
+			contentEncoding: "gzip, gzip, gzip, gzip",
+			body:            func(*testing.T) []byte { return []byte("content") },
+			expectedErr:     errUnsupportedContentEncoding,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			counter := &countingReader{reader: bytes.NewReader(tc.body(t))}
+
+			result, err := readBody(counter, tc.contentEncoding, maxBytes)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				assert.Len(t, result.body, maxBytes)
+
+				return
+			}
+
+			require.ErrorIs(t, err, tc.expectedErr)
+			assert.LessOrEqual(t, counter.read, int64(maxBytes+1), "Should stop reading at the limit")
+		})
+	}
+}
+
+func TestReadBody_EmptyBody(t *testing.T) {
+	t.Parallel()
+
+	result, err := readBody(io.LimitReader(strings.NewReader(""), 0), "gzip", 1024)
+	require.NoError(t, err)
+
+	assert.Empty(t, result.body)
+	assert.Zero(t, result.transferred)
+}
@@ -0,0 +1,212 @@
+package adapters
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+)
+
+// metaPrescanBytes is how much of a page is searched for a <meta> charset declaration, as the
+// HTML standard does.
+const metaPrescanBytes = 1024
+
+const (
+	charsetUTF8        = "utf-8"
+	charsetWindows1252 = "windows-1252"
+)
+
+// byteOrderMarks are the byte order marks HTML recognises, with the encoding each announces.
+var byteOrderMarks = []struct {
+	bom     []byte
+	charset string
+}{
+	{bom: []byte{0xef, 0xbb, 0xbf}, charset: charsetUTF8},
+	{bom: []byte{0xfe, 0xff}, charset: "utf-16be"},
+	{bom: []byte{0xff, 0xfe}, charset: "utf-16le"},
+}
+
+// transcodePage converts a page to UTF-8. Its encoding is taken from the charset of the
+// Content-Type header, the byte order mark, the <meta> tags of the page and the bytes themselves,
+// in that order; a page that is neither declared nor valid UTF-8 is read in the encoding its byte
+// statistics point at, or else as windows-1252.
+func transcodePage(body []byte, contentType string) (string, *domain.PageEncoding, error) {
+	pageEncoding := &domain.PageEncoding{
+		HeaderCharset: charsetFromContentType(contentType),
+	}
+
+	headerCharset := lookupCharset(pageEncoding.HeaderCharset)
+	bomCharset, bomLength := byteOrderMark(body)
+	content := body[bomLength:]
+
+	pageEncoding.MetaCharset = prescanMetaCharset(content)
+	metaCharset := lookupCharset(pageEncoding.MetaCharset)
+
+	// A page can only declare an encoding it could have declared itself in.
+	switch metaCharset {
+	case "utf-16be", "utf-16le":
+		metaCharset = charsetUTF8
+	case "x-user-defined":
+		metaCharset = charsetWindows1252
+	}
+
+	switch {
+	case headerCharset != "":
+		pageEncoding.Detected, pageEncoding.Source = headerCharset, domain.EncodingSourceContentType
+	case bomCharset != "":
+		pageEncoding.Detected, pageEncoding.Source = bomCharset, domain.EncodingSourceBOM
+	case metaCharset != "":
+		pageEncoding.Detected, pageEncoding.Source = metaCharset, domain.EncodingSourceMeta
+	case utf8.Valid(content):
+		pageEncoding.Detected, pageEncoding.Source = charsetUTF8, domain.EncodingSourceSniffed
+	default:
+		pageEncoding.Detected, pageEncoding.Source = detectCharset(content), domain.EncodingSourceSniffed
+		if pageEncoding.Detected == charsetWindows1252 {
+			pageEncoding.Source = domain.EncodingSourceDefault
+		}
+	}
+
+	pageEncoding.Declared = declaredCharset(pageEncoding.HeaderCharset, headerCharset)
+	if pageEncoding.Declared == "" {
+		pageEncoding.Declared = declaredCharset(pageEncoding.MetaCharset, metaCharset)
+	}
+
+	pageEncoding.Mismatch = charsetsDisagree(pageEncoding.Detected, content,
+		declaredCharset(pageEncoding.HeaderCharset, headerCharset),
+		bomCharset,
+		declaredCharset(pageEncoding.MetaCharset, metaCharset),
+	)
+
+	if pageEncoding.Detected == charsetUTF8 {
+		return string(content), pageEncoding, nil
+	}
+
+	encoding, _ := charset.Lookup(pageEncoding.Detected)
+
+	decoded, err := encoding.NewDecoder().Bytes(content)
+	if err != nil {
+		return "", pageEncoding, fmt.Errorf("failed to transcode %s content: %w", pageEncoding.Detected, err)
+	}
+
+	return string(decoded), pageEncoding, nil
+}
+
+// charsetsDisagree reports whether any declaration names another encoding than the detected one,
+// or whether the bytes of the page contradict it: a UTF-8 page that is not valid UTF-8, or a page
+// in a legacy encoding that reads as UTF-8.
+func charsetsDisagree(detected string, content []byte, declarations ...string) bool {
+	for _, declared := range declarations {
+		if declared != "" && declared != detected {
+			return true
+		}
+	}
+
+	switch detected {
+	case charsetUTF8:
+		return !utf8.Valid(content)
+	case "utf-16be", "utf-16le":
+		return false
+	default:
+		return hasNonASCII(content) && utf8.Valid(content)
+	}
+}
+
+// declaredCharset is the canonical name of a declared charset label, or the label itself when it
+// names no encoding HTML knows.
+func declaredCharset(label, canonical string) string {
+	if canonical != "" {
+		return canonical
+	}
+
+	return label
+}
+
+// lookupCharset returns the canonical name of a charset label, or an empty string when it names
+// no encoding HTML knows.
+func lookupCharset(label string) string {
+	if label == "" {
+		return ""
+	}
+
+	_, name := charset.Lookup(label)
+
+	return name
+}
+
+func byteOrderMark(content []byte) (string, int) {
+	for _, mark := range byteOrderMarks {
+		if bytes.HasPrefix(content, mark.bom) {
+			return mark.charset, len(mark.bom)
+		}
+	}
+
+	return "", 0
+}
+
+// prescanMetaCharset returns the charset label of the first <meta charset> or
+// <meta http-equiv="Content-Type"> tag at the start of a page.
+func prescanMetaCharset(content []byte) string {
+	if len(content) > metaPrescanBytes {
+		content = content[:metaPrescanBytes]
+	}
+
+	tokenizer := html.NewTokenizer(bytes.NewReader(content))
+
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return ""
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := tokenizer.TagName()
+			if string(name) != "meta" {
+				continue
+			}
+
+			var (
+				metaCharset string
+				httpEquiv   bool
+				metaContent string
+			)
+
+			for hasAttr {
+				var key, value []byte
+
+				key, value, hasAttr = tokenizer.TagAttr()
+
+				switch string(key) {
+				case "charset":
+					metaCharset = strings.ToLower(strings.TrimSpace(string(value)))
+				case "http-equiv":
+					httpEquiv = strings.EqualFold(strings.TrimSpace(string(value)), "content-type")
+				case "content":
+					metaContent = string(value)
+				}
+			}
+
+			if metaCharset != "" {
+				return metaCharset
+			}
+
+			if httpEquiv {
+				if contentCharset := charsetFromContentType(metaContent); contentCharset != "" {
+					return contentCharset
+				}
+			}
+		}
+	}
+}
+
+func hasNonASCII(content []byte) bool {
+	for _, b := range content {
+		if b >= utf8.RuneSelf {
+			return true
+		}
+	}
+
+	return false
+}
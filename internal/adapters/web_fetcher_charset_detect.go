@@ -0,0 +1,338 @@
+package adapters
+
+import (
+	"math"
+	"unicode"
+
+	"golang.org/x/net/html/charset"
+)
+
+const (
+	// minMultiByteConfidence is the confidence, out of 100, a multi-byte encoding needs before a
+	// page is read in it rather than in a single-byte encoding.
+	minMultiByteConfidence = 50
+
+	// upperCaseWeightDivisor scales down the weight of upper case letters, which are much rarer in
+	// running text than lower case ones. It tells apart encodings that map the same bytes to the
+	// two cases of an alphabet, such as windows-1251 and KOI8-R.
+	upperCaseWeightDivisor = 3
+)
+
+// multiByteCharset is a multi-byte encoding the detector recognises: how its characters are laid
+// out in bytes, and the characters most frequent in the language it is used for.
+type multiByteCharset struct {
+	name        string
+	charLength  func(content []byte) int
+	commonChars string
+}
+
+// singleByteCharset is a single-byte encoding the detector recognises, with the weights of the
+// letters most frequent in the languages it is used for. Only letters outside ASCII matter, as
+// every candidate reads ASCII alike.
+type singleByteCharset struct {
+	name    string
+	letters map[rune]int
+}
+
+// multiByteCharsets are tried in order, so that the first wins a tie.
+var multiByteCharsets = []multiByteCharset{
+	{
+		name:       "shift_jis",
+		charLength: shiftJISCharLength,
+		commonChars: "のにはをたがでてとしれさあるいうかこらなまもっりすくけんーきよやせ" +
+			"日本人年大一中会事出時行見月分上生者国自子",
+	},
+	{
+		name:       "euc-jp",
+		charLength: eucJPCharLength,
+		commonChars: "のにはをたがでてとしれさあるいうかこらなまもっりすくけんーきよやせ" +
+			"日本人年大一中会事出時行見月分上生者国自子",
+	},
+	{
+		name:        "gbk",
+		charLength:  gbkCharLength,
+		commonChars: "的一是不了在人有我他这个们中来上大为和国地到以说时要就出会可也你对生能而子那得于着下自之年过发后作里用道行所然家种事成方多经么去法学如都同现当没动面起看定天分还进好小部其些主样理心她本前开但因只从想实",
+	},
+	{
+		name:        "big5",
+		charLength:  big5CharLength,
+		commonChars: "的一是不了在人有我他這個們中來上大為和國地到以說時要就出會可也你對生能而子那得於著下自之年過發後作裡用道行所然家種事成方多經麼去法學如都同現當沒動面起看定天分還進好小部其些主樣理心她本前開但因只從想實",
+	},
+	{
+		name:        "euc-kr",
+		charLength:  eucKRCharLength,
+		commonChars: "이다는의에가고를한하지서으로기도사자리일대시아정수있을니것들나게적인해그어보장요면우전주만부상전에서원국",
+	},
+}
+
+// singleByteCharsets are tried in order, so that windows-1252, the fallback, wins a tie.
+var singleByteCharsets = []singleByteCharset{
+	{
+		name: charsetWindows1252,
+		letters: map[rune]int{
+			'é': 10, 'à': 5, 'è': 4, 'ü': 4, 'ö': 4, 'ä': 4, 'ñ': 4, 'á': 4, 'í': 4, 'ó': 4,
+			'ç': 3, 'ê': 3, 'ß': 2, 'ã': 2, 'õ': 2, 'â': 2, 'ô': 2, 'ú': 2,
+			'î': 1, 'û': 1, 'ë': 1, 'ï': 1, 'ø': 1, 'å': 1, 'æ': 1, 'ù': 1,
+		},
+	},
+	{
+		name: "windows-1250",
+		letters: map[rune]int{
+			'ą': 5, 'ę': 5, 'ł': 6, 'ś': 4, 'ż': 4, 'ź': 2, 'ć': 4, 'ń': 4, 'ó': 5,
+			'č': 5, 'š': 5, 'ž': 5, 'ř': 5, 'ě': 6, 'ů': 3, 'á': 5, 'í': 5, 'é': 5, 'ý': 4,
+			'ő': 3, 'ű': 2, 'ö': 3, 'ü': 3, 'ă': 3, 'ț': 2, 'ș': 2, 'â': 2, 'î': 2,
+		},
+	},
+	{
+		name:    "windows-1251",
+		letters: cyrillicLetters,
+	},
+	{
+		name:    "koi8-r",
+		letters: cyrillicLetters,
+	},
+}
+
+// cyrillicLetters are the weights of the most frequent Russian letters.
+var cyrillicLetters = map[rune]int{
+	'о': 10, 'е': 8, 'а': 8, 'и': 7, 'н': 7, 'т': 6, 'с': 5, 'р': 5, 'в': 4, 'л': 4,
+	'к': 3, 'м': 3, 'д': 3, 'п': 3, 'у': 3, 'я': 2, 'ы': 2, 'ь': 2, 'г': 2, 'з': 2,
+	'б': 2, 'ч': 1, 'й': 1, 'х': 1, 'ж': 1, 'ш': 1, 'ю': 1, 'ц': 1, 'щ': 1, 'э': 1,
+	'ф': 1, 'ъ': 1, 'ё': 1,
+}
+
+// commonCharCodes are the common characters of each multi-byte encoding, encoded in it.
+var commonCharCodes = encodeCommonChars()
+
+func encodeCommonChars() map[string]map[string]bool {
+	codes := make(map[string]map[string]bool, len(multiByteCharsets))
+
+	for _, candidate := range multiByteCharsets {
+		encoding, _ := charset.Lookup(candidate.name)
+		encoder := encoding.NewEncoder()
+
+		codes[candidate.name] = make(map[string]bool)
+
+		for _, char := range candidate.commonChars {
+			if encoded, err := encoder.Bytes([]byte(string(char))); err == nil {
+				codes[candidate.name][string(encoded)] = true
+			}
+		}
+	}
+
+	return codes
+}
+
+// detectCharset guesses the encoding of a page that declares none and is not valid UTF-8, from
+// the statistics of its bytes. Multi-byte encodings are recognised by how well the bytes follow
+// their layout and how many of the characters are common in the language; single-byte ones by
+// how frequent the letters the bytes stand for are. It returns windows-1252 when nothing fits
+// better.
+func detectCharset(content []byte) string {
+	best, bestConfidence := "", 0
+
+	for _, candidate := range multiByteCharsets {
+		if confidence := multiByteConfidence(content, candidate); confidence > bestConfidence {
+			best, bestConfidence = candidate.name, confidence
+		}
+	}
+
+	if bestConfidence >= minMultiByteConfidence {
+		return best
+	}
+
+	best, bestScore := charsetWindows1252, 0.0
+
+	for _, candidate := range singleByteCharsets {
+		if score := singleByteScore(content, candidate); score > bestScore {
+			best, bestScore = candidate.name, score
+		}
+	}
+
+	return best
+}
+
+// multiByteConfidence rates, out of 100, how likely the content is in a multi-byte encoding. A
+// single invalid sequence for every twenty characters rules it out; otherwise the confidence grows
+// with the share of common characters among the multi-byte ones.
+func multiByteConfidence(content []byte, candidate multiByteCharset) int {
+	var multiByteChars, invalidChars, commonChars int
+
+	common := commonCharCodes[candidate.name]
+
+	for i := 0; i < len(content); {
+		length := candidate.charLength(content[i:])
+
+		switch {
+		case length == 0:
+			invalidChars++
+			i++
+
+			continue
+		case length > 1:
+			multiByteChars++
+
+			if common[string(content[i:i+length])] {
+				commonChars++
+			}
+		}
+
+		i += length
+	}
+
+	if multiByteChars <= 10 && invalidChars == 0 {
+		return 10
+	}
+
+	if multiByteChars < 20*invalidChars {
+		return 0
+	}
+
+	scale := 90 / math.Log(float64(multiByteChars)/4)
+	confidence := int(math.Log(float64(commonChars)+1)*scale + 10)
+
+	return min(max(confidence, 0), 100)
+}
+
+// singleByteScore is the average weight of the letters the bytes outside ASCII stand for in a
+// single-byte encoding.
+func singleByteScore(content []byte, candidate singleByteCharset) float64 {
+	encoding, _ := charset.Lookup(candidate.name)
+	decoder := encoding.NewDecoder()
+
+	var weight, count int
+
+	for _, b := range content {
+		if b < 0x80 {
+			continue
+		}
+
+		decoded, err := decoder.Bytes([]byte{b})
+		if err != nil {
+			continue
+		}
+
+		count++
+
+		for _, r := range string(decoded) {
+			lower := unicode.ToLower(r)
+
+			switch {
+			case r == lower:
+				weight += candidate.letters[r] * upperCaseWeightDivisor
+			default:
+				weight += candidate.letters[lower]
+			}
+		}
+	}
+
+	if count == 0 {
+		return 0
+	}
+
+	return float64(weight) / float64(count*upperCaseWeightDivisor)
+}
+
+// shiftJISCharLength returns the length of the character at the start of Shift_JIS content, or 0
+// when the bytes are not one.
+func shiftJISCharLength(content []byte) int {
+	lead := content[0]
+
+	switch {
+	case lead < 0x80, lead >= 0xa1 && lead <= 0xdf:
+		return 1
+	case (lead >= 0x81 && lead <= 0x9f) || (lead >= 0xe0 && lead <= 0xfc):
+		if len(content) > 1 && content[1] >= 0x40 && content[1] <= 0xfc && content[1] != 0x7f {
+			return 2
+		}
+	}
+
+	return 0
+}
+
+// eucJPCharLength returns the length of the character at the start of EUC-JP content, or 0 when
+// the bytes are not one.
+func eucJPCharLength(content []byte) int {
+	lead := content[0]
+
+	switch {
+	case lead < 0x80:
+		return 1
+	case lead == 0x8e:
+		if len(content) > 1 && content[1] >= 0xa1 && content[1] <= 0xdf {
+			return 2
+		}
+	case lead == 0x8f:
+		if len(content) > 2 && isEUCByte(content[1]) && isEUCByte(content[2]) {
+			return 3
+		}
+	case isEUCByte(lead):
+		if len(content) > 1 && isEUCByte(content[1]) {
+			return 2
+		}
+	}
+
+	return 0
+}
+
+// eucKRCharLength returns the length of the character at the start of EUC-KR content, or 0 when
+// the bytes are not one.
+func eucKRCharLength(content []byte) int {
+	lead := content[0]
+
+	switch {
+	case lead < 0x80:
+		return 1
+	case isEUCByte(lead):
+		if len(content) > 1 && isEUCByte(content[1]) {
+			return 2
+		}
+	}
+
+	return 0
+}
+
+// gbkCharLength returns the length of the character at the start of GBK or GB18030 content, or 0
+// when the bytes are not one.
+func gbkCharLength(content []byte) int {
+	lead := content[0]
+
+	switch {
+	case lead < 0x80:
+		return 1
+	case lead >= 0x81 && lead <= 0xfe && len(content) > 1:
+		trail := content[1]
+
+		if trail >= 0x40 && trail <= 0xfe && trail != 0x7f {
+			return 2
+		}
+
+		if trail >= 0x30 && trail <= 0x39 && len(content) > 3 &&
+			content[2] >= 0x81 && content[2] <= 0xfe && content[3] >= 0x30 && content[3] <= 0x39 {
+			return 4
+		}
+	}
+
+	return 0
+}
+
+// big5CharLength returns the length of the character at the start of Big5 content, or 0 when the
+// bytes are not one.
+func big5CharLength(content []byte) int {
+	lead := content[0]
+
+	switch {
+	case lead < 0x80:
+		return 1
+	case lead >= 0x81 && lead <= 0xfe && len(content) > 1:
+		if trail := content[1]; (trail >= 0x40 && trail <= 0x7e) || (trail >= 0xa1 && trail <= 0xfe) {
+			return 2
+		}
+	}
+
+	return 0
+}
+
+func isEUCByte(b byte) bool {
+	return b >= 0xa1 && b <= 0xfe
+}
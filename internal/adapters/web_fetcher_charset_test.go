@@ -0,0 +1,284 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html/charset"
+)
+
+func encodeCharset(t *testing.T, label, content string) []byte {
+	t.Helper()
+
+	encoding, _ := charset.Lookup(label)
+	require.NotNil(t, encoding, "Unknown charset %s", label)
+
+	encoded, err := encoding.NewEncoder().Bytes([]byte(content))
+	require.NoError(t, err)
+
+	return encoded
+}
+
+func TestTranscodePage(t *testing.T) {
+	t.Parallel()
+
+	const (
+		japanese = "<html><head><title>日本語のページ</title></head><body><h1>こんにちは</h1></body></html>"
+		french   = "<html><head><title>Café crème</title></head><body><h1>Été à Paris</h1></body></html>"
+	)
+
+	cases := []struct {
+		name        string
+		body        func(t *testing.T) []byte
+		contentType string
+		expected    string
+		encoding    domain.PageEncoding
+	}{
+		{
+			name:        "Charset from Content-Type",
+			body:        func(t *testing.T) []byte { return encodeCharset(t, "shift_jis", japanese) },
+			contentType: "text/html; charset=Shift_JIS",
+			expected:    japanese,
+			encoding: domain.PageEncoding{
+				Detected:      "shift_jis",
+				Source:        domain.EncodingSourceContentType,
+				Declared:      "shift_jis",
+				HeaderCharset: "shift_jis",
+			},
+		},
+		{
+			name:        "Label of another name",
+			body:        func(t *testing.T) []byte { return encodeCharset(t, "windows-1252", french) },
+			contentType: `text/html; charset="ISO-8859-1"`,
+			expected:    french,
+			encoding: domain.PageEncoding{
+				Detected:      "windows-1252",
+				Source:        domain.EncodingSourceContentType,
+				Declared:      "windows-1252",
+				HeaderCharset: "iso-8859-1",
+			},
+		},
+		{
+			name: "Byte order mark",
+			body: func(t *testing.T) []byte {
+				return append([]byte{0xff, 0xfe}, encodeCharset(t, "utf-16le", french)...)
+			},
+			contentType: "text/html",
+			expected:    french,
+			encoding: domain.PageEncoding{
+				Detected: "utf-16le",
+				Source:   domain.EncodingSourceBOM,
+			},
+		},
+		{
+			name: "UTF-8 byte order mark",
+			body: func(*testing.T) []byte {
+				return append([]byte{0xef, 0xbb, 0xbf}, japanese...)
+			},
+			expected: japanese,
+			encoding: domain.PageEncoding{
+				Detected: "utf-8",
+				Source:   domain.EncodingSourceBOM,
+			},
+		},
+		{
+			name: "Meta charset",
+			body: func(t *testing.T) []byte {
+				return encodeCharset(t, "euc-jp", `<meta charset="EUC-JP">`+japanese)
+			},
+			contentType: "text/html",
+			expected:    `<meta charset="EUC-JP">` + japanese,
+			encoding: domain.PageEncoding{
+				Detected:    "euc-jp",
+				Source:      domain.EncodingSourceMeta,
+				Declared:    "euc-jp",
+				MetaCharset: "euc-jp",
+			},
+		},
+		{
+			name: "Meta http-equiv",
+			body: func(t *testing.T) []byte {
+				return encodeCharset(t, "iso-8859-2",
+					`<meta http-equiv="Content-Type" content="text/html; charset=iso-8859-2">Zażółć`)
+			},
+			expected: `<meta http-equiv="Content-Type" content="text/html; charset=iso-8859-2">Zażółć`,
+			encoding: domain.PageEncoding{
+				Detected:    "iso-8859-2",
+				Source:      domain.EncodingSourceMeta,
+				Declared:    "iso-8859-2",
+				MetaCharset: "iso-8859-2",
+			},
+		},
+		{
+			name:     "Sniffed UTF-8",
+			body:     func(*testing.T) []byte { return []byte(japanese) },
+			expected: japanese,
+			encoding: domain.PageEncoding{
+				Detected: "utf-8",
+				Source:   domain.EncodingSourceSniffed,
+			},
+		},
+		{
+			name:     "Undeclared legacy encoding",
+			body:     func(t *testing.T) []byte { return encodeCharset(t, "windows-1252", french) },
+			expected: french,
+			encoding: domain.PageEncoding{
+				Detected: "windows-1252",
+				Source:   domain.EncodingSourceDefault,
+			},
+		},
+		{
+			name:     "Undeclared Shift_JIS",
+			body:     func(t *testing.T) []byte { return encodeCharset(t, "shift_jis", japanese) },
+			expected: japanese,
+			encoding: domain.PageEncoding{
+				Detected: "shift_jis",
+				Source:   domain.EncodingSourceSniffed,
+			},
+		},
+		{
+			name: "Header and meta disagree",
+			body: func(t *testing.T) []byte {
+				return encodeCharset(t, "shift_jis", `<meta charset="utf-8">`+japanese)
+			},
+			contentType: "text/html; charset=shift_jis",
+			expected:    `<meta charset="utf-8">` + japanese,
+			encoding: domain.PageEncoding{
+				Detected:      "shift_jis",
+				Source:        domain.EncodingSourceContentType,
+				Declared:      "shift_jis",
+				HeaderCharset: "shift_jis",
+				MetaCharset:   "utf-8",
+				Mismatch:      true,
+			},
+		},
+		{
+			name:        "UTF-8 page declared as legacy",
+			body:        func(*testing.T) []byte { return []byte(french) },
+			contentType: "text/html; charset=iso-8859-1",
+			expected:    "<html><head><title>CafÃ© crÃ¨me</title></head><body><h1>Ã‰tÃ© Ã\u00a0 Paris</h1></body></html>",
+			encoding: domain.PageEncoding{
+				Detected:      "windows-1252",
+				Source:        domain.EncodingSourceContentType,
+				Declared:      "windows-1252",
+				HeaderCharset: "iso-8859-1",
+				Mismatch:      true,
+			},
+		},
+		{
+			name: "Legacy page declared as UTF-8",
+			body: func(t *testing.T) []byte {
+				return encodeCharset(t, "windows-1252", `<meta charset="utf-8">`+french)
+			},
+			expected: "<meta charset=\"utf-8\"><html><head><title>Caf\xe9 cr\xe8me</title></head><body><h1>\xc9t\xe9 \xe0 Paris</h1></body></html>",
+			encoding: domain.PageEncoding{
+				Detected:    "utf-8",
+				Source:      domain.EncodingSourceMeta,
+				Declared:    "utf-8",
+				MetaCharset: "utf-8",
+				Mismatch:    true,
+			},
+		},
+		{
+			name:        "Unknown declared charset",
+			body:        func(*testing.T) []byte { return []byte(japanese) },
+			contentType: "text/html; charset=klingon",
+			expected:    japanese,
+			encoding: domain.PageEncoding{
+				Detected:      "utf-8",
+				Source:        domain.EncodingSourceSniffed,
+				Declared:      "klingon",
+				HeaderCharset: "klingon",
+				Mismatch:      true,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			page, pageEncoding, err := transcodePage(tc.body(t), tc.contentType)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, page)
+			assert.Equal(t, tc.encoding, *pageEncoding)
+		})
+	}
+}
+
+func TestPrescanMetaCharset(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{name: "Charset attribute", content: `<head><meta charset=" Shift_JIS ">`, expected: "shift_jis"},
+		{name: "Http-equiv", content: `<meta http-equiv="content-type" content="text/html; charset='koi8-r'">`, expected: "koi8-r"},
+		{name: "First declaration wins", content: `<meta charset="utf-8"><meta charset="gbk">`, expected: "utf-8"},
+		{name: "Content without http-equiv", content: `<meta name="x" content="text/html; charset=gbk">`, expected: ""},
+		{name: "No declaration", content: `<html><head><title>Page</title></head></html>`, expected: ""},
+		{
+			name:     "Declaration past the prescan window",
+			content:  "<!--" + string(make([]byte, metaPrescanBytes)) + `--><meta charset="gbk">`,
+			expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, prescanMetaCharset([]byte(tc.content)))
+		})
+	}
+}
+
+func TestDetectCharset(t *testing.T) {
+	t.Parallel()
+
+	const (
+		japanese = "<html><head><title>日本語のページ</title></head><body><p>これは日本語で書かれたページです。" +
+			"今日はいい天気ですね。私たちの会社の新しい製品について説明します。</p></body></html>"
+		chinese = "<html><head><title>中文网页</title></head><body><p>这是一个用中文写的网页。我们的公司在中国有很多客户，" +
+			"他们对我们的产品和服务都非常满意。</p></body></html>"
+		traditional = "<html><head><title>中文網頁</title></head><body><p>這是一個用中文寫的網頁。我們的公司在台灣有很多客戶，" +
+			"他們對我們的產品和服務都非常滿意。</p></body></html>"
+		korean = "<html><head><title>한국어 페이지</title></head><body><p>이것은 한국어로 작성된 페이지입니다. " +
+			"우리 회사는 고객에게 좋은 제품과 서비스를 제공하기 위해 노력하고 있습니다.</p></body></html>"
+		russian = "<html><head><title>Русская страница</title></head><body><p>Это страница, написанная на русском языке. " +
+			"Наша компания предлагает новые продукты и услуги для своих клиентов.</p></body></html>"
+		polish = "<html><head><title>Strona po polsku</title></head><body><p>Zażółć gęślą jaźń. Nasza firma oferuje " +
+			"nowe usługi dla klientów, którzy cenią sobie jakość i szybką obsługę.</p></body></html>"
+		french = "<html><head><title>Café crème</title></head><body><p>Été à Paris, déjà très animé. " +
+			"Nous présentons les dernières créations de notre équipe.</p></body></html>"
+	)
+
+	cases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{name: "Shift_JIS", content: japanese, expected: "shift_jis"},
+		{name: "EUC-JP", content: japanese, expected: "euc-jp"},
+		{name: "GBK", content: chinese, expected: "gbk"},
+		{name: "Big5", content: traditional, expected: "big5"},
+		{name: "EUC-KR", content: korean, expected: "euc-kr"},
+		{name: "Windows-1251", content: russian, expected: "windows-1251"},
+		{name: "KOI8-R", content: russian, expected: "koi8-r"},
+		{name: "Windows-1250", content: polish, expected: "windows-1250"},
+		{name: "Windows-1252", content: french, expected: charsetWindows1252},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, detectCharset(encodeCharset(t, tc.expected, tc.content)))
+		})
+	}
+}
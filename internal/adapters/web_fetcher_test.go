@@ -1,6 +1,7 @@
 package adapters
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -8,7 +9,9 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -41,6 +44,12 @@ func (f *TestWebPageFetcher) Fetch(ctx context.Context, targetURL string, timeou
 		return nil, domain.NewInvalidURLError(targetURL, err)
 	}
 
+	robots, release, err := f.waitForRobots(ctx, targetURL)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	if timeout > 0 {
 		f.client.SetTimeout(timeout)
 	}
@@ -62,7 +71,10 @@ func (f *TestWebPageFetcher) Fetch(ctx context.Context, targetURL string, timeou
 		return nil, err
 	}
 
-	return result.(*domain.WebPageContent), nil
+	content := result.(*domain.WebPageContent)
+	content.Robots = robots
+
+	return content, nil
 }
 
 // validateURL overrides the normal validation to allow local URLs for testing
@@ -117,7 +129,7 @@ func newWebFetcherTestSuite(t *testing.T) *WebFetcherTestSuite {
 
 // SetupTest sets up resources before each test
 func (suite *WebFetcherTestSuite) SetupTest() {
-	baseFetcher := NewWebFetcher(suite.config, suite.logger)
+	baseFetcher := NewWebFetcher(suite.config, nil, nil, suite.logger)
 	suite.fetcher = &TestWebPageFetcher{WebFetcher: baseFetcher}
 }
 
@@ -142,7 +154,7 @@ type serverConfig struct {
 	StatusCode   int
 	ContentType  string
 	ResponseBody string
-	Headers      map[string]string
+	Headers      http.Header
 	Delay        time.Duration
 	ResponseSize int // For generating large responses
 }
@@ -169,13 +181,15 @@ func WithResponseBody(body string) ServerOption {
 }
 
 // WithHeaders sets custom headers for the server response
-func WithHeaders(headers map[string]string) ServerOption {
+func WithHeaders(headers http.Header) ServerOption {
 	return func(config *serverConfig) {
 		if config.Headers == nil {
-			config.Headers = make(map[string]string)
+			config.Headers = make(http.Header)
 		}
-		for key, value := range headers {
-			config.Headers[key] = value
+		for key, values := range headers {
+			for _, value := range values {
+				config.Headers.Add(key, value)
+			}
 		}
 	}
 }
@@ -218,8 +232,10 @@ func (suite *WebFetcherTestSuite) createSimpleServer(options ...ServerOption) *h
 		}
 
 		// Middleware custom headers
-		for key, value := range config.Headers {
-			w.Header().Set(key, value)
+		for key, values := range config.Headers {
+			for _, value := range values {
+				w.Header().Add(key, value)
+			}
 		}
 
 		// Middleware status code
@@ -269,7 +285,7 @@ func (suite *WebFetcherTestSuite) TestFetch_SuccessfulRequest() {
 		responseBody   string
 		responseCode   int
 		contentType    string
-		customHeaders  map[string]string
+		customHeaders  http.Header
 		expectedStatus int
 	}{
 		{
@@ -298,7 +314,15 @@ func (suite *WebFetcherTestSuite) TestFetch_SuccessfulRequest() {
 			responseBody:   "<html><body>Test</body></html>",
 			responseCode:   http.StatusOK,
 			contentType:    "text/html",
-			customHeaders:  map[string]string{"X-Custom-Header": "test-value"},
+			customHeaders:  http.Header{"X-Custom-Header": {"test-value"}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Multi-valued headers",
+			responseBody:   "<html><body>Test</body></html>",
+			responseCode:   http.StatusOK,
+			contentType:    "text/html",
+			customHeaders:  http.Header{"Set-Cookie": {"session=abc; Secure", "theme=dark"}},
 			expectedStatus: http.StatusOK,
 		},
 	}
@@ -335,8 +359,8 @@ func (suite *WebFetcherTestSuite) TestFetch_SuccessfulRequest() {
 			assert.Equal(t, server.URL, result.URL)
 
 			// Check custom headers
-			for key, expectedValue := range tc.customHeaders {
-				assert.Equal(t, expectedValue, result.Headers[key])
+			for key, expectedValues := range tc.customHeaders {
+				assert.Equal(t, expectedValues, result.Headers.Values(key))
 			}
 		})
 	}
@@ -386,7 +410,7 @@ func (suite *WebFetcherTestSuite) TestFetch_ErrorCases() {
 					WithResponseSize(11*1024*1024), // Write more than 10MB
 				)
 			},
-			expectedErrMsg: "Response size .* bytes exceeds maximum allowed",
+			expectedErrMsg: "Response size .*exceeds maximum allowed",
 			expectedCode:   "RESPONSE_TOO_LARGE",
 		},
 		{
@@ -564,7 +588,7 @@ func (suite *WebFetcherTestSuite) TestFetch_InvalidURLs() {
 						Timeout:     60 * time.Second,
 					},
 				}
-				realFetcher := NewWebFetcher(cfg, infrastructure.Logger{Logger: zerolog.Nop()})
+				realFetcher := NewWebFetcher(cfg, nil, nil, infrastructure.Logger{Logger: zerolog.Nop()})
 				result, err = realFetcher.Fetch(ctx, tc.url, 0)
 			} else {
 				// Use TestWebPageFetcher for other tests
@@ -622,6 +646,186 @@ func (suite *WebFetcherTestSuite) TestFetch_CircuitBreaker() {
 	assert.Contains(suite.t, domainErr.Message, "Service temporarily unavailable")
 }
 
+// TestFetch_Robots tests that pages robots.txt disallows are not fetched
+func (suite *WebFetcherTestSuite) TestFetch_Robots() {
+	var pageRequests atomic.Int32
+
+	suite.createTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			w.Write([]byte("User-agent: webanalyzer\nDisallow: /private\n\nSitemap: https://example.com/sitemap.xml\n"))
+
+			return
+		}
+
+		pageRequests.Add(1)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<html><body>Page</body></html>"))
+	})
+
+	fetcher := &TestWebPageFetcher{
+		WebFetcher: NewWebFetcher(suite.config, nil, newTestRobotsPolicy(newMemoryRobotsTxtCache()), suite.logger),
+	}
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := fetcher.Fetch(ctx, suite.testServer.URL+"/private/page", 0)
+	require.Nil(suite.t, result)
+
+	var domainErr *domain.DomainError
+	require.True(suite.t, errors.As(err, &domainErr), "Expected domain error, got %T", err)
+	assert.Equal(suite.t, "ROBOTS_DISALLOWED", domainErr.Code)
+	assert.Equal(suite.t, http.StatusForbidden, domainErr.StatusCode)
+	assert.ErrorIs(suite.t, err, domain.ErrRobotsDisallowed)
+	assert.Equal(suite.t, int32(0), pageRequests.Load(), "Should not request a disallowed page")
+
+	result, err = fetcher.Fetch(ctx, suite.testServer.URL+"/public", 0)
+	require.NoError(suite.t, err)
+	require.NotNil(suite.t, result.Robots)
+	assert.True(suite.t, result.Robots.Allowed)
+	assert.Equal(suite.t, []string{"https://example.com/sitemap.xml"}, result.Robots.Sitemaps)
+
+	result, err = fetcher.Fetch(domain.WithRobotsTxtIgnored(ctx), suite.testServer.URL+"/private/page", 0)
+	require.NoError(suite.t, err)
+	require.NotNil(suite.t, result.Robots)
+	assert.True(suite.t, result.Robots.Overridden)
+	assert.Equal(suite.t, "Disallow: /private", result.Robots.Rule)
+}
+
+// TestFetch_NetworkGuard tests that pages on non public addresses are refused without retries
+func (suite *WebFetcherTestSuite) TestFetch_NetworkGuard() {
+	var requests atomic.Int32
+
+	suite.createTestServer(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<html><body>Internal</body></html>"))
+	})
+
+	guard, err := NewNetworkGuard(config.EgressConfig{})
+	require.NoError(suite.t, err)
+
+	fetcher := &TestWebPageFetcher{WebFetcher: NewWebFetcher(suite.config, guard, nil, suite.logger)}
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 5*time.Second)
+	defer cancel()
+
+	for i := 0; i < 4; i++ {
+		result, err := fetcher.Fetch(ctx, suite.testServer.URL, 0)
+		require.Nil(suite.t, result)
+
+		var domainErr *domain.DomainError
+		require.True(suite.t, errors.As(err, &domainErr), "Expected domain error, got %T", err)
+		assert.Equal(suite.t, "ADDRESS_NOT_ALLOWED", domainErr.Code, "Refusals should not open the circuit breaker")
+		assert.ErrorIs(suite.t, err, domain.ErrAddressNotAllowed)
+	}
+
+	assert.Zero(suite.t, requests.Load(), "Should not connect to non public addresses")
+}
+
+// TestFetch_ContentEncoding tests that compressed pages are decoded and their sizes reported
+func (suite *WebFetcherTestSuite) TestFetch_ContentEncoding() {
+	html := []byte(strings.Repeat("<p>Hello, World!</p>", 100))
+	compressed := compressGzip(suite.t, html)
+
+	suite.createTestServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(suite.t, "gzip, deflate, br, zstd", r.Header.Get("Accept-Encoding"))
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(compressed)
+	})
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := suite.fetcher.Fetch(ctx, suite.testServer.URL, 0)
+	require.NoError(suite.t, err)
+
+	assert.Equal(suite.t, string(html), result.HTML)
+	assert.Equal(suite.t, int64(len(compressed)), result.TransferredBytes)
+	assert.Equal(suite.t, int64(len(html)), result.DecodedBytes)
+	assert.Equal(suite.t, "gzip", result.Headers.Get("Content-Encoding"), "Should keep the header as received")
+}
+
+// TestFetch_Charset tests that pages in legacy encodings are transcoded to UTF-8
+func (suite *WebFetcherTestSuite) TestFetch_Charset() {
+	html := "<html><head><title>日本語のページ</title></head><body><h1>こんにちは</h1></body></html>"
+	encoded := encodeCharset(suite.t, "shift_jis", html)
+
+	suite.createTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=Shift_JIS")
+		w.Write(encoded)
+	})
+
+	ctx, cancel := context.WithTimeout(suite.t.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := suite.fetcher.Fetch(ctx, suite.testServer.URL, 0)
+	require.NoError(suite.t, err)
+
+	assert.Equal(suite.t, html, result.HTML)
+	assert.Equal(suite.t, int64(len(encoded)), result.DecodedBytes)
+	require.NotNil(suite.t, result.Encoding)
+	assert.Equal(suite.t, "shift_jis", result.Encoding.Detected)
+	assert.Equal(suite.t, domain.EncodingSourceContentType, result.Encoding.Source)
+	assert.False(suite.t, result.Encoding.Mismatch)
+}
+
+// TestFetch_ResponseSizeLimit tests that oversized bodies are refused without reading them whole
+func (suite *WebFetcherTestSuite) TestFetch_ResponseSizeLimit() {
+	cfg := suite.config
+	cfg.MaxResponseSizeBytes = 64 * 1024
+
+	fetcher := &TestWebPageFetcher{WebFetcher: NewWebFetcher(cfg, nil, nil, suite.logger)}
+
+	bomb := compressGzip(suite.t, bytes.Repeat([]byte{0}, 64*int(cfg.MaxResponseSizeBytes)))
+
+	cases := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{
+			name: "Announced by Content-Length",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html")
+				w.Header().Set("Content-Length", strconv.FormatInt(cfg.MaxResponseSizeBytes+1, 10))
+				w.WriteHeader(http.StatusOK)
+				w.(http.Flusher).Flush()
+
+				// The body never comes, the fetcher must not wait for it.
+				<-r.Context().Done()
+			},
+		},
+		{
+			name: "Decompression bomb",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html")
+				w.Header().Set("Content-Encoding", "gzip")
+				w.Write(bomb)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+
+			ctx, cancel := context.WithTimeout(t.Context(), 2*time.Second)
+			defer cancel()
+
+			result, err := fetcher.Fetch(ctx, server.URL, 0)
+			require.Nil(t, result)
+
+			var domainErr *domain.DomainError
+			require.True(t, errors.As(err, &domainErr), "Expected domain error, got %T", err)
+			assert.Equal(t, "RESPONSE_TOO_LARGE", domainErr.Code)
+			assert.Equal(t, http.StatusRequestEntityTooLarge, domainErr.StatusCode)
+		})
+	}
+}
+
 // TestFetch_TimeoutSettings tests timeout configuration
 func (suite *WebFetcherTestSuite) TestFetch_TimeoutSettings() {
 	cases := []struct {
@@ -805,13 +1009,25 @@ func (suite *WebFetcherTestSuite) TestValidateURL() {
 			wantErr: true,
 			errMsg:  "access to private or local networks is not allowed",
 		},
+		{
+			name:    "Cloud metadata endpoint blocked",
+			url:     "http://169.254.169.254/latest/meta-data/",
+			wantErr: true,
+			errMsg:  "access to private or local networks is not allowed",
+		},
+		{
+			name:    "IPv6 unique local address blocked",
+			url:     "http://[fd00::1]/",
+			wantErr: true,
+			errMsg:  "access to private or local networks is not allowed",
+		},
 	}
 
 	for _, tc := range cases {
 		suite.t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			realFetcher := NewWebFetcher(config.WebFetcherConfig{}, infrastructure.Logger{Logger: zerolog.Nop()})
+			realFetcher := NewWebFetcher(config.WebFetcherConfig{}, nil, nil, infrastructure.Logger{Logger: zerolog.Nop()})
 
 			err := realFetcher.validateURL(tc.url)
 			if tc.wantErr {
@@ -979,6 +1195,31 @@ func (suite *WebFetcherTestSuite) TestIsPrivateOrLocalURL() {
 			host:     "192.169.0.1",
 			expected: false,
 		},
+		{
+			name:     "Link local metadata endpoint",
+			host:     "169.254.169.254",
+			expected: true,
+		},
+		{
+			name:     "Shared address space",
+			host:     "100.64.0.1",
+			expected: true,
+		},
+		{
+			name:     "IPv6 unique local",
+			host:     "fd00::1",
+			expected: true,
+		},
+		{
+			name:     "IPv4 mapped loopback",
+			host:     "::ffff:127.0.0.1",
+			expected: true,
+		},
+		{
+			name:     "Localhost with trailing dot",
+			host:     "localhost.",
+			expected: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -42,6 +42,8 @@ type (
 		Auth                  AuthConfig                  `json:"auth"`
 		WebFetcher            WebFetcherConfig            `json:"web_fetcher"`
 		LinkChecker           LinkCheckerConfig           `json:"link_checker"`
+		Robots                RobotsConfig                `json:"robots"`
+		Egress                EgressConfig                `json:"egress"`
 	}
 
 	AppConfig struct {
@@ -167,6 +169,16 @@ type (
 		PoolTimeout   time.Duration `envconfig:"KEYDB_POOL_TIMEOUT" default:"5s" json:"pool_timeout"`
 		MaxRetries    int           `envconfig:"KEYDB_MAX_RETRIES" default:"3" json:"max_retries"`
 		DefaultExpiry time.Duration `envconfig:"KEYDB_DEFAULT_EXPIRY" default:"24h" json:"default_expiry"`
+
+		// Link check outcomes are shared between analyses, failures for a shorter time so a link
+		// that comes back is noticed soon.
+		LinkCheckSuccessTTL time.Duration `envconfig:"KEYDB_LINK_CHECK_SUCCESS_TTL" default:"6h" json:"link_check_success_ttl"`
+		LinkCheckFailureTTL time.Duration `envconfig:"KEYDB_LINK_CHECK_FAILURE_TTL" default:"15m" json:"link_check_failure_ttl"`
+
+		// A robots.txt is kept for a day at most, one that answered with a server error only until
+		// the origin had a chance to recover.
+		RobotsTxtTTL      time.Duration `envconfig:"KEYDB_ROBOTS_TXT_TTL" default:"24h" json:"robots_txt_ttl"`
+		RobotsTxtErrorTTL time.Duration `envconfig:"KEYDB_ROBOTS_TXT_ERROR_TTL" default:"10m" json:"robots_txt_error_ttl"`
 	}
 
 	ThrottledRateLimitingConfig struct {
@@ -191,6 +203,7 @@ type (
 		UseVaultKeys   bool          `envconfig:"AUTH_USE_VAULT_KEYS" default:"true" json:"use_vault_keys"`
 		KeyCacheTTL    time.Duration `envconfig:"AUTH_KEY_CACHE_TTL" default:"1h" json:"key_cache_ttl"`
 		FallbackKeyHex string        `envconfig:"AUTH_FALLBACK_KEY_HEX" default:"01c7981f62c676934dc4acfa7825205ae927960875d09abec497efbe2dba41b7" json:"fallback_key_hex,omitempty"`
+		AdminScope     string        `envconfig:"AUTH_ADMIN_SCOPE" default:"admin" json:"admin_scope"`
 	}
 
 	BackoffConfig struct {
@@ -229,6 +242,50 @@ type (
 		RetryWaitTime       time.Duration        `envconfig:"LINK_CHECKER_RETRY_WAIT_TIME" default:"500ms" json:"retry_wait_time"`
 		MaxRetryWaitTime    time.Duration        `envconfig:"LINK_CHECKER_MAX_RETRY_WAIT_TIME" default:"2s" json:"max_retry_wait_time"`
 		CircuitBreaker      CircuitBreakerConfig `envconfig:"LINK_CHECKER_CIRCUIT_BREAKER" json:"circuit_breaker"`
+
+		// Internal links all point at the analysed origin, so they are checked with their own,
+		// lower concurrency and paced to at most InternalRequestsPerSecond.
+		InternalMaxConcurrentChecks int     `envconfig:"LINK_CHECKER_INTERNAL_MAX_CONCURRENT_CHECKS" default:"2" json:"internal_max_concurrent_checks"`
+		InternalRequestsPerSecond   float64 `envconfig:"LINK_CHECKER_INTERNAL_REQUESTS_PER_SECOND" default:"5" json:"internal_requests_per_second"`
+
+		// Every host gets its own circuit breaker, kept for the MaxTrackedHosts most recently checked
+		// hosts. Requests to one host start PerHostDelay apart with at most MaxConcurrentChecksPerHost
+		// in flight, and a Retry-After of up to MaxRetryAfter is waited for before checking again.
+		MaxTrackedHosts            int           `envconfig:"LINK_CHECKER_MAX_TRACKED_HOSTS" default:"1000" json:"max_tracked_hosts"`
+		MaxConcurrentChecksPerHost int           `envconfig:"LINK_CHECKER_MAX_CONCURRENT_CHECKS_PER_HOST" default:"2" json:"max_concurrent_checks_per_host"`
+		PerHostDelay               time.Duration `envconfig:"LINK_CHECKER_PER_HOST_DELAY" default:"100ms" json:"per_host_delay"`
+		MaxRetryAfter              time.Duration `envconfig:"LINK_CHECKER_MAX_RETRY_AFTER" default:"10s" json:"max_retry_after"`
+
+		// Redirects are followed for up to MaxRedirects hops and chains longer than LongRedirectChain
+		// are reported. With DetectSoft404, links are fetched with a GET read up to Soft404MaxBodyBytes
+		// instead of a HEAD request, so that pages answering 200 can be checked for soft 404s.
+		MaxRedirects        int   `envconfig:"LINK_CHECKER_MAX_REDIRECTS" default:"5" json:"max_redirects"`
+		LongRedirectChain   int   `envconfig:"LINK_CHECKER_LONG_REDIRECT_CHAIN" default:"3" json:"long_redirect_chain"`
+		DetectSoft404       bool  `envconfig:"LINK_CHECKER_DETECT_SOFT_404" default:"true" json:"detect_soft_404"`
+		Soft404MaxBodyBytes int64 `envconfig:"LINK_CHECKER_SOFT_404_MAX_BODY_BYTES" default:"65536" json:"soft_404_max_body_bytes"`
+
+		// Internal links with a fragment are fetched up to FragmentMaxBodyBytes to check that the
+		// target document has an element with that id or name.
+		CheckFragments       bool  `envconfig:"LINK_CHECKER_CHECK_FRAGMENTS" default:"true" json:"check_fragments"`
+		FragmentMaxBodyBytes int64 `envconfig:"LINK_CHECKER_FRAGMENT_MAX_BODY_BYTES" default:"1048576" json:"fragment_max_body_bytes"`
+	}
+
+	// EgressConfig adjusts the networks the web fetcher, the link checker and robots.txt fetches
+	// may connect to. Loopback, private, link local and other non public addresses are refused
+	// unless they are in AllowedCIDRs, and DeniedCIDRs refuses more networks, even allowed ones.
+	EgressConfig struct {
+		AllowedCIDRs []string `envconfig:"EGRESS_ALLOWED_CIDRS" json:"allowed_cidrs"`
+		DeniedCIDRs  []string `envconfig:"EGRESS_DENIED_CIDRS" json:"denied_cidrs"`
+	}
+
+	// RobotsConfig controls how robots.txt is honoured by the web fetcher and the link checker.
+	// Rules are evaluated for the web fetcher's user agent, robots.txt files are read up to
+	// MaxBodyBytes and a Crawl-delay is waited for up to MaxCrawlDelay.
+	RobotsConfig struct {
+		Enabled       bool          `envconfig:"ROBOTS_ENABLED" default:"true" json:"enabled"`
+		Timeout       time.Duration `envconfig:"ROBOTS_TIMEOUT" default:"5s" json:"timeout"`
+		MaxBodyBytes  int64         `envconfig:"ROBOTS_MAX_BODY_BYTES" default:"512000" json:"max_body_bytes"`
+		MaxCrawlDelay time.Duration `envconfig:"ROBOTS_MAX_CRAWL_DELAY" default:"10s" json:"max_crawl_delay"`
 	}
 )
 
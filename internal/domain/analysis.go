@@ -4,6 +4,7 @@ package domain
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,6 +25,10 @@ const (
 	LinkTypeInternal LinkType = "internal"
 	LinkTypeExternal LinkType = "external"
 
+	LinkScopeExternal LinkScope = "external"
+	LinkScopeInternal LinkScope = "internal"
+	LinkScopeAll      LinkScope = "all"
+
 	InputTypePassword = "password"
 
 	EventTypeStarted   Event = "analysis_started"
@@ -43,17 +48,198 @@ const (
 
 	OutboxEventAnalysisRequested OutboxEventType = "analysis.requested"
 	OutboxEventAnalysisRetry     OutboxEventType = "analysis.retry"
+
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+
+	// RecommendedTitleLength is the title length search engines display before truncating.
+	RecommendedTitleLength = 60
+	// RecommendedDescriptionLength is the description length search engines display before truncating.
+	RecommendedDescriptionLength = 160
+
+	MetaIssueMissingTitle         = "missing_title"
+	MetaIssueTitleTooLong         = "title_too_long"
+	MetaIssueMissingDescription   = "missing_description"
+	MetaIssueMultipleDescriptions = "multiple_descriptions"
+	MetaIssueDescriptionTooLong   = "description_too_long"
+	MetaIssueMultipleCanonicals   = "multiple_canonicals"
+	MetaIssueMissingViewport      = "missing_viewport"
+	MetaIssueMissingCharset       = "missing_charset"
+	MetaIssueNoIndex              = "noindex"
+
+	HeadingIssueMultipleH1 = "multiple_h1"
+	HeadingIssueLevelJump  = "heading_level_jump"
+	HeadingIssueEmpty      = "empty_heading"
+	HeadingIssueAriaHidden = "aria_hidden_heading"
+
+	A11yIssueImageMissingAlt            = "image_missing_alt"
+	A11yIssueInputMissingLabel          = "input_missing_label"
+	A11yIssueMissingLang                = "missing_lang"
+	A11yIssueSkippedHeading             = "skipped_heading_level"
+	A11yIssueEmptyLink                  = "empty_link"
+	A11yIssueEmptyButton                = "empty_button"
+	A11yIssueDuplicateID                = "duplicate_id"
+	A11yIssuePositiveTabindex           = "positive_tabindex"
+	A11yIssueMissingMainLandmark        = "missing_main_landmark"
+	A11yIssueMissingNavigationLandmark  = "missing_navigation_landmark"
+	A11yIssueMissingBannerLandmark      = "missing_banner_landmark"
+	A11yIssueMissingContentinfoLandmark = "missing_contentinfo_landmark"
+
+	StructuredDataJSONLD    StructuredDataFormat = "json-ld"
+	StructuredDataMicrodata StructuredDataFormat = "microdata"
+	StructuredDataRDFa      StructuredDataFormat = "rdfa"
+
+	TechCategoryCMS                 TechCategory = "cms"
+	TechCategoryEcommerce           TechCategory = "ecommerce"
+	TechCategoryWebFramework        TechCategory = "web_framework"
+	TechCategoryJavaScriptFramework TechCategory = "javascript_framework"
+	TechCategoryJavaScriptLibrary   TechCategory = "javascript_library"
+	TechCategoryStaticSiteGenerator TechCategory = "static_site_generator"
+	TechCategoryAnalytics           TechCategory = "analytics"
+	TechCategoryTagManager          TechCategory = "tag_manager"
+	TechCategoryCDN                 TechCategory = "cdn"
+	TechCategoryWebServer           TechCategory = "web_server"
+	TechCategoryProgrammingLanguage TechCategory = "programming_language"
+	TechCategoryHosting             TechCategory = "hosting"
+	TechCategoryCache               TechCategory = "cache"
+
+	TrackerCategoryAnalytics      TrackerCategory = "analytics"
+	TrackerCategoryAdvertising    TrackerCategory = "advertising"
+	TrackerCategorySocial         TrackerCategory = "social"
+	TrackerCategoryFingerprinting TrackerCategory = "fingerprinting"
+
+	ThirdPartyScript     ThirdPartyResourceType = "script"
+	ThirdPartyIframe     ThirdPartyResourceType = "iframe"
+	ThirdPartyImage      ThirdPartyResourceType = "image"
+	ThirdPartyPixel      ThirdPartyResourceType = "pixel"
+	ThirdPartyPreconnect ThirdPartyResourceType = "preconnect"
+
+	PrivacyIssueTrackersWithoutConsent = "trackers_without_consent"
+
+	LinkIssueRedirectLoop      = "redirect_loop"
+	LinkIssueHTTPSDowngrade    = "https_downgrade"
+	LinkIssueLongRedirectChain = "long_redirect_chain"
+	LinkIssueSoft404           = "soft_404"
+	LinkIssueDanglingAnchor    = "dangling_anchor"
+	LinkIssueRobotsDisallowed  = "robots_disallowed"
+
+	ResourceTypeScript     ResourceType = "script"
+	ResourceTypeStylesheet ResourceType = "stylesheet"
+	ResourceTypeImage      ResourceType = "image"
+	ResourceTypeFont       ResourceType = "font"
+	ResourceTypeVideo      ResourceType = "video"
+	ResourceTypeAudio      ResourceType = "audio"
+
+	ImpactLow    Impact = "low"
+	ImpactMedium Impact = "medium"
+	ImpactHigh   Impact = "high"
+
+	PerfHintRenderBlockingScript     = "render_blocking_script"
+	PerfHintRenderBlockingStylesheet = "render_blocking_stylesheet"
+	PerfHintImageMissingDimensions   = "image_missing_dimensions"
+	PerfHintMissingPreconnect        = "missing_preconnect"
+	PerfHintLargeInlineScripts       = "large_inline_scripts"
+	PerfHintLargeInlineStyles        = "large_inline_styles"
+	PerfHintUncompressedResponse     = "uncompressed_response"
+	PerfHintMissingCacheHeaders      = "missing_cache_headers"
+	PerfHintLargeHTML                = "large_html"
+
+	GradeA Grade = "A"
+	GradeB Grade = "B"
+	GradeC Grade = "C"
+	GradeD Grade = "D"
+	GradeF Grade = "F"
+
+	SecurityCheckHSTS                      = "strict_transport_security"
+	SecurityCheckContentSecurityPolicy     = "content_security_policy"
+	SecurityCheckFraming                   = "framing"
+	SecurityCheckReferrerPolicy            = "referrer_policy"
+	SecurityCheckPermissionsPolicy         = "permissions_policy"
+	SecurityCheckCrossOriginOpenerPolicy   = "cross_origin_opener_policy"
+	SecurityCheckCrossOriginEmbedderPolicy = "cross_origin_embedder_policy"
+	SecurityCheckCookies                   = "cookies"
+
+	SecurityIssueHeaderMissing              = "header_missing"
+	SecurityIssueHeaderInvalid              = "header_invalid"
+	SecurityIssueNotHTTPS                   = "not_https"
+	SecurityIssueHSTSShortMaxAge            = "hsts_short_max_age"
+	SecurityIssueHSTSNoIncludeSubDomains    = "hsts_no_include_subdomains"
+	SecurityIssueHSTSNotPreloaded           = "hsts_not_preloaded"
+	SecurityIssueCSPReportOnly              = "csp_report_only"
+	SecurityIssueCSPUnsafeInline            = "csp_unsafe_inline"
+	SecurityIssueCSPUnsafeEval              = "csp_unsafe_eval"
+	SecurityIssueCSPWildcardSource          = "csp_wildcard_source"
+	SecurityIssueCSPMissingScriptPolicy     = "csp_missing_script_policy"
+	SecurityIssueFramingWildcard            = "frame_ancestors_wildcard"
+	SecurityIssueFramingAllowFrom           = "x_frame_options_allow_from"
+	SecurityIssueReferrerLeaksURL           = "referrer_policy_leaks_url"
+	SecurityIssueReferrerLeaksOrigin        = "referrer_policy_leaks_origin"
+	SecurityIssuePermissionsWildcard        = "permissions_policy_wildcard"
+	SecurityIssueCrossOriginUnsafeNone      = "cross_origin_unsafe_none"
+	SecurityIssueCookieMissingSecure        = "cookie_missing_secure"
+	SecurityIssueCookieMissingHTTPOnly      = "cookie_missing_httponly"
+	SecurityIssueCookieMissingSameSite      = "cookie_missing_samesite"
+	SecurityIssueCookieSameSiteNoneInsecure = "cookie_samesite_none_insecure"
+
+	MixedContentActive  MixedContentKind = "active"
+	MixedContentPassive MixedContentKind = "passive"
+	MixedContentForm    MixedContentKind = "form"
+
+	FormIssueInsecureAction       = "form_insecure_action"
+	FormIssuePasswordOnHTTP       = "form_password_on_http"
+	FormIssuePasswordAutocomplete = "form_password_autocomplete"
+	FormIssueMissingCSRFToken     = "form_missing_csrf_token"
+	FormIssueUnlabeledField       = "form_unlabeled_field"
+	FormIssueUnrestrictedUpload   = "form_unrestricted_file_upload"
+	FormIssueValidationDisabled   = "form_validation_disabled"
+	FormIssueMissingValidation    = "form_missing_validation"
+
+	FormKindLogin         FormKind = "login"
+	FormKindSignup        FormKind = "signup"
+	FormKindPasswordReset FormKind = "password_reset"
+	FormKindSearch        FormKind = "search"
+	FormKindNewsletter    FormKind = "newsletter"
+	FormKindPayment       FormKind = "payment"
+	FormKindUnknown       FormKind = "unknown"
+
+	LinkRegionHeader     LinkRegion = "header"
+	LinkRegionNavigation LinkRegion = "navigation"
+	LinkRegionContent    LinkRegion = "content"
+	LinkRegionSidebar    LinkRegion = "sidebar"
+	LinkRegionFooter     LinkRegion = "footer"
+
+	EncodingSourceContentType EncodingSource = "content_type"
+	EncodingSourceBOM         EncodingSource = "bom"
+	EncodingSourceMeta        EncodingSource = "meta"
+	EncodingSourceSniffed     EncodingSource = "sniffed"
+	EncodingSourceDefault     EncodingSource = "default"
 )
 
 type (
 	AnalysisStatus  string
 	HTMLVersion     string
 	LinkType        string
+	LinkScope       string
 	FormMethod      string
 	Event           string
 	OutboxStatus    string
 	Priority        string
 	OutboxEventType string
+	Severity        string
+
+	StructuredDataFormat string
+	TechCategory         string
+
+	TrackerCategory        string
+	ThirdPartyResourceType string
+	ResourceType           string
+	Impact                 string
+	Grade                  string
+	MixedContentKind       string
+	FormKind               string
+	LinkRegion             string
+	EncodingSource         string
 
 	Analysis struct {
 		ID          uuid.UUID      `json:"analysis_id"`
@@ -70,13 +256,23 @@ type (
 	}
 
 	AnalysisData struct {
-		HTMLVersion    HTMLVersion   `json:"html_version"`
-		Title          string        `json:"title"`
-		HeadingCounts  HeadingCounts `json:"heading_counts"`
-		Links          LinkAnalysis  `json:"links"`
-		Forms          FormAnalysis  `json:"forms"`
-		FetchTime      uint64        `json:"fetch_time"`
-		ProcessingTime uint64        `json:"processing_time"`
+		HTMLVersion    HTMLVersion               `json:"html_version"`
+		Title          string                    `json:"title"`
+		HeadingCounts  HeadingCounts             `json:"heading_counts"`
+		HeadingOutline *HeadingOutline           `json:"heading_outline,omitempty"`
+		Links          LinkAnalysis              `json:"links"`
+		Resources      *ResourceInventory        `json:"resources,omitempty"`
+		MixedContent   *MixedContentReport       `json:"mixed_content,omitempty"`
+		Forms          FormAnalysis              `json:"forms"`
+		Meta           *MetaAnalysis             `json:"meta,omitempty"`
+		StructuredData []StructuredDataItem      `json:"structured_data,omitempty"`
+		Accessibility  *AccessibilityAnalysis    `json:"accessibility,omitempty"`
+		Analyzers      map[string]AnalyzerResult `json:"analyzers,omitempty"`
+		Performance    *PerformanceReport        `json:"performance,omitempty"`
+		Robots         *RobotsVerdict            `json:"robots,omitempty"`
+		Encoding       *PageEncoding             `json:"encoding,omitempty"`
+		FetchTime      uint64                    `json:"fetch_time"`
+		ProcessingTime uint64                    `json:"processing_time"`
 	}
 
 	HeadingCounts struct {
@@ -88,24 +284,197 @@ type (
 		H6 int `json:"h6"`
 	}
 
+	// HeadingOutline is the document outline derived from the h1-h6 elements.
+	HeadingOutline struct {
+		Headings []HeadingNode `json:"headings"`
+		Issues   []Finding     `json:"issues"`
+	}
+
+	// HeadingNode is a heading together with the lower level headings nested under it.
+	// Position is the zero based index of the heading in document order.
+	HeadingNode struct {
+		Level    int           `json:"level"`
+		Text     string        `json:"text"`
+		Position int           `json:"position"`
+		Children []HeadingNode `json:"children,omitempty"`
+	}
+
 	LinkAnalysis struct {
 		InternalCount     int                `json:"internal_count"`
 		ExternalCount     int                `json:"external_count"`
 		TotalCount        int                `json:"total_count"`
+		RegionCounts      map[LinkRegion]int `json:"region_counts"`
 		ExternalLinks     []Link             `json:"-"` // Not serialized to JSON
+		Links             []Link             `json:"-"` // Persisted apart from the results
 		InaccessibleLinks []InaccessibleLink `json:"inaccessible_links"`
+		LinkIssues        []LinkIssue        `json:"link_issues"`
+	}
+
+	// ResourceInventory lists the subresources a page depends on. InaccessibleResources is only
+	// filled when the analysis was asked to check resources.
+	ResourceInventory struct {
+		TotalCount            int                  `json:"total_count"`
+		InternalCount         int                  `json:"internal_count"`
+		ExternalCount         int                  `json:"external_count"`
+		CountsByType          map[ResourceType]int `json:"counts_by_type"`
+		Resources             []Resource           `json:"resources"`
+		InaccessibleResources []InaccessibleLink   `json:"inaccessible_resources"`
 	}
 
+	Resource struct {
+		URL          string       `json:"url"`
+		Type         ResourceType `json:"type"`
+		Origin       LinkType     `json:"origin"`
+		Async        bool         `json:"async,omitempty"`
+		Defer        bool         `json:"defer,omitempty"`
+		LazyLoaded   bool         `json:"lazy_loaded,omitempty"`
+		HasIntegrity bool         `json:"has_integrity"`
+	}
+
+	// InaccessibleLink is a link the link checker could not reach. Scope tells whether it was
+	// checked as an internal or an external link, and Cached whether the outcome was taken from
+	// an earlier check shared through the link check cache.
 	InaccessibleLink struct {
+		URL        string   `json:"url"`
+		StatusCode int      `json:"status_code"`
+		Error      string   `json:"error"`
+		Scope      LinkType `json:"scope"`
+		Cached     bool     `json:"cached"`
+	}
+
+	// LinkIssue is a link that can be reached but redirects badly, serves a not found page with
+	// a success status or points at a fragment the target document does not have. Text is the
+	// anchor text of the link.
+	LinkIssue struct {
+		URL       string         `json:"url"`
+		Code      string         `json:"code"`
+		Severity  Severity       `json:"severity"`
+		Message   string         `json:"message"`
+		Scope     LinkType       `json:"scope"`
+		Text      string         `json:"text,omitempty"`
+		Redirects *RedirectChain `json:"redirects,omitempty"`
+	}
+
+	// RedirectChain lists the redirects followed from a link, in order, up to the URL the check
+	// ended on.
+	RedirectChain struct {
+		Hops     []RedirectHop `json:"hops"`
+		HopCount int           `json:"hop_count"`
+		FinalURL string        `json:"final_url"`
+	}
+
+	// RedirectHop is a single redirect: the URL requested, the redirect status it answered with
+	// and the resolved Location it pointed to.
+	RedirectHop struct {
 		URL        string `json:"url"`
 		StatusCode int    `json:"status_code"`
-		Error      string `json:"error"`
+		Location   string `json:"location"`
+	}
+
+	// LinkCheckReport is what the link checker found for a set of links. Results holds the
+	// outcome of every link that was checked.
+	LinkCheckReport struct {
+		InaccessibleLinks []InaccessibleLink
+		Issues            []LinkIssue
+		Results           []LinkCheckResult
+	}
+
+	// LinkCheckResult is the outcome of checking one URL. A zero StatusCode with an Error means
+	// the URL could not be reached at all. MissingFragment is set when the fragment of the URL
+	// matches no element of the document it points at, RobotsDisallowed when robots.txt kept the
+	// URL from being checked, and Cached when the outcome was taken from the link check cache.
+	LinkCheckResult struct {
+		URL              string         `json:"url"`
+		StatusCode       int            `json:"status_code"`
+		Error            string         `json:"error,omitempty"`
+		Redirects        *RedirectChain `json:"redirects,omitempty"`
+		RedirectLoop     bool           `json:"redirect_loop,omitempty"`
+		Soft404Reason    string         `json:"soft_404_reason,omitempty"`
+		MissingFragment  bool           `json:"missing_fragment,omitempty"`
+		RobotsDisallowed bool           `json:"robots_disallowed,omitempty"`
+		Cached           bool           `json:"cached,omitempty"`
+		CheckedAt        time.Time      `json:"checked_at"`
+	}
+
+	// MixedContentReport lists the plain HTTP URLs an HTTPS page loads or submits to. Browsers
+	// block active mixed content outright and may upgrade or block passive mixed content.
+	MixedContentReport struct {
+		ActiveCount  int                `json:"active_count"`
+		PassiveCount int                `json:"passive_count"`
+		FormCount    int                `json:"form_count"`
+		Items        []MixedContentItem `json:"items"`
+	}
+
+	MixedContentItem struct {
+		URL     string           `json:"url"`
+		Element string           `json:"element"`
+		Kind    MixedContentKind `json:"kind"`
 	}
 
 	FormAnalysis struct {
-		TotalCount         int         `json:"total_count"`
-		LoginFormsDetected int         `json:"login_forms_detected"`
-		LoginFormDetails   []LoginForm `json:"login_form_details"`
+		TotalCount         int                  `json:"total_count"`
+		LoginFormsDetected int                  `json:"login_forms_detected"`
+		LoginFormDetails   []LoginForm          `json:"login_form_details"`
+		Forms              []FormDetail         `json:"forms"`
+		Security           []FormSecurity       `json:"security"`
+		Classifications    []FormClassification `json:"classifications"`
+		FederatedProviders []string             `json:"federated_providers"`
+	}
+
+	// FormDetail describes the structure of a single form. Index is the zero based position of the
+	// form in document order, Action the URL it submits to after resolution and Enctype the
+	// encoding its submissions use.
+	FormDetail struct {
+		Index      int         `json:"index"`
+		Method     FormMethod  `json:"method"`
+		Action     string      `json:"action"`
+		Enctype    string      `json:"enctype"`
+		NoValidate bool        `json:"novalidate"`
+		Fields     []FormField `json:"fields"`
+		Findings   []Finding   `json:"findings"`
+	}
+
+	// FormField is a single input, select or textarea of a form together with its validation
+	// constraints. Label is the text of the label the field is announced with, if any.
+	FormField struct {
+		Name         string `json:"name"`
+		Type         string `json:"type"`
+		Required     bool   `json:"required"`
+		Pattern      string `json:"pattern,omitempty"`
+		MinLength    int    `json:"min_length,omitempty"`
+		MaxLength    int    `json:"max_length,omitempty"`
+		Autocomplete string `json:"autocomplete,omitempty"`
+		Label        string `json:"label,omitempty"`
+	}
+
+	// FormClassification labels what a form is for. Confidence runs from 0 to 100 and Signals lists
+	// the evidence behind the label. Standalone classifications describe groups of fields that are
+	// not wrapped in a <form> element; their Index counts those groups separately.
+	FormClassification struct {
+		Index      int      `json:"index"`
+		Kind       FormKind `json:"kind"`
+		Confidence int      `json:"confidence"`
+		Signals    []string `json:"signals"`
+		Standalone bool     `json:"standalone"`
+	}
+
+	// FormSecurity is the security assessment of a single form. Index is the zero based position
+	// of the form in document order and Action the URL it submits to after resolution.
+	FormSecurity struct {
+		Index            int               `json:"index"`
+		Method           FormMethod        `json:"method"`
+		Action           string            `json:"action"`
+		InsecureAction   bool              `json:"insecure_action"`
+		HasPasswordField bool              `json:"has_password_field"`
+		HasCSRFToken     bool              `json:"has_csrf_token"`
+		CredentialFields []CredentialField `json:"credential_fields"`
+		Findings         []Finding         `json:"findings"`
+	}
+
+	CredentialField struct {
+		Name         string `json:"name"`
+		Type         string `json:"type"`
+		Autocomplete string `json:"autocomplete,omitempty"`
 	}
 
 	LoginForm struct {
@@ -114,6 +483,176 @@ type (
 		Fields []string   `json:"fields"`
 	}
 
+	// MetaAnalysis holds the SEO relevant <meta> and <link> tags of a page.
+	MetaAnalysis struct {
+		Description  string            `json:"description,omitempty"`
+		Keywords     []string          `json:"keywords,omitempty"`
+		Robots       []string          `json:"robots,omitempty"`
+		Viewport     string            `json:"viewport,omitempty"`
+		Charset      string            `json:"charset,omitempty"`
+		CanonicalURL string            `json:"canonical_url,omitempty"`
+		Hreflang     []HreflangLink    `json:"hreflang,omitempty"`
+		OpenGraph    map[string]string `json:"open_graph,omitempty"`
+		TwitterCard  map[string]string `json:"twitter_card,omitempty"`
+		Issues       []Finding         `json:"issues"`
+	}
+
+	HreflangLink struct {
+		Lang string `json:"lang"`
+		URL  string `json:"url"`
+	}
+
+	// StructuredDataItem is a single top level schema.org style entity found in the page.
+	StructuredDataItem struct {
+		Format     StructuredDataFormat `json:"format"`
+		Types      []string             `json:"types"`
+		Properties map[string]any       `json:"properties"`
+		Errors     []string             `json:"errors,omitempty"`
+	}
+
+	// AccessibilityAnalysis is the outcome of the static WCAG oriented checks.
+	AccessibilityAnalysis struct {
+		Findings []Finding `json:"findings"`
+	}
+
+	// Finding is a single problem reported by one of the analysis checks.
+	// WCAG and Selector are only set by checks tied to a success criterion or an element.
+	Finding struct {
+		Code     string   `json:"code"`
+		Severity Severity `json:"severity"`
+		Message  string   `json:"message"`
+		WCAG     string   `json:"wcag,omitempty"`
+		Selector string   `json:"selector,omitempty"`
+	}
+
+	// TechStack lists the technologies fingerprinted on a page and the signature
+	// database release they were matched against.
+	TechStack struct {
+		SignaturesVersion string       `json:"signatures_version"`
+		Technologies      []Technology `json:"technologies"`
+	}
+
+	// Technology is a single fingerprinted technology. Confidence is a 0-100 score and
+	// Evidence names the inputs that matched, such as "header:server" or "script".
+	Technology struct {
+		Name       string       `json:"name"`
+		Category   TechCategory `json:"category"`
+		Version    string       `json:"version,omitempty"`
+		Confidence int          `json:"confidence"`
+		Evidence   []string     `json:"evidence"`
+	}
+
+	// ThirdPartyInventory lists every third-party registrable domain (eTLD+1) a page loads
+	// resources from, classified against the tracker list release named by TrackerListVersion.
+	ThirdPartyInventory struct {
+		TrackerListVersion string             `json:"tracker_list_version"`
+		Domains            []ThirdPartyDomain `json:"domains"`
+		TrackerCount       int                `json:"tracker_count"`
+		ConsentManager     string             `json:"consent_manager,omitempty"`
+		Issues             []Finding          `json:"issues"`
+	}
+
+	// ThirdPartyDomain groups the resources loaded from one registrable domain. Categories is
+	// empty for domains that are not on the tracker list.
+	ThirdPartyDomain struct {
+		Domain     string               `json:"domain"`
+		Company    string               `json:"company,omitempty"`
+		Categories []TrackerCategory    `json:"categories"`
+		Tracker    bool                 `json:"tracker"`
+		Hosts      []string             `json:"hosts"`
+		Resources  []ThirdPartyResource `json:"resources"`
+	}
+
+	ThirdPartyResource struct {
+		URL  string                 `json:"url"`
+		Type ThirdPartyResourceType `json:"type"`
+	}
+
+	// PerformanceReport is a static estimate of the page load cost built from the markup and the
+	// response headers alone; none of the referenced resources are fetched.
+	PerformanceReport struct {
+		HTMLBytes                 int               `json:"html_bytes"`
+		TransferredBytes          int               `json:"transferred_bytes,omitempty"`
+		ContentEncoding           string            `json:"content_encoding,omitempty"`
+		Compressed                bool              `json:"compressed"`
+		Cache                     CacheHeaders      `json:"cache"`
+		InlineScriptBytes         int               `json:"inline_script_bytes"`
+		InlineStyleBytes          int               `json:"inline_style_bytes"`
+		RenderBlockingScripts     []string          `json:"render_blocking_scripts"`
+		RenderBlockingStylesheets []string          `json:"render_blocking_stylesheets"`
+		ImagesWithoutDimensions   int               `json:"images_without_dimensions"`
+		Hints                     []PerformanceHint `json:"hints"`
+	}
+
+	// PageEncoding is the character encoding a page was decoded with before it was analysed.
+	// Declared is the encoding the Content-Type header names, or else the <meta> tag of the page,
+	// and Mismatch is set when the header, the byte order mark, the <meta> tag and the bytes of the
+	// page do not agree on the encoding.
+	PageEncoding struct {
+		Detected      string         `json:"detected"`
+		Source        EncodingSource `json:"source"`
+		Declared      string         `json:"declared,omitempty"`
+		HeaderCharset string         `json:"header_charset,omitempty"`
+		MetaCharset   string         `json:"meta_charset,omitempty"`
+		Mismatch      bool           `json:"mismatch"`
+	}
+
+	CacheHeaders struct {
+		CacheControl string `json:"cache_control,omitempty"`
+		Expires      string `json:"expires,omitempty"`
+		ETag         string `json:"etag,omitempty"`
+		LastModified string `json:"last_modified,omitempty"`
+	}
+
+	// PerformanceHint is a single optimisation opportunity together with its estimated impact.
+	// Resources lists the URLs or origins the hint applies to, when there are any.
+	PerformanceHint struct {
+		Code      string   `json:"code"`
+		Impact    Impact   `json:"impact"`
+		Message   string   `json:"message"`
+		Resources []string `json:"resources,omitempty"`
+	}
+
+	// SecurityHeaderAudit grades the security relevant response headers of a page. Grade is the
+	// average of the individual check grades.
+	SecurityHeaderAudit struct {
+		Grade                   Grade                 `json:"grade"`
+		Checks                  []SecurityHeaderCheck `json:"checks"`
+		HSTS                    *HSTSPolicy           `json:"hsts,omitempty"`
+		ContentSecurityPolicies []CSPPolicy           `json:"content_security_policies,omitempty"`
+		PermissionsPolicy       map[string][]string   `json:"permissions_policy,omitempty"`
+		Cookies                 []CookieFlags         `json:"cookies,omitempty"`
+	}
+
+	// SecurityHeaderCheck is the outcome of one check. Values holds every raw value of the
+	// headers the check looked at, so repeated headers are reported in full.
+	SecurityHeaderCheck struct {
+		Name     string    `json:"name"`
+		Present  bool      `json:"present"`
+		Values   []string  `json:"values,omitempty"`
+		Grade    Grade     `json:"grade"`
+		Findings []Finding `json:"findings"`
+	}
+
+	HSTSPolicy struct {
+		MaxAge            int64 `json:"max_age"`
+		IncludeSubDomains bool  `json:"include_subdomains"`
+		Preload           bool  `json:"preload"`
+	}
+
+	// CSPPolicy is a single Content-Security-Policy header value split into its directives.
+	CSPPolicy struct {
+		ReportOnly bool                `json:"report_only"`
+		Directives map[string][]string `json:"directives"`
+	}
+
+	CookieFlags struct {
+		Name     string `json:"name"`
+		Secure   bool   `json:"secure"`
+		HTTPOnly bool   `json:"http_only"`
+		SameSite string `json:"same_site,omitempty"`
+	}
+
 	AnalysisError struct {
 		Code       string `json:"code"`
 		Message    string `json:"message"`
@@ -124,19 +663,60 @@ type (
 	AnalysisOptions struct {
 		IncludeHeadings bool          `json:"include_headings"`
 		CheckLinks      bool          `json:"check_links"`
+		LinkScope       LinkScope     `json:"link_scope"`
 		DetectForms     bool          `json:"detect_forms"`
+		IncludeMeta     bool          `json:"include_meta"`
+		Accessibility   bool          `json:"accessibility"`
+		CheckResources  bool          `json:"check_resources"`
+		Analyzers       []string      `json:"analyzers"`
 		Timeout         time.Duration `json:"timeout"`
+		IgnoreRobotsTxt bool          `json:"ignore_robots_txt,omitempty"`
 	}
 
 	//counterfeiter:generate -o ../mocks/html_analyzer.go . HTMLAnalyzer
 
 	HTMLAnalyzer interface {
-		Analyze(ctx context.Context, url, html string, options AnalysisOptions) (*AnalysisData, error)
+		Analyze(ctx context.Context, doc *Document, options AnalysisOptions) (*AnalysisData, error)
 	}
 
+	// Link is a deduplicated hyperlink of a page. Region is the part of the page the first
+	// occurrence of the link sits in, Rel holds its lower cased rel tokens. StatusCode is set for
+	// every link the link checker got an answer from, Error only for those it found inaccessible,
+	// and Cached for those whose outcome was taken from the link check cache.
 	Link struct {
-		URL  string
-		Type LinkType
+		URL        string         `json:"url"`
+		Type       LinkType       `json:"type"`
+		Region     LinkRegion     `json:"region"`
+		Text       string         `json:"text"`
+		Rel        []string       `json:"rel"`
+		Target     string         `json:"target,omitempty"`
+		StatusCode int            `json:"status_code,omitempty"`
+		Error      string         `json:"error,omitempty"`
+		Redirects  *RedirectChain `json:"redirects,omitempty"`
+		Cached     bool           `json:"cached,omitempty"`
+	}
+
+	// LinkFilter narrows down the stored links of an analysis. Zero values match every link.
+	LinkFilter struct {
+		Type       LinkType
+		Region     LinkRegion
+		StatusCode int
+		Host       string
+	}
+
+	// LinkPage is one page of the stored links of an analysis, in document order.
+	LinkPage struct {
+		Links      []Link           `json:"links"`
+		Pagination CursorPagination `json:"pagination"`
+	}
+
+	// CursorPagination describes a page of a cursor paginated listing. NextCursor is passed back
+	// to fetch the following page and is empty on the last one.
+	CursorPagination struct {
+		Limit      int    `json:"limit"`
+		TotalCount int    `json:"total_count"`
+		HasNext    bool   `json:"has_next"`
+		NextCursor string `json:"next_cursor,omitempty"`
 	}
 
 	WebPageContent struct {
@@ -144,8 +724,14 @@ type (
 		StatusCode    int
 		HTML          string
 		ContentType   string
-		Headers       map[string]string
+		Headers       http.Header
 		FetchDuration time.Duration
+		Robots        *RobotsVerdict
+		Encoding      *PageEncoding
+		// TransferredBytes is the size of the body as it was sent, before its Content-Encoding was
+		// decoded, and DecodedBytes its size once decoded, before it was transcoded to UTF-8.
+		TransferredBytes int64
+		DecodedBytes     int64
 	}
 
 	AnalysisEvent struct {
@@ -227,6 +813,79 @@ func (a *Analysis) CheckVersion(expectedVersion int) error {
 	return nil
 }
 
+// Includes reports whether links of the given type are checked in this scope. An empty scope
+// checks external links only.
+func (s LinkScope) Includes(linkType LinkType) bool {
+	switch s {
+	case LinkScopeAll:
+		return true
+	case LinkScopeInternal:
+		return linkType == LinkTypeInternal
+	default:
+		return linkType == LinkTypeExternal
+	}
+}
+
+// RecordLinkChecks keeps the inaccessible links of a link check, adds its link issues to those
+// found on the page itself and copies the status code, redirects and cache origin of every checked
+// link, and the error of every inaccessible one, onto the matching entry of Links.
+func (a *LinkAnalysis) RecordLinkChecks(report LinkCheckReport) {
+	a.InaccessibleLinks = report.InaccessibleLinks
+
+	reported := make(map[string]bool, len(a.LinkIssues))
+	for _, issue := range a.LinkIssues {
+		reported[issue.Code+" "+issue.URL] = true
+	}
+
+	for _, issue := range report.Issues {
+		if !reported[issue.Code+" "+issue.URL] {
+			a.LinkIssues = append(a.LinkIssues, issue)
+		}
+	}
+
+	inaccessible := make(map[string]InaccessibleLink, len(a.InaccessibleLinks))
+	for _, link := range a.InaccessibleLinks {
+		inaccessible[link.URL] = link
+	}
+
+	checked := make(map[string]LinkCheckResult, len(report.Results))
+	for _, result := range report.Results {
+		checked[result.URL] = result
+	}
+
+	for i, link := range a.Links {
+		if result, ok := checked[link.URL]; ok {
+			a.Links[i].StatusCode = result.StatusCode
+			a.Links[i].Cached = result.Cached
+		}
+
+		if failure, ok := inaccessible[link.URL]; ok {
+			a.Links[i].StatusCode = failure.StatusCode
+			a.Links[i].Error = failure.Error
+		}
+
+		a.Links[i].Redirects = checked[link.URL].Redirects
+	}
+}
+
+// Accessible reports whether the URL answered without an error status.
+func (r LinkCheckResult) Accessible() bool {
+	return r.Error == "" && r.StatusCode < http.StatusBadRequest
+}
+
+// Links returns the resources as links so they can be handed to the link checker.
+func (r *ResourceInventory) Links() []Link {
+	links := make([]Link, 0, len(r.Resources))
+	for _, resource := range r.Resources {
+		links = append(links, Link{
+			URL:  resource.URL,
+			Type: resource.Origin,
+		})
+	}
+
+	return links
+}
+
 func (e *OutboxEvent) MarkPublished(publishedAt time.Time) error {
 	if e.Status != OutboxStatusProcessing {
 
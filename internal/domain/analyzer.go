@@ -0,0 +1,269 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	ErrUnknownAnalyzer    = errors.New("unknown analyzer")
+	ErrDuplicateAnalyzer  = errors.New("duplicate analyzer")
+	ErrAnalyzerDependency = errors.New("invalid analyzer dependency")
+)
+
+type (
+	//counterfeiter:generate -o ../mocks/analyzer.go . Analyzer
+
+	// Analyzer is a self-contained check that runs against a fetched page. Its output is stored
+	// under its own name in the analysis results, so adding one needs no schema change.
+	Analyzer interface {
+		// Name is the unique key clients select the analyzer by and its output is stored under.
+		Name() string
+		// Version is bumped whenever the shape or semantics of the output change.
+		Version() string
+		// Dependencies names the analyzers that must run first; their outputs are available
+		// through Document.Result.
+		Dependencies() []string
+		Run(ctx context.Context, doc *Document) (any, error)
+	}
+
+	// Document is the page handed to every analyzer. It is parsed once and shared, so analyzers
+	// must treat it as read-only.
+	Document struct {
+		URL         string
+		StatusCode  int
+		ContentType string
+		Headers     http.Header
+		HTML        string
+		Root        *html.Node
+		// TransferredBytes and DecodedBytes are the sizes of the body as it was sent and once its
+		// Content-Encoding was decoded, before HTML was transcoded to UTF-8.
+		TransferredBytes int64
+		DecodedBytes     int64
+
+		results map[string]any
+	}
+
+	AnalyzerResult struct {
+		Version string `json:"version"`
+		Output  any    `json:"output,omitempty"`
+		Error   string `json:"error,omitempty"`
+	}
+
+	// AnalyzerRegistry holds the available analyzers and runs a selection of them in dependency order.
+	AnalyzerRegistry struct {
+		analyzers map[string]Analyzer
+		order     []string
+	}
+)
+
+func NewDocument(content *WebPageContent) (*Document, error) {
+	root, err := html.Parse(strings.NewReader(content.HTML))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	return &Document{
+		URL:              content.URL,
+		StatusCode:       content.StatusCode,
+		ContentType:      content.ContentType,
+		Headers:          content.Headers,
+		HTML:             content.HTML,
+		Root:             root,
+		TransferredBytes: content.TransferredBytes,
+		DecodedBytes:     content.DecodedBytes,
+		results:          make(map[string]any),
+	}, nil
+}
+
+// Result returns the output of an analyzer that already ran against this document.
+func (d *Document) Result(name string) (any, bool) {
+	output, ok := d.results[name]
+
+	return output, ok
+}
+
+// NewAnalyzerRegistry validates that analyzer names are unique and that every dependency
+// is registered without forming a cycle.
+func NewAnalyzerRegistry(analyzers ...Analyzer) (*AnalyzerRegistry, error) {
+	registry := &AnalyzerRegistry{
+		analyzers: make(map[string]Analyzer, len(analyzers)),
+	}
+
+	for _, analyzer := range analyzers {
+		name := analyzer.Name()
+		if _, exists := registry.analyzers[name]; exists {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateAnalyzer, name)
+		}
+
+		registry.analyzers[name] = analyzer
+	}
+
+	names := registry.Names()
+
+	order, err := registry.resolve(names)
+	if err != nil {
+		return nil, err
+	}
+
+	registry.order = order
+
+	return registry, nil
+}
+
+// Names returns the registered analyzer names in lexical order.
+func (r *AnalyzerRegistry) Names() []string {
+	names := make([]string, 0, len(r.analyzers))
+	for name := range r.analyzers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Resolve returns the analyzers to run for the requested names, dependencies included and
+// ordered so that every analyzer comes after the ones it depends on. A nil selection means
+// every registered analyzer.
+func (r *AnalyzerRegistry) Resolve(names []string) ([]Analyzer, error) {
+	if names == nil {
+		names = r.order
+	}
+
+	order, err := r.resolve(names)
+	if err != nil {
+		return nil, err
+	}
+
+	analyzers := make([]Analyzer, 0, len(order))
+	for _, name := range order {
+		analyzers = append(analyzers, r.analyzers[name])
+	}
+
+	return analyzers, nil
+}
+
+// Run executes the selected analyzers against the document. A failing analyzer is reported
+// in its own result and skips the analyzers depending on it, without affecting the others.
+func (r *AnalyzerRegistry) Run(ctx context.Context, doc *Document, names []string) (map[string]AnalyzerResult, error) {
+	analyzers, err := r.Resolve(names)
+	if err != nil {
+		return nil, err
+	}
+
+	if doc.results == nil {
+		doc.results = make(map[string]any)
+	}
+
+	results := make(map[string]AnalyzerResult, len(analyzers))
+
+	for _, analyzer := range analyzers {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		result := AnalyzerResult{Version: analyzer.Version()}
+
+		if failed := failedDependency(analyzer, results); failed != "" {
+			result.Error = fmt.Sprintf("dependency %q failed", failed)
+			results[analyzer.Name()] = result
+
+			continue
+		}
+
+		output, err := runAnalyzer(ctx, analyzer, doc)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Output = output
+			doc.results[analyzer.Name()] = output
+		}
+
+		results[analyzer.Name()] = result
+	}
+
+	return results, nil
+}
+
+func (r *AnalyzerRegistry) resolve(names []string) ([]string, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(r.analyzers))
+	order := make([]string, 0, len(names))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		analyzer, ok := r.analyzers[name]
+		if !ok {
+			if len(path) == 0 {
+				return fmt.Errorf("%w: %q", ErrUnknownAnalyzer, name)
+			}
+
+			return fmt.Errorf("%w: %q depends on unknown analyzer %q", ErrAnalyzerDependency, path[len(path)-1], name)
+		}
+
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("%w: cycle %s", ErrAnalyzerDependency, strings.Join(append(path, name), " -> "))
+		}
+
+		state[name] = visiting
+
+		dependencies := slices.Clone(analyzer.Dependencies())
+		sort.Strings(dependencies)
+
+		for _, dependency := range dependencies {
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+		order = append(order, name)
+
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+func failedDependency(analyzer Analyzer, results map[string]AnalyzerResult) string {
+	for _, dependency := range analyzer.Dependencies() {
+		if results[dependency].Error != "" {
+			return dependency
+		}
+	}
+
+	return ""
+}
+
+// runAnalyzer shields the analysis from a panicking analyzer, reporting it as that analyzer's error.
+func runAnalyzer(ctx context.Context, analyzer Analyzer, doc *Document) (output any, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("analyzer panicked: %v", recovered)
+		}
+	}()
+
+	return analyzer.Run(ctx, doc)
+}
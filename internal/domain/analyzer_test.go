@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubAnalyzer struct {
+	name         string
+	dependencies []string
+	run          func(ctx context.Context, doc *Document) (any, error)
+}
+
+func (a stubAnalyzer) Name() string           { return a.name }
+func (a stubAnalyzer) Version() string        { return "1.0.0" }
+func (a stubAnalyzer) Dependencies() []string { return a.dependencies }
+
+func (a stubAnalyzer) Run(ctx context.Context, doc *Document) (any, error) {
+	if a.run == nil {
+		return a.name, nil
+	}
+
+	return a.run(ctx, doc)
+}
+
+func analyzerNames(analyzers []Analyzer) []string {
+	names := make([]string, 0, len(analyzers))
+	for _, analyzer := range analyzers {
+		names = append(names, analyzer.Name())
+	}
+
+	return names
+}
+
+func TestNewAnalyzerRegistry(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		analyzers []Analyzer
+		wantErr   error
+	}{
+		{
+			name: "valid dependency graph",
+			analyzers: []Analyzer{
+				stubAnalyzer{name: "a"},
+				stubAnalyzer{name: "b", dependencies: []string{"a"}},
+			},
+		},
+		{
+			name: "duplicate name",
+			analyzers: []Analyzer{
+				stubAnalyzer{name: "a"},
+				stubAnalyzer{name: "a"},
+			},
+			wantErr: ErrDuplicateAnalyzer,
+		},
+		{
+			name: "unknown dependency",
+			analyzers: []Analyzer{
+				stubAnalyzer{name: "a", dependencies: []string{"missing"}},
+			},
+			wantErr: ErrAnalyzerDependency,
+		},
+		{
+			name: "dependency cycle",
+			analyzers: []Analyzer{
+				stubAnalyzer{name: "a", dependencies: []string{"c"}},
+				stubAnalyzer{name: "b", dependencies: []string{"a"}},
+				stubAnalyzer{name: "c", dependencies: []string{"b"}},
+			},
+			wantErr: ErrAnalyzerDependency,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			registry, err := NewAnalyzerRegistry(tc.analyzers...)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, registry.Names(), len(tc.analyzers))
+		})
+	}
+}
+
+func TestAnalyzerRegistry_Resolve(t *testing.T) {
+	t.Parallel()
+
+	registry, err := NewAnalyzerRegistry(
+		stubAnalyzer{name: "report", dependencies: []string{"scripts", "headers"}},
+		stubAnalyzer{name: "scripts"},
+		stubAnalyzer{name: "headers"},
+		stubAnalyzer{name: "standalone"},
+	)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		selected []string
+		expected []string
+		wantErr  error
+	}{
+		{
+			name:     "nil selection runs every analyzer",
+			selected: nil,
+			expected: []string{"headers", "scripts", "report", "standalone"},
+		},
+		{
+			name:     "empty selection runs nothing",
+			selected: []string{},
+			expected: []string{},
+		},
+		{
+			name:     "dependencies are pulled in before the dependent",
+			selected: []string{"report"},
+			expected: []string{"headers", "scripts", "report"},
+		},
+		{
+			name:     "duplicates are resolved once",
+			selected: []string{"scripts", "report", "scripts"},
+			expected: []string{"scripts", "headers", "report"},
+		},
+		{
+			name:     "unknown analyzer",
+			selected: []string{"nope"},
+			wantErr:  ErrUnknownAnalyzer,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			analyzers, err := registry.Resolve(tc.selected)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, analyzerNames(analyzers))
+		})
+	}
+}
+
+func TestAnalyzerRegistry_Run(t *testing.T) {
+	t.Parallel()
+
+	registry, err := NewAnalyzerRegistry(
+		stubAnalyzer{name: "title", run: func(ctx context.Context, doc *Document) (any, error) {
+			return doc.URL, nil
+		}},
+		stubAnalyzer{name: "echo", dependencies: []string{"title"}, run: func(ctx context.Context, doc *Document) (any, error) {
+			output, _ := doc.Result("title")
+
+			return output, nil
+		}},
+		stubAnalyzer{name: "broken", run: func(ctx context.Context, doc *Document) (any, error) {
+			return nil, errors.New("boom")
+		}},
+		stubAnalyzer{name: "dependent", dependencies: []string{"broken"}},
+		stubAnalyzer{name: "panicking", run: func(ctx context.Context, doc *Document) (any, error) {
+			panic("unexpected")
+		}},
+	)
+	require.NoError(t, err)
+
+	doc, err := NewDocument(&WebPageContent{URL: "https://example.com", HTML: "<html><body></body></html>"})
+	require.NoError(t, err)
+	require.NotNil(t, doc.Root)
+
+	results, err := registry.Run(context.Background(), doc, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, AnalyzerResult{Version: "1.0.0", Output: "https://example.com"}, results["title"])
+	assert.Equal(t, AnalyzerResult{Version: "1.0.0", Output: "https://example.com"}, results["echo"])
+	assert.Equal(t, AnalyzerResult{Version: "1.0.0", Error: "boom"}, results["broken"])
+	assert.Equal(t, AnalyzerResult{Version: "1.0.0", Error: `dependency "broken" failed`}, results["dependent"])
+	assert.Equal(t, AnalyzerResult{Version: "1.0.0", Error: "analyzer panicked: unexpected"}, results["panicking"])
+}
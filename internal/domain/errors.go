@@ -17,6 +17,10 @@ var (
 	ErrCircuitBreakerOpen     = errors.New("circuit breaker open")
 	ErrCacheUnavailable       = errors.New("cache service unavailable")
 	ErrConcurrentModification = errors.New("concurrent modification detected")
+	ErrInvalidCursor          = errors.New("invalid pagination cursor")
+	ErrInvalidPageLimit       = errors.New("invalid pagination limit")
+	ErrRobotsDisallowed       = errors.New("disallowed by robots.txt")
+	ErrAddressNotAllowed      = errors.New("address is not allowed")
 )
 
 type (
@@ -98,6 +102,24 @@ func NewTimeoutError(url string, timeout interface{}) *DomainError {
 	).WithDetails("url", url).WithDetails("timeout", timeout)
 }
 
+func NewRobotsDisallowedError(url, rule string) *DomainError {
+	return NewDomainError(
+		"ROBOTS_DISALLOWED",
+		fmt.Sprintf("Fetching %s is disallowed by robots.txt", url),
+		403,
+		ErrRobotsDisallowed,
+	).WithDetails("url", url).WithDetails("rule", rule)
+}
+
+func NewAddressNotAllowedError(url string, cause error) *DomainError {
+	return NewDomainError(
+		"ADDRESS_NOT_ALLOWED",
+		fmt.Sprintf("Fetching %s would reach a private or local network", url),
+		403,
+		cause,
+	).WithDetails("url", url)
+}
+
 func NewRateLimitError(message string) *DomainError {
 	return NewDomainError(
 		"RATE_LIMITING_EXCEEDED",
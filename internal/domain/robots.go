@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	robotsTxtIgnoredKey struct{}
+
+	// RobotsTxt is the robots.txt of an origin as it was fetched. A zero StatusCode means the
+	// file could not be fetched at all.
+	RobotsTxt struct {
+		Origin     string    `json:"origin"`
+		StatusCode int       `json:"status_code"`
+		Content    string    `json:"content"`
+		FetchedAt  time.Time `json:"fetched_at"`
+	}
+
+	// RobotsVerdict is what the robots.txt of an origin says about fetching a URL as our user
+	// agent. Rule is the directive that decided it, CrawlDelay the delay in seconds the origin asks
+	// for and Wait that delay capped at what we are willing to wait. Overridden is set when an admin
+	// asked to ignore robots.txt.
+	RobotsVerdict struct {
+		Allowed    bool          `json:"allowed"`
+		Rule       string        `json:"rule,omitempty"`
+		CrawlDelay float64       `json:"crawl_delay,omitempty"`
+		Sitemaps   []string      `json:"sitemaps"`
+		Overridden bool          `json:"overridden,omitempty"`
+		Wait       time.Duration `json:"-"`
+	}
+)
+
+// WithRobotsTxtIgnored exempts the requests made on behalf of ctx from robots.txt. Only analyses
+// requested with an admin token may ask for it.
+func WithRobotsTxtIgnored(ctx context.Context) context.Context {
+	return context.WithValue(ctx, robotsTxtIgnoredKey{}, true)
+}
+
+// RobotsTxtIgnored reports whether the requests made on behalf of ctx may ignore robots.txt.
+func RobotsTxtIgnored(ctx context.Context) bool {
+	ignored, _ := ctx.Value(robotsTxtIgnoredKey{}).(bool)
+
+	return ignored
+}
@@ -3,8 +3,12 @@ package domain
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 )
 
@@ -16,8 +20,15 @@ type (
 	ContentHash struct {
 		value string
 	}
+
+	ReuseKey struct {
+		value string
+	}
 )
 
+// volatileHeaders change with every response without any analyzer reading them.
+var volatileHeaders = []string{"Age", "Date"}
+
 func NewNormalizedURL(rawURL string) (*NormalizedURL, error) {
 	parsedURL, err := url.Parse(rawURL)
 	if err != nil {
@@ -61,3 +72,46 @@ func (h *ContentHash) String() string {
 
 	return h.value
 }
+
+// NewReuseKey identifies everything the results of an analysis depend on: the page's normalized
+// URL, its response headers other than the volatile ones, its content and the options it was
+// requested with, apart from the timeout. Results are only reused between analyses with the same
+// key.
+func NewReuseKey(content *WebPageContent, options AnalysisOptions) *ReuseKey {
+	pageURL := content.URL
+	if normalizedURL, err := NewNormalizedURL(content.URL); err == nil {
+		pageURL = normalizedURL.String()
+	}
+
+	// An empty analyzer selection runs none, unlike an omitted one.
+	analyzers := slices.Clone(options.Analyzers)
+	slices.Sort(analyzers)
+
+	options.Timeout = 0
+	options.Analyzers = slices.Compact(analyzers)
+
+	// Marshalling options of fixed fields cannot fail.
+	optionsJSON, _ := json.Marshal(options)
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n", pageURL, optionsJSON)
+
+	for _, name := range slices.Sorted(maps.Keys(content.Headers)) {
+		if slices.Contains(volatileHeaders, http.CanonicalHeaderKey(name)) {
+			continue
+		}
+
+		for _, value := range content.Headers[name] {
+			fmt.Fprintf(hash, "%s: %s\n", name, value)
+		}
+	}
+
+	fmt.Fprintf(hash, "\n%s", content.HTML)
+
+	return &ReuseKey{value: hex.EncodeToString(hash.Sum(nil))}
+}
+
+func (k *ReuseKey) String() string {
+
+	return k.value
+}
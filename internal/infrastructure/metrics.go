@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/architeacher/svc-web-analyzer/internal/config"
@@ -32,6 +33,8 @@ type (
 		RecordAnalysisRequest(ctx context.Context, duration time.Duration, success bool, errorType string)
 		RecordOutboxEvent(ctx context.Context, success bool, priority string)
 		RecordLinkCheck(ctx context.Context, success bool, linkType string)
+		RecordLinkCheckerBreakerState(ctx context.Context, host, state string)
+		RecordLinkCheckCache(ctx context.Context, hit bool)
 		RecordFetchTime(ctx context.Context, duration time.Duration)
 		RecordProcessingTime(ctx context.Context, duration time.Duration)
 		Handler() http.Handler
@@ -54,8 +57,15 @@ type (
 		outboxErrorTotal        metric.Int64Counter
 		linkCheckTotal          metric.Int64Counter
 		linkCheckErrorTotal     metric.Int64Counter
+		linkCheckerBreakerState metric.Int64ObservableGauge
+		linkCheckCacheTotal     metric.Int64Counter
 		fetchTimeDuration       metric.Float64Histogram
 		processingTimeDuration  metric.Float64Histogram
+
+		// breakerStates holds the hosts whose link checker circuit breaker is not closed, so that
+		// the breaker state gauge only reports those.
+		breakerStates   map[string]int64
+		breakerStatesMu sync.Mutex
 	}
 )
 
@@ -115,6 +125,7 @@ func NewOTELMetrics(ctx context.Context, cfg config.ServiceConfig, logger Logger
 		meterProvider: meterProvider,
 		meter:         meter,
 		logger:        logger,
+		breakerStates: make(map[string]int64),
 	}
 
 	if err := provider.initializeMetrics(); err != nil {
@@ -230,6 +241,25 @@ func (om *OTELMetrics) initializeMetrics() error {
 		return fmt.Errorf("failed to create link_check_errors_total counter: %w", err)
 	}
 
+	om.linkCheckerBreakerState, err = om.meter.Int64ObservableGauge(
+		"link_checker_circuit_breaker_state",
+		metric.WithDescription("State of the link checker circuit breakers that are not closed, by host: 1 half-open, 2 open"),
+		metric.WithUnit("{state}"),
+		metric.WithInt64Callback(om.observeBreakerStates),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create link_checker_circuit_breaker_state gauge: %w", err)
+	}
+
+	om.linkCheckCacheTotal, err = om.meter.Int64Counter(
+		"link_check_cache_lookups_total",
+		metric.WithDescription("Total number of link check cache lookups by result"),
+		metric.WithUnit("{lookup}"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create link_check_cache_lookups_total counter: %w", err)
+	}
+
 	om.fetchTimeDuration, err = om.meter.Float64Histogram(
 		"fetch_time_seconds",
 		metric.WithDescription("Time spent fetching web page content in seconds"),
@@ -345,6 +375,51 @@ func (om *OTELMetrics) RecordLinkCheck(ctx context.Context, success bool, linkTy
 	}
 }
 
+// RecordLinkCheckerBreakerState keeps the state of the breaker of a host until it closes. A closed
+// breaker, including that of a host the link checker stops tracking, drops the series of the host,
+// so the gauge has at most one series per tracked host with a tripped breaker.
+func (om *OTELMetrics) RecordLinkCheckerBreakerState(_ context.Context, host, state string) {
+	om.breakerStatesMu.Lock()
+	defer om.breakerStatesMu.Unlock()
+
+	switch state {
+	case "half-open":
+		om.breakerStates[host] = 1
+	case "open":
+		om.breakerStates[host] = 2
+	default:
+		delete(om.breakerStates, host)
+	}
+}
+
+func (om *OTELMetrics) observeBreakerStates(_ context.Context, observer metric.Int64Observer) error {
+	om.breakerStatesMu.Lock()
+	defer om.breakerStatesMu.Unlock()
+
+	for host, value := range om.breakerStates {
+		observer.Observe(value,
+			metric.WithAttributes(
+				HostAttr(host),
+			),
+		)
+	}
+
+	return nil
+}
+
+func (om *OTELMetrics) RecordLinkCheckCache(ctx context.Context, hit bool) {
+	result := "hit"
+	if !hit {
+		result = "miss"
+	}
+
+	om.linkCheckCacheTotal.Add(ctx, 1,
+		metric.WithAttributes(
+			CacheResultAttr(result),
+		),
+	)
+}
+
 func (om *OTELMetrics) RecordFetchTime(ctx context.Context, duration time.Duration) {
 	om.fetchTimeDuration.Record(ctx, duration.Seconds())
 }
@@ -14,6 +14,8 @@ const (
 	errorTypeKey      = "error.type"
 	priorityKey       = "priority"
 	linkTypeKey       = "link.type"
+	hostKey           = "host"
+	cacheResultKey    = "cache.result"
 )
 
 func HTTPMethodAttr(method string) attribute.KeyValue {
@@ -43,3 +45,11 @@ func PriorityAttr(priority string) attribute.KeyValue {
 func LinkTypeAttr(linkType string) attribute.KeyValue {
 	return attribute.String(linkTypeKey, linkType)
 }
+
+func HostAttr(host string) attribute.KeyValue {
+	return attribute.String(hostKey, host)
+}
+
+func CacheResultAttr(result string) attribute.KeyValue {
+	return attribute.String(cacheResultKey, result)
+}
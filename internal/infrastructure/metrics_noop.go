@@ -27,6 +27,12 @@ func (n *NoOpMetrics) RecordOutboxEvent(_ context.Context, _ bool, _ string) {
 func (n *NoOpMetrics) RecordLinkCheck(_ context.Context, _ bool, _ string) {
 }
 
+func (n *NoOpMetrics) RecordLinkCheckerBreakerState(_ context.Context, _, _ string) {
+}
+
+func (n *NoOpMetrics) RecordLinkCheckCache(_ context.Context, _ bool) {
+}
+
 func (n *NoOpMetrics) RecordFetchTime(_ context.Context, _ time.Duration) {
 }
 
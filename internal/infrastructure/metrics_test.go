@@ -0,0 +1,59 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestRecordLinkCheckerBreakerState(t *testing.T) {
+	t.Parallel()
+
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	metrics := &OTELMetrics{
+		meterProvider: meterProvider,
+		meter:         meterProvider.Meter(metricsNamespace),
+		breakerStates: make(map[string]int64),
+	}
+	require.NoError(t, metrics.initializeMetrics())
+
+	breakerStates := func() map[string]int64 {
+		var collected metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(t.Context(), &collected))
+
+		states := make(map[string]int64)
+
+		for _, scope := range collected.ScopeMetrics {
+			for _, m := range scope.Metrics {
+				if m.Name != "link_checker_circuit_breaker_state" {
+					continue
+				}
+
+				for _, point := range m.Data.(metricdata.Gauge[int64]).DataPoints {
+					host, _ := point.Attributes.Value(hostKey)
+					states[host.AsString()] = point.Value
+				}
+			}
+		}
+
+		return states
+	}
+
+	ctx := context.Background()
+
+	metrics.RecordLinkCheckerBreakerState(ctx, "a.example.com", "open")
+	metrics.RecordLinkCheckerBreakerState(ctx, "b.example.com", "half-open")
+	metrics.RecordLinkCheckerBreakerState(ctx, "c.example.com", "closed")
+
+	assert.Equal(t, map[string]int64{"a.example.com": 2, "b.example.com": 1}, breakerStates(), "Should only report breakers that are not closed")
+
+	metrics.RecordLinkCheckerBreakerState(ctx, "a.example.com", "closed")
+
+	assert.Equal(t, map[string]int64{"b.example.com": 1}, breakerStates(), "Should drop the series of a host once its breaker closes")
+}
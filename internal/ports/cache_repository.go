@@ -9,6 +9,8 @@ import (
 )
 
 //counterfeiter:generate -o ../mocks/cache_repository.go . CacheRepository
+//counterfeiter:generate -o ../mocks/link_check_cache_repository.go . LinkCheckCacheRepository
+//counterfeiter:generate -o ../mocks/robots_txt_cache_repository.go . RobotsTxtCacheRepository
 type (
 	Setter interface {
 		Set(context.Context, *domain.Analysis) error
@@ -19,4 +21,16 @@ type (
 		Setter
 		Deleter
 	}
+
+	// LinkCheckCacheRepository shares the outcome of link checks between analyses.
+	LinkCheckCacheRepository interface {
+		FindLinkCheck(ctx context.Context, linkURL string) (*domain.LinkCheckResult, error)
+		SaveLinkCheck(ctx context.Context, result domain.LinkCheckResult) error
+	}
+
+	// RobotsTxtCacheRepository shares the robots.txt of an origin between analyses.
+	RobotsTxtCacheRepository interface {
+		FindRobotsTxt(ctx context.Context, origin string) (*domain.RobotsTxt, error)
+		SaveRobotsTxt(ctx context.Context, robotsTxt domain.RobotsTxt) error
+	}
 )
@@ -11,5 +11,8 @@ import (
 //counterfeiter:generate -o ../mocks/link_checker.go . LinkChecker
 
 type LinkChecker interface {
-	CheckAccessibility(ctx context.Context, links []domain.Link) []domain.InaccessibleLink
+	// CheckAccessibility checks the links of the types the scope includes and reports those that
+	// could not be reached, those that redirect badly or serve soft 404s, and the outcome of each
+	// check.
+	CheckAccessibility(ctx context.Context, links []domain.Link, scope domain.LinkScope) domain.LinkCheckReport
 }
@@ -27,7 +27,7 @@ type (
 	}
 
 	Updater interface {
-		Update(ctx context.Context, analysisID, contentHash string, contentSize int64, results *domain.AnalysisData) error
+		Update(ctx context.Context, analysisID, contentHash, reuseKey string, contentSize int64, results *domain.AnalysisData) error
 		UpdateStatus(ctx context.Context, analysisID string, status domain.AnalysisStatus) error
 		UpdateCompletionDuration(ctx context.Context, analysisID string, durationMs int64) error
 		MarkFailed(ctx context.Context, analysisID, errorCode, errorMessage string, statusCode int) error
@@ -37,6 +37,13 @@ type (
 	Deleter interface {
 		Delete(ctx context.Context, analysisID string) error
 	}
+
+	// LinkStore keeps the complete link set of an analysis apart from its results.
+	LinkStore interface {
+		SaveLinks(ctx context.Context, analysisID string, links []domain.Link) error
+		CopyLinks(ctx context.Context, analysisID, sourceAnalysisID string) error
+		FindLinks(ctx context.Context, analysisID string, filter domain.LinkFilter, cursor string, limit int) (*domain.LinkPage, error)
+	}
 )
 
 //counterfeiter:generate -o ../mocks/analysis_repository.go . AnalysisRepository
@@ -45,11 +52,12 @@ type (
 	// AnalysisRepository provides methods for managing web page analysis data.
 	AnalysisRepository interface {
 		Finder
-		FindByContentHash(ctx context.Context, contentHash string) (*domain.Analysis, error)
+		FindByReuseKey(ctx context.Context, reuseKey string) (*domain.Analysis, error)
 		Saver
 		TransactionalSaver
 		Updater
 		Deleter
+		LinkStore
 	}
 
 	// OutboxRepository handles outbox events for reliable message delivery.
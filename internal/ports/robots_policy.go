@@ -0,0 +1,17 @@
+//go:generate go tool github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+package ports
+
+import (
+	"context"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+//counterfeiter:generate -o ../mocks/robots_policy.go . RobotsPolicy
+
+type RobotsPolicy interface {
+	// Evaluate tells whether the URL may be fetched according to the robots.txt of its origin and
+	// how long to leave between requests to that origin.
+	Evaluate(ctx context.Context, targetURL string) domain.RobotsVerdict
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/architeacher/svc-web-analyzer/internal/adapters/repos"
 	"github.com/architeacher/svc-web-analyzer/internal/config"
 	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+	"github.com/architeacher/svc-web-analyzer/internal/ports"
 	"github.com/architeacher/svc-web-analyzer/internal/service"
 	"github.com/architeacher/svc-web-analyzer/internal/shared/backoff"
 	"github.com/architeacher/svc-web-analyzer/internal/usecases"
@@ -153,6 +154,20 @@ func WithDataRepos() DependencyOption {
 			d.logger,
 		)
 
+		// Without a cache connection every analysis checks its links itself.
+		if d.Infra.CacheClient != nil {
+			d.Repos.LinkCheckCache = repos.NewLinkCheckCacheRepository(
+				d.Infra.CacheClient,
+				d.cfg.Cache,
+				d.logger,
+			)
+			d.Repos.RobotsTxtCache = repos.NewRobotsTxtCacheRepository(
+				d.Infra.CacheClient,
+				d.cfg.Cache,
+				d.logger,
+			)
+		}
+
 		return nil
 	}
 }
@@ -195,10 +210,27 @@ func WithTracing(ctx context.Context) DependencyOption {
 
 func WithDomainServices() DependencyOption {
 	return func(d *Dependencies) error {
+		guard, err := adapters.NewNetworkGuard(d.cfg.Egress)
+		if err != nil {
+			return fmt.Errorf("invalid egress configuration: %w", err)
+		}
+
+		analyzers, err := adapters.NewAnalyzerRegistry()
+		if err != nil {
+			return fmt.Errorf("failed to create analyzer registry: %w", err)
+		}
+
+		// Fetcher and link checker share one view of the robots.txt files.
+		var robots ports.RobotsPolicy
+		if d.cfg.Robots.Enabled {
+			robots = adapters.NewRobotsPolicy(d.cfg.Robots, d.cfg.WebFetcher.UserAgent, guard, d.Repos.RobotsTxtCache, d.logger)
+		}
+
 		d.DomainServices = DomainServices{
-			WebFetcher:   adapters.NewWebFetcher(d.cfg.WebFetcher, d.logger),
+			WebFetcher:   adapters.NewWebFetcher(d.cfg.WebFetcher, guard, robots, d.logger),
 			HTMLAnalyzer: adapters.NewHTMLAnalyzer(d.logger),
-			LinkChecker:  adapters.NewLinkChecker(d.cfg.LinkChecker, d.logger, d.Infra.Metrics),
+			LinkChecker:  adapters.NewLinkChecker(d.cfg.LinkChecker, d.cfg.WebFetcher.UserAgent, guard, d.Repos.LinkCheckCache, robots, d.logger, d.Infra.Metrics),
+			Analyzers:    analyzers,
 		}
 
 		return nil
@@ -237,7 +269,7 @@ func WithHTTPServer() DependencyOption {
 			d.logger,
 		)
 
-		requestHandler := http.NewRequestHandler(d.Apps.Web, d.logger)
+		requestHandler := http.NewRequestHandler(d.Apps.Web, d.DomainServices.Analyzers, d.cfg.Auth.AdminScope, d.logger)
 		httpServer := initHTTPServer(d.cfg, d.logger, d.Infra.Metrics, requestHandler, pasetoKeyService)
 
 		d.Infra.HTTPServer = httpServer
@@ -319,6 +351,7 @@ func WithSubscriber() DependencyOption {
 			d.DomainServices.WebFetcher,
 			d.DomainServices.HTMLAnalyzer,
 			d.DomainServices.LinkChecker,
+			d.DomainServices.Analyzers,
 			d.logger,
 			d.Infra.Metrics,
 		)
@@ -46,6 +46,7 @@ type (
 		WebFetcher   ports.WebFetcher
 		HTMLAnalyzer domain.HTMLAnalyzer
 		LinkChecker  ports.LinkChecker
+		Analyzers    *domain.AnalyzerRegistry
 	}
 
 	Repos struct {
@@ -53,6 +54,8 @@ type (
 		AnalysisRepo      ports.AnalysisRepository
 		OutboxRepo        ports.OutboxRepository
 		CacheRepo         ports.CacheRepository
+		LinkCheckCache    ports.LinkCheckCacheRepository
+		RobotsTxtCache    ports.RobotsTxtCacheRepository
 	}
 
 	Dependencies struct {
@@ -19,6 +19,7 @@ type (
 		StartAnalysis(ctx context.Context, url string, options domain.AnalysisOptions) (*domain.Analysis, error)
 		FetchAnalysis(ctx context.Context, analysisID string) (*domain.Analysis, error)
 		FetchAnalysisEvents(ctx context.Context, analysisID string) (<-chan domain.AnalysisEvent, error)
+		FetchAnalysisLinks(ctx context.Context, analysisID string, filter domain.LinkFilter, cursor string, limit int) (*domain.LinkPage, error)
 		FetchReadinessReport(ctx context.Context) (*domain.ReadinessResult, error)
 		FetchLivenessReport(ctx context.Context) (*domain.LivenessResult, error)
 		FetchHealthReport(ctx context.Context) (*domain.HealthResult, error)
@@ -137,6 +138,25 @@ func (s *appService) FetchAnalysis(ctx context.Context, analysisID string) (*dom
 	return analysis, nil
 }
 
+func (s *appService) FetchAnalysisLinks(
+	ctx context.Context,
+	analysisID string,
+	filter domain.LinkFilter,
+	cursor string,
+	limit int,
+) (*domain.LinkPage, error) {
+	if _, err := s.FetchAnalysis(ctx, analysisID); err != nil {
+		return nil, err
+	}
+
+	page, err := s.analysisRepo.FindLinks(ctx, analysisID, filter, cursor, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find analysis links: %w", err)
+	}
+
+	return page, nil
+}
+
 func (s *appService) FetchAnalysisEvents(ctx context.Context, analysisID string) (<-chan domain.AnalysisEvent, error) {
 	events := make(chan domain.AnalysisEvent, 10)
 	checkAnalysisChan := make(chan struct{}, 1)
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -99,6 +101,62 @@ func (s *ApplicationServiceTestSuite) TestFetchAnalysis_BothFail() {
 	s.Require().Equal(1, s.fakeAnalysisRepo.FindCallCount())
 }
 
+func (s *ApplicationServiceTestSuite) TestFetchAnalysisLinks_Success() {
+	analysis := s.createAnalysis(domain.StatusCompleted)
+	filter := domain.LinkFilter{Type: domain.LinkTypeExternal, Host: "example.org"}
+	expectedPage := &domain.LinkPage{
+		Links:      []domain.Link{{URL: "https://example.org/", Type: domain.LinkTypeExternal}},
+		Pagination: domain.CursorPagination{Limit: 1, TotalCount: 2, HasNext: true, NextCursor: "MA"},
+	}
+	s.fakeCacheRepo.FindReturns(analysis, nil)
+	s.fakeAnalysisRepo.FindLinksReturns(expectedPage, nil)
+
+	result, err := s.service.FetchAnalysisLinks(s.T().Context(), analysis.ID.String(), filter, "", 1)
+
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPage, result)
+
+	_, analysisID, passedFilter, cursor, limit := s.fakeAnalysisRepo.FindLinksArgsForCall(0)
+	s.Require().Equal(analysis.ID.String(), analysisID)
+	s.Require().Equal(filter, passedFilter)
+	s.Require().Empty(cursor)
+	s.Require().Equal(1, limit)
+}
+
+func (s *ApplicationServiceTestSuite) TestFetchAnalysisLinks_AnalysisNotFound() {
+	s.fakeCacheRepo.FindReturns(nil, domain.ErrCacheUnavailable)
+	s.fakeAnalysisRepo.FindReturns(nil, fmt.Errorf("%w: no analysis with ID 1", domain.ErrAnalysisNotFound))
+
+	result, err := s.service.FetchAnalysisLinks(s.T().Context(), uuid.New().String(), domain.LinkFilter{}, "", 50)
+
+	s.Require().ErrorIs(err, domain.ErrAnalysisNotFound)
+	s.Require().Nil(result)
+	s.Require().Zero(s.fakeAnalysisRepo.FindLinksCallCount())
+}
+
+func (s *ApplicationServiceTestSuite) TestFetchAnalysisLinks_RepositoryUnavailable() {
+	s.fakeCacheRepo.FindReturns(nil, domain.ErrCacheUnavailable)
+	s.fakeAnalysisRepo.FindReturns(nil, errors.New("failed to query analysis: connection refused"))
+
+	result, err := s.service.FetchAnalysisLinks(s.T().Context(), uuid.New().String(), domain.LinkFilter{}, "", 50)
+
+	s.Require().Error(err)
+	s.Require().NotErrorIs(err, domain.ErrAnalysisNotFound)
+	s.Require().Nil(result)
+	s.Require().Zero(s.fakeAnalysisRepo.FindLinksCallCount())
+}
+
+func (s *ApplicationServiceTestSuite) TestFetchAnalysisLinks_InvalidCursor() {
+	analysis := s.createAnalysis(domain.StatusCompleted)
+	s.fakeCacheRepo.FindReturns(analysis, nil)
+	s.fakeAnalysisRepo.FindLinksReturns(nil, domain.ErrInvalidCursor)
+
+	result, err := s.service.FetchAnalysisLinks(s.T().Context(), analysis.ID.String(), domain.LinkFilter{}, "bogus", 50)
+
+	s.Require().ErrorIs(err, domain.ErrInvalidCursor)
+	s.Require().Nil(result)
+}
+
 func (s *ApplicationServiceTestSuite) TestStartAnalysis_Success() {
 	if testing.Short() {
 		s.T().Skip("skipping test that requires database transactions")
@@ -26,6 +26,7 @@ type (
 		webFetcher   ports.WebFetcher
 		htmlAnalyzer domain.HTMLAnalyzer
 		linkChecker  ports.LinkChecker
+		analyzers    *domain.AnalyzerRegistry
 		logger       infrastructure.Logger
 		metrics      infrastructure.Metrics
 	}
@@ -38,6 +39,7 @@ func NewSubscriberService(
 	webFetcher ports.WebFetcher,
 	htmlAnalyzer domain.HTMLAnalyzer,
 	linkChecker ports.LinkChecker,
+	analyzers *domain.AnalyzerRegistry,
 	logger infrastructure.Logger,
 	metrics infrastructure.Metrics,
 ) SubscriberService {
@@ -48,6 +50,7 @@ func NewSubscriberService(
 		webFetcher:   webFetcher,
 		htmlAnalyzer: htmlAnalyzer,
 		linkChecker:  linkChecker,
+		analyzers:    analyzers,
 		logger:       logger,
 		metrics:      metrics,
 	}
@@ -95,9 +98,22 @@ func (s *subscriberService) ProcessAnalysisRequest(
 		}
 	}
 
+	// The HTTP handler only accepts the override from admins.
+	if payload.Options.IgnoreRobotsTxt {
+		ctx = domain.WithRobotsTxtIgnored(ctx)
+	}
+
 	content, err := s.webFetcher.Fetch(ctx, payload.URL, payload.Options.Timeout)
 	if err != nil {
-		if updateErr := s.analysisRepo.MarkFailed(ctx, payload.AnalysisID.String(), "FETCH_ERROR", err.Error(), 0); updateErr != nil {
+		errorCode := "FETCH_ERROR"
+		switch {
+		case errors.Is(err, domain.ErrRobotsDisallowed):
+			errorCode = "ROBOTS_DISALLOWED"
+		case errors.Is(err, domain.ErrAddressNotAllowed):
+			errorCode = "ADDRESS_NOT_ALLOWED"
+		}
+
+		if updateErr := s.analysisRepo.MarkFailed(ctx, payload.AnalysisID.String(), errorCode, err.Error(), 0); updateErr != nil {
 			s.logger.Error().Err(updateErr).Str("analysis_id", payload.AnalysisID.String()).
 				Msg("failed to mark analysis as failed")
 		} else {
@@ -112,15 +128,16 @@ func (s *subscriberService) ProcessAnalysisRequest(
 
 		return &domain.ProcessAnalysisMessageResult{
 			Success:      false,
-			ErrorCode:    "FETCH_ERROR",
+			ErrorCode:    errorCode,
 			ErrorMessage: fmt.Sprintf("failed to fetch web page: %v", err),
 		}, nil
 	}
 
 	contentHashObj := domain.NewContentHash(content.HTML)
 	contentHash := contentHashObj.String()
+	reuseKey := domain.NewReuseKey(content, payload.Options).String()
 
-	existingAnalysis, err := s.checkDuplicateContent(ctx, contentHash)
+	existingAnalysis, err := s.checkDuplicateContent(ctx, reuseKey)
 	if err != nil {
 		return &domain.ProcessAnalysisMessageResult{
 			Success:      false,
@@ -130,7 +147,7 @@ func (s *subscriberService) ProcessAnalysisRequest(
 	}
 
 	if existingAnalysis != nil {
-		if err := s.copyAnalysisResults(ctx, payload.AnalysisID, contentHash, existingAnalysis); err != nil {
+		if err := s.copyAnalysisResults(ctx, payload.AnalysisID, contentHash, reuseKey, existingAnalysis); err != nil {
 			return &domain.ProcessAnalysisMessageResult{
 				Success:      false,
 				ErrorCode:    "COPY_RESULTS_ERROR",
@@ -144,7 +161,7 @@ func (s *subscriberService) ProcessAnalysisRequest(
 			Str("content_hash", contentHash).
 			Msg("copied results from existing analysis (duplicate content)")
 	} else {
-		if err := s.performFullAnalysis(ctx, payload.AnalysisID, contentHash, content, payload.Options); err != nil {
+		if err := s.performFullAnalysis(ctx, payload.AnalysisID, contentHash, reuseKey, content, payload.Options); err != nil {
 			return &domain.ProcessAnalysisMessageResult{
 				Success:      false,
 				ErrorCode:    "ANALYSIS_ERROR",
@@ -194,18 +211,24 @@ func (s *subscriberService) ProcessAnalysisRequest(
 	}, nil
 }
 
-func (s *subscriberService) checkDuplicateContent(ctx context.Context, contentHash string) (*domain.Analysis, error) {
-	analysis, err := s.analysisRepo.FindByContentHash(ctx, contentHash)
+// checkDuplicateContent finds an analysis of the same page, served with the same headers and
+// requested with the same options, whose results can be reused.
+func (s *subscriberService) checkDuplicateContent(ctx context.Context, reuseKey string) (*domain.Analysis, error) {
+	analysis, err := s.analysisRepo.FindByReuseKey(ctx, reuseKey)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("failed to check for existing content hash: %w", err)
+		return nil, fmt.Errorf("failed to check for existing content: %w", err)
 	}
 
 	return analysis, nil
 }
 
-func (s *subscriberService) copyAnalysisResults(ctx context.Context, analysisID uuid.UUID, contentHash string, sourceAnalysis *domain.Analysis) error {
+func (s *subscriberService) copyAnalysisResults(ctx context.Context, analysisID uuid.UUID, contentHash, reuseKey string, sourceAnalysis *domain.Analysis) error {
+	if err := s.analysisRepo.CopyLinks(ctx, analysisID.String(), sourceAnalysis.ID.String()); err != nil {
+		return fmt.Errorf("failed to copy links from existing analysis: %w", err)
+	}
+
 	if err := s.analysisRepo.Update(
-		ctx, analysisID.String(), contentHash, sourceAnalysis.ContentSize, sourceAnalysis.Results,
+		ctx, analysisID.String(), contentHash, reuseKey, sourceAnalysis.ContentSize, sourceAnalysis.Results,
 	); err != nil {
 		return fmt.Errorf("failed to copy results from existing analysis: %w", err)
 	}
@@ -230,20 +253,40 @@ func (s *subscriberService) copyAnalysisResults(ctx context.Context, analysisID
 	return nil
 }
 
-func (s *subscriberService) performFullAnalysis(ctx context.Context, analysisID uuid.UUID, contentHash string, content *domain.WebPageContent, options domain.AnalysisOptions) error {
+func (s *subscriberService) performFullAnalysis(ctx context.Context, analysisID uuid.UUID, contentHash, reuseKey string, content *domain.WebPageContent, options domain.AnalysisOptions) error {
 	processingStart := time.Now()
 
-	results, err := s.htmlAnalyzer.Analyze(ctx, content.URL, content.HTML, options)
+	// The page is parsed once for the HTML analyzer and the pluggable analyzers.
+	doc, err := domain.NewDocument(content)
+	if err != nil {
+		return err
+	}
+
+	results, err := s.htmlAnalyzer.Analyze(ctx, doc, options)
 	if err != nil {
 		return fmt.Errorf("failed to analyze HTML: %w", err)
 	}
 
-	if options.CheckLinks && s.linkChecker != nil && len(results.Links.ExternalLinks) > 0 {
-		results.Links.InaccessibleLinks = s.linkChecker.CheckAccessibility(ctx, results.Links.ExternalLinks)
+	if options.CheckLinks && s.linkChecker != nil && len(results.Links.Links) > 0 {
+		results.Links.RecordLinkChecks(s.linkChecker.CheckAccessibility(ctx, results.Links.Links, options.LinkScope))
+	}
+
+	// Same origin assets are checked too, paced by the internal rate limit of the link checker.
+	if options.CheckResources && s.linkChecker != nil && results.Resources != nil && len(results.Resources.Resources) > 0 {
+		results.Resources.InaccessibleResources = s.linkChecker.CheckAccessibility(ctx, results.Resources.Links(), domain.LinkScopeAll).InaccessibleLinks
 	}
 
+	analyzerResults, err := s.runAnalyzers(ctx, analysisID, doc, options.Analyzers)
+	if err != nil {
+		return fmt.Errorf("failed to run analyzers: %w", err)
+	}
+
+	results.Analyzers = analyzerResults
+
 	processingDuration := time.Since(processingStart)
 
+	results.Robots = content.Robots
+	results.Encoding = content.Encoding
 	results.FetchTime = uint64(content.FetchDuration.Milliseconds())
 	results.ProcessingTime = uint64(processingDuration.Milliseconds())
 
@@ -256,7 +299,12 @@ func (s *subscriberService) performFullAnalysis(ctx context.Context, analysisID
 	s.metrics.RecordFetchTime(ctx, content.FetchDuration)
 	s.metrics.RecordProcessingTime(ctx, processingDuration)
 
-	if err := s.analysisRepo.Update(ctx, analysisID.String(), contentHash, int64(len(content.HTML)), results); err != nil {
+	// Links are stored before the analysis completes so clients never see it without them.
+	if err := s.analysisRepo.SaveLinks(ctx, analysisID.String(), results.Links.Links); err != nil {
+		return fmt.Errorf("failed to save analysis links: %w", err)
+	}
+
+	if err := s.analysisRepo.Update(ctx, analysisID.String(), contentHash, reuseKey, int64(len(content.HTML)), results); err != nil {
 		return fmt.Errorf("failed to save analysis results: %w", err)
 	}
 
@@ -279,3 +327,33 @@ func (s *subscriberService) performFullAnalysis(ctx context.Context, analysisID
 
 	return nil
 }
+
+func (s *subscriberService) runAnalyzers(ctx context.Context, analysisID uuid.UUID, doc *domain.Document, names []string) (map[string]domain.AnalyzerResult, error) {
+	if s.analyzers == nil {
+		return nil, nil
+	}
+
+	results, err := s.analyzers.Run(ctx, doc, names)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	for name, result := range results {
+		if result.Error == "" {
+			continue
+		}
+
+		s.logger.Warn().
+			Str("analysis_id", analysisID.String()).
+			Str("analyzer", name).
+			Str("version", result.Version).
+			Str("error", result.Error).
+			Msg("analyzer failed")
+	}
+
+	return results, nil
+}
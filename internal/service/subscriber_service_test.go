@@ -3,6 +3,7 @@ package service
 import (
 	"database/sql"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
@@ -57,6 +58,7 @@ func (s *SubscriberServiceTestSuite) SetupTest() {
 		s.mocks.webFetcher,
 		s.mocks.htmlAnalyzer,
 		s.mocks.linkChecker,
+		nil,
 		s.mocks.logger,
 		s.mocks.metrics,
 	)
@@ -138,6 +140,91 @@ func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_InvalidatesCache
 	}
 }
 
+func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_RobotsDisallowed() {
+	t := s.T()
+
+	analysisID := uuid.New()
+	url := "https://example.com/private"
+	payload := domain.AnalysisRequestPayload{
+		AnalysisID: analysisID,
+		URL:        url,
+		Options: domain.AnalysisOptions{
+			Timeout: 30 * time.Second,
+		},
+		Priority:  domain.PriorityNormal,
+		CreatedAt: time.Now(),
+	}
+	outboxEvent := s.createTestOutboxEvent(analysisID)
+
+	s.setupFailedFetchFlow(outboxEvent, domain.NewRobotsDisallowedError(url, "Disallow: /private"))
+
+	result, err := s.service.ProcessAnalysisRequest(t.Context(), payload)
+
+	s.Require().NoError(err)
+	s.Require().False(result.Success)
+	s.Require().Equal("ROBOTS_DISALLOWED", result.ErrorCode)
+
+	fetchCtx, _, _ := s.mocks.webFetcher.FetchArgsForCall(0)
+	s.Require().False(domain.RobotsTxtIgnored(fetchCtx), "Should respect robots.txt unless the analysis overrides it")
+
+	_, _, errorCode, _, _ := s.mocks.analysisRepo.MarkFailedArgsForCall(0)
+	s.Require().Equal("ROBOTS_DISALLOWED", errorCode)
+}
+
+func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_AddressNotAllowed() {
+	t := s.T()
+
+	analysisID := uuid.New()
+	url := "https://internal.example.com"
+	payload := domain.AnalysisRequestPayload{
+		AnalysisID: analysisID,
+		URL:        url,
+		Options: domain.AnalysisOptions{
+			Timeout: 30 * time.Second,
+		},
+		Priority:  domain.PriorityNormal,
+		CreatedAt: time.Now(),
+	}
+	outboxEvent := s.createTestOutboxEvent(analysisID)
+
+	s.setupFailedFetchFlow(outboxEvent, domain.NewAddressNotAllowedError(url, domain.ErrAddressNotAllowed))
+
+	result, err := s.service.ProcessAnalysisRequest(t.Context(), payload)
+
+	s.Require().NoError(err)
+	s.Require().False(result.Success)
+	s.Require().Equal("ADDRESS_NOT_ALLOWED", result.ErrorCode)
+
+	_, _, errorCode, _, _ := s.mocks.analysisRepo.MarkFailedArgsForCall(0)
+	s.Require().Equal("ADDRESS_NOT_ALLOWED", errorCode)
+}
+
+func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_IgnoresRobotsTxtWhenOverridden() {
+	t := s.T()
+
+	analysisID := uuid.New()
+	url := "https://example.com"
+	payload := domain.AnalysisRequestPayload{
+		AnalysisID: analysisID,
+		URL:        url,
+		Options: domain.AnalysisOptions{
+			Timeout:         30 * time.Second,
+			IgnoreRobotsTxt: true,
+		},
+		Priority:  domain.PriorityNormal,
+		CreatedAt: time.Now(),
+	}
+	outboxEvent := s.createTestOutboxEvent(analysisID)
+
+	s.setupFailedFetchFlow(outboxEvent, errors.New("failed to fetch URL"))
+
+	_, err := s.service.ProcessAnalysisRequest(t.Context(), payload)
+	s.Require().NoError(err)
+
+	fetchCtx, _, _ := s.mocks.webFetcher.FetchArgsForCall(0)
+	s.Require().True(domain.RobotsTxtIgnored(fetchCtx))
+}
+
 func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_ContinuesOnCacheDeleteError() {
 	t := s.T()
 
@@ -192,6 +279,7 @@ func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_NilCacheRepo() {
 		s.mocks.webFetcher,
 		s.mocks.htmlAnalyzer,
 		s.mocks.linkChecker,
+		nil,
 		s.mocks.logger,
 		s.mocks.metrics,
 	)
@@ -207,6 +295,270 @@ func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_NilCacheRepo() {
 		"MarkFailed should be called even with nil cache repo")
 }
 
+func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_SavesLinksWithCheckResults() {
+	t := s.T()
+
+	analysisID := uuid.New()
+	url := "https://example.com"
+	payload := s.createTestPayload(analysisID, url)
+	payload.Options.CheckLinks = true
+	payload.Options.LinkScope = domain.LinkScopeAll
+	outboxEvent := s.createTestOutboxEvent(analysisID)
+	webContent := s.createTestWebContent(url)
+	analysisData := s.createTestAnalysisData()
+	internalLink := domain.Link{URL: "https://example.com/about", Type: domain.LinkTypeInternal, Region: domain.LinkRegionNavigation}
+	externalLink := domain.Link{URL: "https://gone.example.org/", Type: domain.LinkTypeExternal, Region: domain.LinkRegionContent}
+	analysisData.Links = domain.LinkAnalysis{
+		ExternalLinks: []domain.Link{externalLink},
+		Links:         []domain.Link{internalLink, externalLink},
+	}
+	analysis := &domain.Analysis{
+		ID:     analysisID,
+		URL:    url,
+		Status: domain.StatusCompleted,
+	}
+
+	s.setupSuccessfulAnalysisFlow(outboxEvent, webContent, analysisData, analysis)
+	redirects := &domain.RedirectChain{
+		Hops:     []domain.RedirectHop{{URL: internalLink.URL, StatusCode: 301, Location: internalLink.URL + "home"}},
+		HopCount: 1,
+		FinalURL: internalLink.URL + "home",
+	}
+	s.mocks.linkChecker.CheckAccessibilityReturns(domain.LinkCheckReport{
+		InaccessibleLinks: []domain.InaccessibleLink{
+			{URL: externalLink.URL, StatusCode: 404, Error: "HTTP 404", Scope: domain.LinkTypeExternal},
+		},
+		Issues: []domain.LinkIssue{},
+		Results: []domain.LinkCheckResult{
+			{URL: internalLink.URL, StatusCode: 200, Redirects: redirects, Cached: true},
+			{URL: externalLink.URL, StatusCode: 404, Error: "HTTP 404"},
+		},
+	})
+
+	result, err := s.service.ProcessAnalysisRequest(t.Context(), payload)
+
+	s.Require().NoError(err)
+	s.Require().True(result.Success)
+	s.Require().Equal(1, s.mocks.linkChecker.CheckAccessibilityCallCount())
+
+	_, checkedLinks, scope := s.mocks.linkChecker.CheckAccessibilityArgsForCall(0)
+	s.Require().Equal(analysisData.Links.Links, checkedLinks)
+	s.Require().Equal(domain.LinkScopeAll, scope)
+	s.Require().Equal(1, s.mocks.analysisRepo.SaveLinksCallCount())
+
+	_, savedID, savedLinks := s.mocks.analysisRepo.SaveLinksArgsForCall(0)
+	s.Require().Equal(analysisID.String(), savedID)
+	s.Require().Len(savedLinks, 2)
+	s.Require().Equal(200, savedLinks[0].StatusCode)
+	s.Require().Empty(savedLinks[0].Error)
+	s.Require().Equal(redirects, savedLinks[0].Redirects)
+	s.Require().True(savedLinks[0].Cached)
+	s.Require().Equal(404, savedLinks[1].StatusCode)
+	s.Require().Equal("HTTP 404", savedLinks[1].Error)
+	s.Require().Nil(savedLinks[1].Redirects)
+	s.Require().False(savedLinks[1].Cached)
+}
+
+func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_ChecksInternalResources() {
+	t := s.T()
+
+	analysisID := uuid.New()
+	url := "https://example.com"
+	payload := s.createTestPayload(analysisID, url)
+	payload.Options.CheckResources = true
+	outboxEvent := s.createTestOutboxEvent(analysisID)
+	webContent := s.createTestWebContent(url)
+	analysisData := s.createTestAnalysisData()
+	stylesheet := domain.Resource{URL: "https://example.com/css/site.css", Type: domain.ResourceTypeStylesheet, Origin: domain.LinkTypeInternal}
+	analysisData.Resources = &domain.ResourceInventory{
+		TotalCount:    1,
+		InternalCount: 1,
+		CountsByType:  map[domain.ResourceType]int{domain.ResourceTypeStylesheet: 1},
+		Resources:     []domain.Resource{stylesheet},
+	}
+	analysis := &domain.Analysis{
+		ID:     analysisID,
+		URL:    url,
+		Status: domain.StatusCompleted,
+	}
+
+	s.setupSuccessfulAnalysisFlow(outboxEvent, webContent, analysisData, analysis)
+	s.mocks.linkChecker.CheckAccessibilityReturns(domain.LinkCheckReport{
+		InaccessibleLinks: []domain.InaccessibleLink{
+			{URL: stylesheet.URL, StatusCode: 404, Error: "HTTP 404", Scope: domain.LinkTypeInternal},
+		},
+		Issues:  []domain.LinkIssue{},
+		Results: []domain.LinkCheckResult{{URL: stylesheet.URL, StatusCode: 404, Error: "HTTP 404"}},
+	})
+
+	result, err := s.service.ProcessAnalysisRequest(t.Context(), payload)
+
+	s.Require().NoError(err)
+	s.Require().True(result.Success)
+	s.Require().Equal(1, s.mocks.linkChecker.CheckAccessibilityCallCount())
+
+	_, checkedLinks, scope := s.mocks.linkChecker.CheckAccessibilityArgsForCall(0)
+	s.Require().Equal([]domain.Link{{URL: stylesheet.URL, Type: domain.LinkTypeInternal}}, checkedLinks)
+	s.Require().Equal(domain.LinkScopeAll, scope)
+
+	_, _, _, _, _, savedResults := s.mocks.analysisRepo.UpdateArgsForCall(0)
+	s.Require().Len(savedResults.Resources.InaccessibleResources, 1)
+	s.Require().Equal(stylesheet.URL, savedResults.Resources.InaccessibleResources[0].URL)
+}
+
+func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_ParsesPageOnce() {
+	t := s.T()
+
+	analyzer := &mocks.FakeAnalyzer{}
+	analyzer.NameReturns("stub")
+	analyzer.VersionReturns("1.0.0")
+	analyzer.RunReturns("output", nil)
+
+	analyzers, err := domain.NewAnalyzerRegistry(analyzer)
+	s.Require().NoError(err)
+
+	service := NewSubscriberService(
+		s.mocks.analysisRepo,
+		s.mocks.outboxRepo,
+		s.mocks.cacheRepo,
+		s.mocks.webFetcher,
+		s.mocks.htmlAnalyzer,
+		s.mocks.linkChecker,
+		analyzers,
+		s.mocks.logger,
+		s.mocks.metrics,
+	)
+
+	analysisID := uuid.New()
+	url := "https://example.com"
+	webContent := s.createTestWebContent(url)
+
+	s.setupSuccessfulAnalysisFlow(s.createTestOutboxEvent(analysisID), webContent, s.createTestAnalysisData(), &domain.Analysis{})
+
+	result, err := service.ProcessAnalysisRequest(t.Context(), s.createTestPayload(analysisID, url))
+
+	s.Require().NoError(err)
+	s.Require().True(result.Success)
+	s.Require().Equal(1, s.mocks.htmlAnalyzer.AnalyzeCallCount())
+	s.Require().Equal(1, analyzer.RunCallCount())
+
+	_, analyzedDoc, _ := s.mocks.htmlAnalyzer.AnalyzeArgsForCall(0)
+	_, analyzerDoc := analyzer.RunArgsForCall(0)
+	s.Require().Same(analyzedDoc, analyzerDoc, "Should hand the analyzers the document the HTML analyzer walked")
+	s.Require().Equal(webContent.HTML, analyzedDoc.HTML)
+
+	_, _, _, _, _, savedResults := s.mocks.analysisRepo.UpdateArgsForCall(0)
+	s.Require().Equal(map[string]domain.AnalyzerResult{"stub": {Version: "1.0.0", Output: "output"}}, savedResults.Analyzers)
+}
+
+func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_CopiesLinksOfDuplicateContent() {
+	t := s.T()
+
+	analysisID := uuid.New()
+	sourceID := uuid.New()
+	url := "https://example.com"
+	payload := s.createTestPayload(analysisID, url)
+	outboxEvent := s.createTestOutboxEvent(analysisID)
+	webContent := s.createTestWebContent(url)
+	analysis := &domain.Analysis{
+		ID:     analysisID,
+		URL:    url,
+		Status: domain.StatusCompleted,
+	}
+
+	s.setupSuccessfulAnalysisFlow(outboxEvent, webContent, s.createTestAnalysisData(), analysis)
+	s.mocks.analysisRepo.FindByReuseKeyReturns(&domain.Analysis{
+		ID:      sourceID,
+		URL:     url,
+		Status:  domain.StatusCompleted,
+		Results: s.createTestAnalysisData(),
+	}, nil)
+
+	result, err := s.service.ProcessAnalysisRequest(t.Context(), payload)
+
+	s.Require().NoError(err)
+	s.Require().True(result.Success)
+	s.Require().Zero(s.mocks.analysisRepo.SaveLinksCallCount())
+	s.Require().Equal(1, s.mocks.analysisRepo.CopyLinksCallCount())
+
+	_, copiedID, copiedFromID := s.mocks.analysisRepo.CopyLinksArgsForCall(0)
+	s.Require().Equal(analysisID.String(), copiedID)
+	s.Require().Equal(sourceID.String(), copiedFromID)
+}
+
+func (s *SubscriberServiceTestSuite) TestProcessAnalysisRequest_ReusesOnlyResultsOfSamePageHeadersAndOptions() {
+	t := s.T()
+
+	outboxEvent := s.createTestOutboxEvent(uuid.New())
+	s.setupSuccessfulAnalysisFlow(outboxEvent, nil, s.createTestAnalysisData(), &domain.Analysis{})
+
+	pages := []struct {
+		url     string
+		headers http.Header
+		options func(options *domain.AnalysisOptions)
+	}{
+		{url: "https://example.com", headers: http.Header{"Server": {"nginx"}, "Date": {"Mon, 12 Oct 2026 08:00:00 GMT"}}},
+		{url: "https://EXAMPLE.com:443/", headers: http.Header{"Server": {"nginx"}, "Date": {"Fri, 16 Oct 2026 08:00:00 GMT"}}},
+		{url: "https://other.example.com", headers: http.Header{"Server": {"nginx"}}},
+		{url: "https://example.com", headers: http.Header{"Server": {"nginx"}, "Strict-Transport-Security": {"max-age=31536000"}}},
+		{
+			url:     "https://example.com",
+			headers: http.Header{"Server": {"nginx"}},
+			options: func(options *domain.AnalysisOptions) { options.Accessibility = true },
+		},
+		{
+			url:     "https://example.com",
+			headers: http.Header{"Server": {"nginx"}},
+			options: func(options *domain.AnalysisOptions) { options.Analyzers = []string{"seo", "tech_stack"} },
+		},
+		{
+			url:     "https://example.com",
+			headers: http.Header{"Server": {"nginx"}},
+			options: func(options *domain.AnalysisOptions) {
+				options.Analyzers = []string{"tech_stack", "seo"}
+				options.Timeout = time.Minute
+			},
+		},
+		{
+			url:     "https://example.com",
+			headers: http.Header{"Server": {"nginx"}},
+			options: func(options *domain.AnalysisOptions) { options.Analyzers = []string{} },
+		},
+	}
+
+	for _, page := range pages {
+		webContent := s.createTestWebContent(page.url)
+		webContent.Headers = page.headers
+		s.mocks.webFetcher.FetchReturns(webContent, nil)
+
+		payload := s.createTestPayload(uuid.New(), page.url)
+		if page.options != nil {
+			page.options(&payload.Options)
+		}
+
+		result, err := s.service.ProcessAnalysisRequest(t.Context(), payload)
+		s.Require().NoError(err)
+		s.Require().True(result.Success)
+	}
+
+	keys := make([]string, len(pages))
+	for i := range pages {
+		_, keys[i] = s.mocks.analysisRepo.FindByReuseKeyArgsForCall(i)
+
+		_, _, contentHash, savedKey, _, _ := s.mocks.analysisRepo.UpdateArgsForCall(i)
+		s.Require().Equal(keys[i], savedKey, "Should save the key the results can be reused by")
+		s.Require().Equal(domain.NewContentHash(s.createTestWebContent("").HTML).String(), contentHash)
+	}
+
+	s.Require().Equal(keys[0], keys[1], "Should ignore URL spelling and volatile headers")
+	s.Require().NotEqual(keys[0], keys[2], "Should not reuse results of another URL")
+	s.Require().NotEqual(keys[0], keys[3], "Should not reuse results of other headers")
+	s.Require().NotEqual(keys[0], keys[4], "Should not reuse results of other options")
+	s.Require().NotEqual(keys[0], keys[5], "Should not reuse results of other analyzers")
+	s.Require().Equal(keys[5], keys[6], "Should ignore the analyzer order and the timeout")
+	s.Require().NotEqual(keys[0], keys[7], "Should not reuse results of every analyzer for none")
+}
+
 func (s *SubscriberServiceTestSuite) createTestPayload(analysisID uuid.UUID, url string) domain.AnalysisRequestPayload {
 	return domain.AnalysisRequestPayload{
 		AnalysisID: analysisID,
@@ -263,7 +615,7 @@ func (s *SubscriberServiceTestSuite) setupSuccessfulAnalysisFlow(
 	s.mocks.analysisRepo.UpdateStatusReturns(nil)
 	s.mocks.webFetcher.FetchReturns(webContent, nil)
 	s.mocks.htmlAnalyzer.AnalyzeReturns(analysisData, nil)
-	s.mocks.analysisRepo.FindByContentHashReturns(nil, sql.ErrNoRows)
+	s.mocks.analysisRepo.FindByReuseKeyReturns(nil, sql.ErrNoRows)
 	s.mocks.analysisRepo.UpdateReturns(nil)
 	s.mocks.analysisRepo.FindReturns(analysis, nil)
 	s.mocks.analysisRepo.UpdateCompletionDurationReturns(nil)
@@ -0,0 +1,46 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/infrastructure"
+	"github.com/architeacher/svc-web-analyzer/internal/service"
+	"github.com/architeacher/svc-web-analyzer/internal/shared/decorator"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	FetchAnalysisLinksQuery struct {
+		AnalysisID string
+		Filter     domain.LinkFilter
+		Cursor     string
+		Limit      int
+	}
+
+	FetchAnalysisLinksQueryHandler decorator.QueryHandler[FetchAnalysisLinksQuery, *domain.LinkPage]
+
+	fetchAnalysisLinksQueryHandler struct {
+		appService service.ApplicationService
+	}
+)
+
+func NewFetchAnalysisLinksQueryHandler(
+	appService service.ApplicationService,
+	logger infrastructure.Logger,
+	tracerProvider trace.TracerProvider,
+	metricsClient decorator.MetricsClient,
+) decorator.QueryHandler[FetchAnalysisLinksQuery, *domain.LinkPage] {
+	return decorator.ApplyQueryDecorators[FetchAnalysisLinksQuery, *domain.LinkPage](
+		fetchAnalysisLinksQueryHandler{
+			appService: appService,
+		},
+		logger,
+		tracerProvider,
+		metricsClient,
+	)
+}
+
+func (h fetchAnalysisLinksQueryHandler) Execute(ctx context.Context, query FetchAnalysisLinksQuery) (*domain.LinkPage, error) {
+	return h.appService.FetchAnalysisLinks(ctx, query.AnalysisID, query.Filter, query.Cursor, query.Limit)
+}
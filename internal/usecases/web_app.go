@@ -22,6 +22,7 @@ type (
 	Queries struct {
 		FetchAnalysisQueryHandler        queries.FetchAnalysisQueryHandler
 		FetchAnalysisEventsQueryHandler  queries.FetchAnalysisEventsQueryHandler
+		FetchAnalysisLinksQueryHandler   queries.FetchAnalysisLinksQueryHandler
 		FetchReadinessReportQueryHandler queries.FetchReadinessReportQueryHandler
 		FetchLivenessReportQueryHandler  queries.FetchLivenessReportQueryHandler
 		FetchHealthReportQueryHandler    queries.FetchHealthReportQueryHandler
@@ -45,6 +46,9 @@ func NewWebApplication(
 			FetchAnalysisEventsQueryHandler: queries.NewFetchAnalysisEventsQueryHandler(
 				appService, logger, tracerProvider, metricsClient,
 			),
+			FetchAnalysisLinksQueryHandler: queries.NewFetchAnalysisLinksQueryHandler(
+				appService, logger, tracerProvider, metricsClient,
+			),
 			FetchReadinessReportQueryHandler: queries.NewFetchReadinessReportQueryHandler(
 				appService, logger, tracerProvider, metricsClient,
 			),
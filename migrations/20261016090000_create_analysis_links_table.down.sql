@@ -0,0 +1,8 @@
+-- Drop indexes (they'll be dropped with the table, but explicit for clarity)
+DROP INDEX IF EXISTS idx_analysis_links_type;
+DROP INDEX IF EXISTS idx_analysis_links_region;
+DROP INDEX IF EXISTS idx_analysis_links_host;
+DROP INDEX IF EXISTS idx_analysis_links_status_code;
+
+-- Drop the links table
+DROP TABLE IF EXISTS analysis_links;
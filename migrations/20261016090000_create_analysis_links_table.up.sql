@@ -0,0 +1,44 @@
+-- Deduplicated links of an analysed page, kept apart from analysis.results so they can be
+-- filtered and paginated without loading the whole result document
+CREATE TABLE analysis_links (
+    analysis_id UUID NOT NULL REFERENCES analysis(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL, -- zero based index of the link in document order
+    url TEXT NOT NULL,
+    host TEXT NOT NULL,
+    type VARCHAR(20) NOT NULL, -- 'internal' or 'external'
+    region VARCHAR(20) NOT NULL, -- 'header', 'navigation', 'content', 'sidebar' or 'footer'
+    text TEXT NOT NULL DEFAULT '',
+    rel TEXT[] NOT NULL DEFAULT '{}',
+    target TEXT NOT NULL DEFAULT '',
+    status_code INTEGER, -- only set for checked links
+    error TEXT,
+
+    PRIMARY KEY (analysis_id, position)
+);
+
+-- Filter indexes; each ends with position so a filtered page is read in cursor order
+CREATE INDEX idx_analysis_links_type ON analysis_links(analysis_id, type, position);
+CREATE INDEX idx_analysis_links_region ON analysis_links(analysis_id, region, position);
+CREATE INDEX idx_analysis_links_host ON analysis_links(analysis_id, host, position);
+CREATE INDEX idx_analysis_links_status_code ON analysis_links(analysis_id, status_code, position)
+    WHERE status_code IS NOT NULL;
+
+-- Add table and column comments for documentation
+COMMENT ON TABLE analysis_links IS 'Complete deduplicated link set of each analysis, served by GET /v1/analysis/{analysisId}/links';
+COMMENT ON COLUMN analysis_links.analysis_id IS 'Analysis the link was found by; rows are removed with the analysis';
+COMMENT ON COLUMN analysis_links.position IS 'Zero based index of the link in document order, used as the pagination cursor';
+COMMENT ON COLUMN analysis_links.url IS 'Absolute URL the link resolves to';
+COMMENT ON COLUMN analysis_links.host IS 'Lower cased host of the URL, for filtering by host';
+COMMENT ON COLUMN analysis_links.type IS 'Whether the link points to the analysed host (internal) or elsewhere (external)';
+COMMENT ON COLUMN analysis_links.region IS 'Page region the first occurrence of the link sits in';
+COMMENT ON COLUMN analysis_links.text IS 'Text the link is announced with';
+COMMENT ON COLUMN analysis_links.rel IS 'Lower cased rel tokens of the link';
+COMMENT ON COLUMN analysis_links.target IS 'Browsing context the link opens in';
+COMMENT ON COLUMN analysis_links.status_code IS 'HTTP status code the link answered the link checker with, 0 when an inaccessible link gave no response';
+COMMENT ON COLUMN analysis_links.error IS 'Why the link checker considered the link inaccessible';
+
+-- Index comments for maintenance
+COMMENT ON INDEX idx_analysis_links_type IS 'Index for listing the links of an analysis by type';
+COMMENT ON INDEX idx_analysis_links_region IS 'Index for listing the links of an analysis by page region';
+COMMENT ON INDEX idx_analysis_links_host IS 'Index for listing the links of an analysis by host';
+COMMENT ON INDEX idx_analysis_links_status_code IS 'Index for listing the checked links of an analysis by status code';
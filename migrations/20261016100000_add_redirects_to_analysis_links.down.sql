@@ -0,0 +1 @@
+ALTER TABLE analysis_links DROP COLUMN IF EXISTS redirects;
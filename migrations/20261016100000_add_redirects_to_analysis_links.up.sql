@@ -0,0 +1,4 @@
+-- Redirect chain the link checker followed from each link
+ALTER TABLE analysis_links ADD COLUMN redirects JSONB; -- only set for links that redirect
+
+COMMENT ON COLUMN analysis_links.redirects IS 'Redirects followed from the link: hops with status and location, hop count and final URL';
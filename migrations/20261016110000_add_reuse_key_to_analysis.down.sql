@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_analysis_reuse_key;
+ALTER TABLE analysis DROP COLUMN IF EXISTS reuse_key;
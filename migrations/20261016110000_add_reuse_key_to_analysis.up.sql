@@ -0,0 +1,8 @@
+-- Key of everything the results of an analysis depend on, so that results are only reused between
+-- analyses of the same URL, response headers, content and options
+ALTER TABLE analysis ADD COLUMN reuse_key VARCHAR(64); -- SHA-256 of the normalized URL, headers, content and options
+
+CREATE INDEX idx_analysis_reuse_key ON analysis(reuse_key) WHERE reuse_key IS NOT NULL;
+
+COMMENT ON COLUMN analysis.reuse_key IS 'SHA-256 of the normalized URL, the response headers, the page content and the analysis options; analyses with the same key share results';
+COMMENT ON INDEX idx_analysis_reuse_key IS 'Index for finding completed analyses whose results can be reused';
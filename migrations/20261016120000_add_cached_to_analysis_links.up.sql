@@ -0,0 +1,4 @@
+-- Whether the link checker took the outcome of each link from its cache
+ALTER TABLE analysis_links ADD COLUMN cached BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN analysis_links.cached IS 'Whether the status of the link was taken from an earlier check shared through the link check cache';